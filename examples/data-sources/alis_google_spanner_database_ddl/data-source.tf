@@ -0,0 +1,15 @@
+data "alis_google_spanner_database_ddl" "schema" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+}
+
+# Archive the exact schema a release was built against.
+output "schema_ddl" {
+  value = join(";\n", data.alis_google_spanner_database_ddl.schema.statements)
+}
+
+# Changes only when the schema does; feed it to whatever should redeploy.
+output "schema_fingerprint" {
+  value = data.alis_google_spanner_database_ddl.schema.fingerprint
+}
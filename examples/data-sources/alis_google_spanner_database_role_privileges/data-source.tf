@@ -0,0 +1,15 @@
+data "alis_google_spanner_database_role_privileges" "analyst" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = "analyst"
+}
+
+# Everything the analyst role can read, however it came to hold it.
+output "analyst_readable" {
+  value = [
+    for p in data.alis_google_spanner_database_role_privileges.analyst.privileges :
+    p.column == null ? p.object : "${p.object}.${p.column}"
+    if p.privilege == "SELECT"
+  ]
+}
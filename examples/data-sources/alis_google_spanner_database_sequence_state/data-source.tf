@@ -0,0 +1,11 @@
+data "alis_google_spanner_database_sequence_state" "order_ids" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  sequence = "order_ids"
+}
+
+# A start_with_counter that moves the sequence past every value it has issued.
+output "next_start_with_counter" {
+  value = coalesce(data.alis_google_spanner_database_sequence_state.order_ids.internal_counter, 0) + 1
+}
@@ -0,0 +1,15 @@
+data "alis_google_spanner_table" "users" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  name     = "users"
+}
+
+# The key a child table interleaved in "users" must start with.
+output "users_primary_key" {
+  value = data.alis_google_spanner_table.users.primary_key
+}
+
+output "users_columns" {
+  value = [for column in data.alis_google_spanner_table.users.schema.columns : column.name]
+}
@@ -0,0 +1,28 @@
+data "alis_google_spanner_table_constraints" "orders" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  table    = "orders"
+}
+
+data "alis_google_spanner_table_indexes" "orders" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  table    = "orders"
+}
+
+# Every foreign key should be backed by an index whose leading key columns
+# are the foreign key columns.
+check "foreign_keys_indexed" {
+  assert {
+    condition = alltrue([
+      for fk in data.alis_google_spanner_table_constraints.orders.foreign_keys : anytrue([
+        for index in data.alis_google_spanner_table_indexes.orders.indexes :
+        length(index.columns) >= length(fk.columns) &&
+        slice([for column in index.columns : column.name], 0, length(fk.columns)) == fk.columns
+      ])
+    ])
+    error_message = "Every foreign key on orders must be covered by an index."
+  }
+}
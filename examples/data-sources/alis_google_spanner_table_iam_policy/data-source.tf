@@ -0,0 +1,6 @@
+data "alis_google_spanner_table_iam_policy" "customers" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  table    = "customers"
+}
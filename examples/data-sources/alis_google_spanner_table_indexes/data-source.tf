@@ -0,0 +1,10 @@
+data "alis_google_spanner_table_indexes" "orders" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  table    = "orders"
+}
+
+output "orders_indexes" {
+  value = { for index in data.alis_google_spanner_table_indexes.orders.indexes : index.name => [for column in index.columns : column.name] }
+}
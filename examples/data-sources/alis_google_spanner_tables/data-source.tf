@@ -0,0 +1,19 @@
+# Every table whose name starts with "audit_".
+data "alis_google_spanner_tables" "audit" {
+  project    = var.GOOGLE_PROJECT
+  instance   = var.SPANNER_INSTANCE
+  database   = "tf-test"
+  name_regex = "^audit_"
+}
+
+# The tables interleaved directly in "users".
+data "alis_google_spanner_tables" "users_children" {
+  project      = var.GOOGLE_PROJECT
+  instance     = var.SPANNER_INSTANCE
+  database     = "tf-test"
+  parent_table = "users"
+}
+
+output "audit_tables" {
+  value = data.alis_google_spanner_tables.audit.names
+}
@@ -0,0 +1,9 @@
+list "alis_google_spanner_database_role" "all" {
+  provider = alis
+
+  config {
+    project  = var.GOOGLE_PROJECT
+    instance = var.SPANNER_INSTANCE
+    database = "tf-test"
+  }
+}
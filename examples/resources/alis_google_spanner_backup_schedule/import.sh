@@ -0,0 +1,3 @@
+# Backup schedule can be imported by specifying the fully qualified name of the backup schedule
+# projects/{project}/instances/{instance}/databases/{database}/backupSchedules/{schedule}
+terraform import alis_google_spanner_backup_schedule.schedule "projects/{project}/instances/{instance}/databases/{database}/backupSchedules/{schedule}"
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "alis_google_spanner_backup_schedule" "test_backup_schedule" {
+  project            = var.GOOGLE_PROJECT
+  instance           = var.SPANNER_INSTANCE
+  database           = "tf-test"
+  name               = "daily-incremental"
+  backup_type        = "incremental"
+  cron_spec          = "0 2 * * *"
+  retention_duration = "604800s"
+  encryption_config = {
+    encryption_type = "GOOGLE_DEFAULT_ENCRYPTION"
+  }
+}
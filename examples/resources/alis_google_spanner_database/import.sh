@@ -0,0 +1,3 @@
+# Database can be imported by specifying the fully qualified name of the database
+# projects/{project}/instances/{instance}/databases/{database}
+terraform import alis_google_spanner_database.database "projects/{project}/instances/{instance}/databases/{database}"
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "alis_google_spanner_database" "test_database" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  name     = "tf-test-db"
+  dialect  = "GOOGLE_STANDARD_SQL"
+  extra_statements = [
+    "CREATE SEQUENCE order_ids OPTIONS (sequence_kind = 'bit_reversed_positive')",
+  ]
+  enable_drop_protection = true
+}
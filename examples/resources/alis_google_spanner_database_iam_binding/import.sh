@@ -0,0 +1,4 @@
+# Binding can be imported by specifying the fully qualified name of the role's binding on the database,
+# followed by /conditions/{title} for a conditional binding
+# projects/{project}/instances/{instance}/databases/{database}/iamBindings/{role}[/conditions/{title}]
+terraform import alis_google_spanner_database_iam_binding.binding "projects/{project}/instances/{instance}/databases/{database}/iamBindings/roles/spanner.databaseRoleUser/conditions/{title}"
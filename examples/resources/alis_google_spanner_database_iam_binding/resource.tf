@@ -0,0 +1,24 @@
+# Fine-grained access control needs two bindings: one letting the analysts use
+# fine-grained access control on the database, and one naming the database
+# role they may act as.
+resource "alis_google_spanner_database_iam_binding" "fine_grained_access_user" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = "roles/spanner.fineGrainedAccessUser"
+  members  = ["group:analysts@example.com"]
+}
+
+resource "alis_google_spanner_database_iam_binding" "analyst_role_user" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = "roles/spanner.databaseRoleUser"
+  members  = ["group:analysts@example.com"]
+
+  condition = {
+    title       = "analyst"
+    description = "Act as the analyst database role only"
+    expression  = "resource.type == \"spanner.googleapis.com/DatabaseRole\" && resource.name.endsWith(\"/databaseRoles/analyst\")"
+  }
+}
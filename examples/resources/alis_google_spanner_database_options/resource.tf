@@ -0,0 +1,8 @@
+resource "alis_google_spanner_database_options" "test_database_options" {
+  project                  = var.GOOGLE_PROJECT
+  instance                 = var.SPANNER_INSTANCE
+  database                 = "tf-test"
+  version_retention_period = "3d"
+  optimizer_version        = 6
+  default_time_zone        = "America/New_York"
+}
@@ -3,4 +3,14 @@ resource "alis_google_spanner_database_role" "admin_role" {
   instance = var.SPANNER_INSTANCE
   database = "tf-test"
   role     = "admin"
-}
\ No newline at end of file
+}
+
+# Destroying this role also revokes its privileges and memberships, so it does
+# not depend on every grant being destroyed first.
+resource "alis_google_spanner_database_role" "reporting_role" {
+  project       = var.GOOGLE_PROJECT
+  instance      = var.SPANNER_INSTANCE
+  database      = "tf-test"
+  role          = "reporting"
+  force_destroy = true
+}
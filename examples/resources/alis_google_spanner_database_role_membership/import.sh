@@ -0,0 +1,3 @@
+# Role membership can be imported by specifying the fully qualified name of the membership
+# projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}/members/{member}
+terraform import alis_google_spanner_database_role_membership.membership "projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}/members/{member}"
\ No newline at end of file
@@ -0,0 +1,22 @@
+resource "alis_google_spanner_database_role" "reader" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = "reader"
+}
+
+resource "alis_google_spanner_database_role" "analyst" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = "analyst"
+}
+
+# analyst inherits every privilege granted to reader.
+resource "alis_google_spanner_database_role_membership" "analyst_reader" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  role     = alis_google_spanner_database_role.reader.role
+  member   = alis_google_spanner_database_role.analyst.role
+}
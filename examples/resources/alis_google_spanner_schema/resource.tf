@@ -0,0 +1,24 @@
+resource "alis_google_spanner_schema" "test_schema" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  ddl = [
+    <<-EOT
+      CREATE TABLE Singers (
+        SingerId INT64 NOT NULL,
+        Name     STRING(1024),
+      ) PRIMARY KEY (SingerId);
+
+      CREATE TABLE Albums (
+        SingerId   INT64 NOT NULL,
+        AlbumId    INT64 NOT NULL,
+        Title      STRING(MAX),
+        CreateTime TIMESTAMP OPTIONS (allow_commit_timestamp = true),
+      ) PRIMARY KEY (SingerId, AlbumId),
+        INTERLEAVE IN PARENT Singers ON DELETE CASCADE,
+        ROW DELETION POLICY (OLDER_THAN(CreateTime, INTERVAL 365 DAY));
+
+      CREATE INDEX AlbumsByTitle ON Albums (Title) STORING (CreateTime);
+    EOT
+  ]
+}
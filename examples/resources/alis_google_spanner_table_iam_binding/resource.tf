@@ -11,3 +11,16 @@ resource "alis_google_spanner_table_iam_binding" "editor" {
     "DELETE",
   ]
 }
+
+# Support staff may read a customer's contact details but nothing else.
+resource "alis_google_spanner_table_iam_binding" "support" {
+  project     = var.GOOGLE_PROJECT
+  instance    = var.SPANNER_INSTANCE
+  database    = "tf-test"
+  table       = "customers"
+  role        = "support"
+  permissions = ["SELECT"]
+  columns = {
+    SELECT = ["name", "email"]
+  }
+}
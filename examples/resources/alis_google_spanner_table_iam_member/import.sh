@@ -0,0 +1,3 @@
+# Member can be imported by specifying the fully qualified name of the role's permission on the table
+# projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}/permissions/{permission}
+terraform import alis_google_spanner_table_iam_member.member "projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}/permissions/{permission}"
@@ -0,0 +1,20 @@
+# Two modules can each grant the shared reporting role what they need without
+# revoking each other's grants.
+resource "alis_google_spanner_table_iam_member" "reporting_select" {
+  project    = var.GOOGLE_PROJECT
+  instance   = var.SPANNER_INSTANCE
+  database   = "tf-test"
+  table      = "customers"
+  role       = "reporting"
+  permission = "SELECT"
+  columns    = ["name", "country"]
+}
+
+resource "alis_google_spanner_table_iam_member" "reporting_insert" {
+  project    = var.GOOGLE_PROJECT
+  instance   = var.SPANNER_INSTANCE
+  database   = "tf-test"
+  table      = "customers"
+  role       = "reporting"
+  permission = "INSERT"
+}
@@ -0,0 +1,3 @@
+# Policy can be imported by specifying the fully qualified name of the table
+# projects/{project}/instances/{instance}/databases/{database}/tables/{table}
+terraform import alis_google_spanner_table_iam_policy.policy "projects/{project}/instances/{instance}/databases/{database}/tables/{table}"
@@ -0,0 +1,22 @@
+# Only these two roles may touch the customers table; any other grant on it is
+# revoked on the next apply.
+resource "alis_google_spanner_table_iam_policy" "customers" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = "tf-test"
+  table    = "customers"
+
+  bindings = [
+    {
+      role        = "admin"
+      permissions = ["SELECT", "INSERT", "UPDATE", "DELETE"]
+    },
+    {
+      role        = "support"
+      permissions = ["SELECT"]
+      columns = {
+        SELECT = ["name", "email"]
+      }
+    },
+  ]
+}
@@ -106,8 +106,9 @@ provider "alis" {
 }
 
 // SkipIfNoRoleListing skips the test when the backend cannot list database
-// roles: the emulator does not implement the admin API at all, and a live
-// principal may lack the permission or be unreachable. The probe keeps the
+// roles through the service, which reads INFORMATION_SCHEMA where the admin
+// API is unimplemented (the emulator): a live principal may lack the
+// permission or be unreachable. The probe keeps the
 // gate behavioral — the test starts running the moment the capability
 // appears — and it never fails the build for an environmental condition.
 //
@@ -120,7 +121,7 @@ func (e Env) SkipIfNoRoleListing(t *testing.T) {
 	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
 	defer cancel()
 
-	_, _, err := e.Conn.DatabaseRoles(ctx, e.DatabaseName, 1, "")
+	_, _, err := e.Service.ListDatabaseRoles(ctx, e.DatabaseName, 1, "")
 	if err != nil {
 		t.Skipf("backend cannot list database roles (%v); set GOOGLE_PROJECT/SPANNER_INSTANCE with SPANNER_LIVE=1 for live coverage", err)
 	}
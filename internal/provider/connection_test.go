@@ -19,27 +19,24 @@ func resetConnections(t *testing.T) {
 	clear(connections)
 }
 
-// The cache key has to cover every input the Connection is built from. The two
-// that are easy to forget are the ones that do not appear in the provider
-// configuration at all: the identity ADC resolved to, and the emulator host
-// conn captures at construction.
+// The cache key has to cover every input the Connection is built from. The one
+// that is easy to forget is the one that does not appear in the provider
+// configuration at all: the identity ADC resolved to.
 func TestConnectionKey_SeparatesEveryInput(t *testing.T) {
 	adc := &googleoauth.Credentials{ProjectID: "my-project", JSON: []byte(`{"client_email":"first@example.com"}`)}
 
-	// The key includes the ambient emulator host; pin it so the base key is
-	// stable even when the test process runs with SPANNER_EMULATOR_HOST set
-	// (as the emulator-backed acceptance suite does).
-	t.Setenv("SPANNER_EMULATOR_HOST", "")
-	base := connectionKey("my-project", "", "", adc)
+	base := connectionKey("my-project", "", "", "", nil, conn.Options{}, adc)
 
 	tests := []struct {
-		name         string
-		project      string
-		credentials  string
-		accessToken  string
-		resolved     *googleoauth.Credentials
-		emulatorHost string
-		wantSameKey  bool
+		name        string
+		project     string
+		credentials string
+		accessToken string
+		target      string
+		delegates   []string
+		resolved    *googleoauth.Credentials
+		options     conn.Options
+		wantSameKey bool
 	}{
 		{
 			name:        "identical inputs",
@@ -64,6 +61,21 @@ func TestConnectionKey_SeparatesEveryInput(t *testing.T) {
 			accessToken: "token",
 			resolved:    adc,
 		},
+		{
+			// The same base credentials act as whichever service account
+			// they impersonate.
+			name:     "impersonating a service account",
+			project:  "my-project",
+			target:   "schema-admin@my-project.iam.gserviceaccount.com",
+			resolved: adc,
+		},
+		{
+			name:      "impersonating through a delegate",
+			project:   "my-project",
+			target:    "schema-admin@my-project.iam.gserviceaccount.com",
+			delegates: []string{"hop@my-project.iam.gserviceaccount.com"},
+			resolved:  adc,
+		},
 		{
 			// Same configuration, different principal: ADC can name another
 			// service account without a line of Terraform changing.
@@ -72,20 +84,38 @@ func TestConnectionKey_SeparatesEveryInput(t *testing.T) {
 			resolved: &googleoauth.Credentials{ProjectID: "my-project", JSON: []byte(`{"client_email":"second@example.com"}`)},
 		},
 		{
-			// conn reads this at construction, so it decides which backend the
-			// Connection talks to.
-			name:         "different emulator host",
-			project:      "my-project",
-			resolved:     adc,
-			emulatorHost: "localhost:9010",
+			// Aliased providers differing only in where they dial must not
+			// share one set of clients.
+			name:     "different endpoint",
+			project:  "my-project",
+			resolved: adc,
+			options:  conn.Options{Endpoint: "us-central1-spanner.googleapis.com:443"},
+		},
+		{
+			name:     "different universe domain",
+			project:  "my-project",
+			resolved: adc,
+			options:  conn.Options{UniverseDomain: "example.com"},
+		},
+		{
+			name:     "different emulator host",
+			project:  "my-project",
+			resolved: adc,
+			options:  conn.Options{EmulatorHost: "localhost:9010"},
+		},
+		{
+			// The credentials in options are derived from the other inputs.
+			name:        "same config, client credentials in options",
+			project:     "my-project",
+			resolved:    adc,
+			options:     conn.Options{Credentials: adc},
+			wantSameKey: true,
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			t.Setenv("SPANNER_EMULATOR_HOST", tc.emulatorHost)
-
-			got := connectionKey(tc.project, tc.credentials, tc.accessToken, tc.resolved)
+			got := connectionKey(tc.project, tc.credentials, tc.accessToken, tc.target, tc.delegates, tc.options, tc.resolved)
 			if same := got == base; same != tc.wantSameKey {
 				t.Errorf("key equals base = %v, want %v", same, tc.wantSameKey)
 			}
@@ -96,7 +126,7 @@ func TestConnectionKey_SeparatesEveryInput(t *testing.T) {
 // Nil credentials cannot reach here through Configure, but a panic in a
 // provider surfaces to practitioners as "the plugin crashed".
 func TestConnectionKey_ToleratesNilCredentials(t *testing.T) {
-	if connectionKey("my-project", "", "", nil) == ([32]byte{}) {
+	if connectionKey("my-project", "", "", "", nil, conn.Options{}, nil) == ([32]byte{}) {
 		t.Error("connectionKey returned the zero key")
 	}
 }
@@ -125,3 +155,32 @@ func TestSharedConnection_ReusesOneConnectionPerKey(t *testing.T) {
 		t.Errorf("built %d Connections, want 2 (one per distinct key)", builds)
 	}
 }
+
+// Distinct targets, distinct chains to one target and one target in distinct
+// universes are distinct identities; a delegation chain is ordered, so
+// reordering it is too.
+func TestConnectionKey_SeparatesImpersonatedIdentities(t *testing.T) {
+	adc := &googleoauth.Credentials{ProjectID: "my-project", JSON: []byte(`{"client_email":"ci@example.com"}`)}
+	key := func(target string, delegates ...string) [32]byte {
+		return connectionKey("my-project", "", "", target, delegates, conn.Options{}, adc)
+	}
+	// The same service account name in another universe is another account.
+	inUniverse := func(universe, target string) [32]byte {
+		return connectionKey("my-project", "", "", target, nil, conn.Options{UniverseDomain: universe}, adc)
+	}
+
+	keys := map[[32]byte]string{}
+	for name, k := range map[string][32]byte{
+		"other target":         key("reader@my-project.iam.gserviceaccount.com"),
+		"admin":                key("admin@my-project.iam.gserviceaccount.com"),
+		"admin via a, b":       key("admin@my-project.iam.gserviceaccount.com", "a@my-project.iam.gserviceaccount.com", "b@my-project.iam.gserviceaccount.com"),
+		"admin via b, a":       key("admin@my-project.iam.gserviceaccount.com", "b@my-project.iam.gserviceaccount.com", "a@my-project.iam.gserviceaccount.com"),
+		"no impersonation":     key(""),
+		"admin in example.com": inUniverse("example.com", "admin@my-project.iam.gserviceaccount.com"),
+	} {
+		if other, ok := keys[k]; ok {
+			t.Errorf("%s and %s share a connection key", name, other)
+		}
+		keys[k] = name
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables the provider falls back to for attributes the
+// configuration leaves unset. Apart from SPANNER_EMULATOR_HOST, which the
+// Spanner client libraries read, they are the ones hashicorp/google reads, so
+// a pipeline that already exports them for it configures this provider too.
+const (
+	envCredentials               = "GOOGLE_CREDENTIALS"
+	envAccessToken               = "GOOGLE_OAUTH_ACCESS_TOKEN"
+	envProject                   = "GOOGLE_PROJECT"
+	envImpersonateServiceAccount = "GOOGLE_IMPERSONATE_SERVICE_ACCOUNT"
+	envEmulatorHost              = "SPANNER_EMULATOR_HOST"
+)
+
+// applyEnvironment fills the attributes of config that are null from their
+// environment variables; a configured attribute always wins, and an empty
+// variable counts as unset.
+//
+// credentials and access_token are one choice of identity rather than two
+// independent attributes: configuring either of them ignores both variables,
+// so GOOGLE_CREDENTIALS can never override an access_token given in the
+// configuration. Between the variables, GOOGLE_CREDENTIALS wins, matching
+// the precedence of the attributes.
+func (config *googleProviderModel) applyEnvironment() {
+	if config.Credentials.IsNull() && config.AccessToken.IsNull() {
+		if value := os.Getenv(envCredentials); value != "" {
+			config.Credentials = types.StringValue(value)
+		} else if value := os.Getenv(envAccessToken); value != "" {
+			config.AccessToken = types.StringValue(value)
+		}
+	}
+	config.Project = stringFromEnvironment(config.Project, envProject)
+	config.ImpersonateServiceAccount = stringFromEnvironment(config.ImpersonateServiceAccount, envImpersonateServiceAccount)
+	config.EmulatorHost = stringFromEnvironment(config.EmulatorHost, envEmulatorHost)
+}
+
+// stringFromEnvironment returns value, or the value of the environment
+// variable name when value is null and the variable is set.
+func stringFromEnvironment(value types.String, name string) types.String {
+	if !value.IsNull() {
+		return value
+	}
+	if env := os.Getenv(name); env != "" {
+		return types.StringValue(env)
+	}
+
+	return value
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestApplyEnvironment_Precedence(t *testing.T) {
+	null := types.StringNull()
+	value := types.StringValue
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		// config is the provider configuration before the fallback.
+		config googleProviderModel
+		want   googleProviderModel
+	}{
+		{
+			name: "nothing set",
+			want: googleProviderModel{Credentials: null, AccessToken: null, Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+		{
+			name: "every variable fills its attribute",
+			env: map[string]string{
+				envCredentials:               "env-credentials",
+				envProject:                   "env-project",
+				envImpersonateServiceAccount: "env-sa@example.com",
+				envEmulatorHost:              "localhost:9010",
+			},
+			want: googleProviderModel{
+				Credentials:               value("env-credentials"),
+				AccessToken:               null,
+				Project:                   value("env-project"),
+				ImpersonateServiceAccount: value("env-sa@example.com"),
+				EmulatorHost:              value("localhost:9010"),
+			},
+		},
+		{
+			name: "attributes win over variables",
+			env: map[string]string{
+				envCredentials:               "env-credentials",
+				envProject:                   "env-project",
+				envImpersonateServiceAccount: "env-sa@example.com",
+				envEmulatorHost:              "localhost:9010",
+			},
+			config: googleProviderModel{
+				Credentials:               value("credentials"),
+				Project:                   value("project"),
+				ImpersonateServiceAccount: value("sa@example.com"),
+				EmulatorHost:              value("localhost:9020"),
+			},
+			want: googleProviderModel{
+				Credentials:               value("credentials"),
+				AccessToken:               null,
+				Project:                   value("project"),
+				ImpersonateServiceAccount: value("sa@example.com"),
+				EmulatorHost:              value("localhost:9020"),
+			},
+		},
+		{
+			name: "access token variable",
+			env:  map[string]string{envAccessToken: "env-token"},
+			want: googleProviderModel{Credentials: null, AccessToken: value("env-token"), Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+		{
+			name: "credentials variable wins over access token variable",
+			env:  map[string]string{envCredentials: "env-credentials", envAccessToken: "env-token"},
+			want: googleProviderModel{Credentials: value("env-credentials"), AccessToken: null, Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+		{
+			// Filling credentials from the environment here would outrank
+			// the configured token.
+			name:   "configured access token ignores credentials variable",
+			env:    map[string]string{envCredentials: "env-credentials"},
+			config: googleProviderModel{AccessToken: value("token")},
+			want:   googleProviderModel{Credentials: null, AccessToken: value("token"), Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+		{
+			name:   "configured credentials ignore access token variable",
+			env:    map[string]string{envAccessToken: "env-token"},
+			config: googleProviderModel{Credentials: value("credentials")},
+			want:   googleProviderModel{Credentials: value("credentials"), AccessToken: null, Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+		{
+			name: "empty variables are unset",
+			env:  map[string]string{envCredentials: "", envAccessToken: "env-token", envProject: ""},
+			want: googleProviderModel{Credentials: null, AccessToken: value("env-token"), Project: null, ImpersonateServiceAccount: null, EmulatorHost: null},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{envCredentials, envAccessToken, envProject, envImpersonateServiceAccount, envEmulatorHost} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			// Zero types.String values are null: unset attributes.
+			config := tt.config
+			config.applyEnvironment()
+
+			for name, got := range map[string][2]types.String{
+				"credentials":                 {config.Credentials, tt.want.Credentials},
+				"access_token":                {config.AccessToken, tt.want.AccessToken},
+				"project":                     {config.Project, tt.want.Project},
+				"impersonate_service_account": {config.ImpersonateServiceAccount, tt.want.ImpersonateServiceAccount},
+				"emulator_host":               {config.EmulatorHost, tt.want.EmulatorHost},
+			} {
+				if !got[0].Equal(got[1]) {
+					t.Errorf("%s = %s, want %s", name, got[0], got[1])
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"crypto/sha256"
+	"os"
 	"strings"
 	"sync"
 
@@ -13,9 +14,11 @@ import (
 	"terraform-provider-alis/internal/utils"
 	"terraform-provider-alis/internal/validators"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -28,8 +31,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider              = &googleProvider{}
-	_ provider.ProviderWithFunctions = &googleProvider{}
+	_ provider.Provider                  = &googleProvider{}
+	_ provider.ProviderWithFunctions     = &googleProvider{}
+	_ provider.ProviderWithListResources = &googleProvider{}
 )
 
 // Every Configure call builds a provider instance, and each Connection owns a
@@ -71,13 +75,18 @@ func sharedConnection(key [sha256.Size]byte, build func() conn.Connection) conn.
 // connectionKey fingerprints everything the resulting Connection depends on:
 // the configured credentials, the identity those resolved to — Application
 // Default Credentials can name a different principal without the configuration
-// changing at all — and the emulator host conn captures at construction. A key
-// that misses any of them hands back a Connection built for another backend or
-// another principal.
+// changing at all — the service account impersonated on top of them, through
+// which delegation chain, and the endpoint, universe domain or emulator host
+// the clients dial. The universe domain also picks the IAM Credentials API
+// impersonated tokens are minted by. A key that misses any of them hands back a Connection
+// built for another backend or another principal.
+//
+// options contributes only where the clients dial; its credentials are the
+// ones the other inputs resolve to.
 //
 // The inputs are hashed rather than stored: this key lives in a map that
 // survives for the process, which is no place for resolved credentials.
-func connectionKey(project, credentials, accessToken string, resolved *googleoauth.Credentials) [sha256.Size]byte {
+func connectionKey(project, credentials, accessToken, targetPrincipal string, delegates []string, options conn.Options, resolved *googleoauth.Credentials) [sha256.Size]byte {
 	// Configure rejects nil credentials before reaching here; treat them as
 	// absent rather than panicking if that ever stops being true.
 	var resolvedProject string
@@ -90,7 +99,12 @@ func connectionKey(project, credentials, accessToken string, resolved *googleoau
 		project,
 		credentials,
 		accessToken,
-		conn.EmulatorHost(),
+		targetPrincipal,
+		// Delegates are a chain, so their order is part of the identity.
+		strings.Join(delegates, ","),
+		options.Endpoint,
+		options.UniverseDomain,
+		options.EmulatorHost,
 		resolvedProject,
 		string(resolvedJSON),
 	}, "\x00")))
@@ -118,6 +132,15 @@ type googleProviderModel struct {
 	Credentials types.String `tfsdk:"credentials"`
 	AccessToken types.String `tfsdk:"access_token"`
 	Project     types.String `tfsdk:"project"`
+	Instance    types.String `tfsdk:"instance"`
+	Database    types.String `tfsdk:"database"`
+
+	ImpersonateServiceAccount          types.String `tfsdk:"impersonate_service_account"`
+	ImpersonateServiceAccountDelegates types.List   `tfsdk:"impersonate_service_account_delegates"`
+
+	SpannerEndpoint types.String `tfsdk:"spanner_endpoint"`
+	UniverseDomain  types.String `tfsdk:"universe_domain"`
+	EmulatorHost    types.String `tfsdk:"emulator_host"`
 }
 
 // Metadata returns the provider type name.
@@ -141,27 +164,88 @@ func (p *googleProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					validators.GoogleCredentialsValidator(),
 					validators.StringNotEmpty(),
 				},
-				MarkdownDescription: "A JSON string of Google Cloud credentials.",
+				MarkdownDescription: "Google Cloud credentials JSON, or the path of a file holding it.\n" +
+					"May also be set with the `GOOGLE_CREDENTIALS` environment variable.",
 			},
 			"access_token": schema.StringAttribute{
 				Optional: true,
 				MarkdownDescription: "An OAuth2 access token used to authenticate to Google Cloud instead of `credentials`.\n" +
-					"Requires `project` to be set and conflicts with `credentials`.",
+					"Requires `project` to be set and conflicts with `credentials`.\n" +
+					"May also be set with the `GOOGLE_OAUTH_ACCESS_TOKEN` environment variable.",
 				Validators: []validator.String{
-					stringvalidator.AlsoRequires(
-						path.Expressions{
-							path.MatchRoot("project"),
-						}...,
-					),
 					stringvalidator.ConflictsWith(path.Expressions{
 						path.MatchRoot("credentials"),
 					}...),
 					validators.StringNotEmpty(),
 				},
 			},
+			"impersonate_service_account": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The email of a service account to impersonate. The credentials resolved from `credentials`, `access_token` or " +
+					"Application Default Credentials must hold `roles/iam.serviceAccountTokenCreator` on it, and every Spanner call is made as it.\n" +
+					"May also be set with the `GOOGLE_IMPERSONATE_SERVICE_ACCOUNT` environment variable.",
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"impersonate_service_account_delegates": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The emails of the service accounts in a delegation chain to `impersonate_service_account`, in order. " +
+					"Each must hold `roles/iam.serviceAccountTokenCreator` on the next, and the last on `impersonate_service_account`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validators.StringNotEmpty()),
+				},
+			},
+			"spanner_endpoint": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The Spanner API endpoint to use instead of the default, e.g. a regional endpoint such as " +
+					"`us-central1-spanner.googleapis.com:443` or a Private Service Connect endpoint. Conflicts with `emulator_host`.",
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"universe_domain": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The universe domain of the Spanner API, for clouds other than the default `googleapis.com`. " +
+					"The credentials must belong to the same universe. Conflicts with `emulator_host`.",
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"emulator_host": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The `host:port` of a Spanner emulator to manage instead of Spanner. Clients connect to it over plain text, " +
+					"without credentials.\n" +
+					"May also be set with the `SPANNER_EMULATOR_HOST` environment variable. The Spanner client libraries read that variable " +
+					"themselves and send every client to the emulator while it is set, so to run aliased providers against an emulator and " +
+					"Spanner side by side, set `emulator_host` on the emulator's provider and leave the variable unset.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRoot("spanner_endpoint"),
+						path.MatchRoot("universe_domain"),
+					}...),
+					validators.StringNotEmpty(),
+				},
+			},
 			"project": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The Google Cloud project ID, and the default `project` of every resource and data source.\n" +
+					"May also be set with the `GOOGLE_PROJECT` environment variable.",
+			},
+			"instance": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The Google Cloud project ID.",
+				MarkdownDescription: "The default Spanner instance ID of every resource and data source that takes an `instance`.",
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The default Spanner database ID of every resource and data source that takes a `database`.",
+				Validators: []validator.String{
+					validators.StringNotEmpty(),
+				},
 			},
 		},
 		MarkdownDescription: "Custom terraform provider for managing various google resources used in ALIS.",
@@ -171,9 +255,10 @@ func (p *googleProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 // Configure resolves Google credentials and builds the shared ProviderConfig
 // that every resource and data source receives via ProviderData. Credentials
 // are resolved exactly once here — from the credentials attribute, the
-// access_token attribute, or Application Default Credentials, in that order
-// (utils.GetGoogleCredentials) — and reach every Spanner client through
-// conn.New, the provider's single credential path.
+// access_token attribute, their environment variables (applyEnvironment), or
+// Application Default Credentials, in that order (utils.GetGoogleCredentials),
+// then impersonating impersonate_service_account when set — and reach every
+// Spanner client through conn.New, the provider's single credential path.
 func (p *googleProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Configuring DB client")
 
@@ -214,16 +299,132 @@ func (p *googleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 				"Target-apply the source of the value first, or set it statically in the configuration.",
 		)
 	}
+	if config.ImpersonateServiceAccount.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("impersonate_service_account"),
+			"Unknown Service Account to Impersonate",
+			"The provider cannot configure Google Cloud clients because impersonate_service_account is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.ImpersonateServiceAccountDelegates.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("impersonate_service_account_delegates"),
+			"Unknown Service Account Delegates",
+			"The provider cannot configure Google Cloud clients because impersonate_service_account_delegates is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.SpannerEndpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("spanner_endpoint"),
+			"Unknown Spanner Endpoint",
+			"The provider cannot configure Spanner clients because spanner_endpoint is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.UniverseDomain.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("universe_domain"),
+			"Unknown Universe Domain",
+			"The provider cannot configure Spanner clients because universe_domain is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.EmulatorHost.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("emulator_host"),
+			"Unknown Spanner Emulator Host",
+			"The provider cannot configure Spanner clients because emulator_host is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.Instance.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("instance"),
+			"Unknown Spanner Instance",
+			"The provider cannot default the instance of its resources because instance is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
+	if config.Database.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("database"),
+			"Unknown Spanner Database",
+			"The provider cannot default the database of its resources because database is only known after apply. "+
+				"Target-apply the source of the value first, or set it statically in the configuration.",
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Fall back to the environment for what the configuration leaves unset.
+	// The attribute validators only ever see the configuration, so what
+	// they would have caught is checked again below.
+	config.applyEnvironment()
+
 	// Read configured values
-	credentials := config.Credentials.ValueString()
+	credentials, _, err := utils.ReadPathOrContents(config.Credentials.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials"),
+			"Unable to Read Google Cloud Credentials",
+			"Could not read the credentials file: "+utils.ErrDetail(err),
+		)
+		return
+	}
 	accessToken := config.AccessToken.ValueString()
+	targetPrincipal := config.ImpersonateServiceAccount.ValueString()
+	var delegates []string
+	resp.Diagnostics.Append(config.ImpersonateServiceAccountDelegates.ElementsAs(ctx, &delegates, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if accessToken != "" && config.Project.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project"),
+			"Missing Google Cloud Project",
+			"An access token names no project, so project (or GOOGLE_PROJECT) must be set alongside access_token (or GOOGLE_OAUTH_ACCESS_TOKEN).",
+		)
+	}
+	if len(delegates) > 0 && targetPrincipal == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("impersonate_service_account_delegates"),
+			"Missing Service Account to Impersonate",
+			"impersonate_service_account_delegates is a delegation chain to impersonate_service_account, but neither impersonate_service_account nor GOOGLE_IMPERSONATE_SERVICE_ACCOUNT is set.",
+		)
+	}
+	// The client libraries send every client to an emulator named by the
+	// environment, whatever endpoint they are given; emulator_host itself
+	// conflicting with these is left to its validator.
+	if os.Getenv(envEmulatorHost) != "" {
+		for attribute, value := range map[string]types.String{
+			"spanner_endpoint": config.SpannerEndpoint,
+			"universe_domain":  config.UniverseDomain,
+		} {
+			if value.ValueString() != "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Conflicting Spanner Emulator Host",
+					attribute+" is set, but so is "+envEmulatorHost+", which sends every Spanner client to the emulator. "+
+						"Unset "+envEmulatorHost+", and set emulator_host on the providers meant for the emulator instead.",
+				)
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Get Google Cloud credentials
-	googleCreds, err := utils.GetGoogleCredentials(ctx, config.Project.ValueString(), credentials, accessToken)
+	// Get Google Cloud credentials. Impersonating credentials mint their
+	// tokens through the IAM Credentials API, which needs the cloud-platform
+	// scope rather than the Spanner ones.
+	var scopes []string
+	if targetPrincipal != "" {
+		scopes = []string{utils.CloudPlatformScope}
+	}
+	googleCreds, err := utils.GetGoogleCredentials(ctx, config.Project.ValueString(), credentials, accessToken, scopes...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Resolve Google Cloud Credentials",
@@ -241,23 +442,49 @@ func (p *googleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Every Spanner client acts as the impersonated service account instead,
+	// when one is configured.
+	clientCreds := googleCreds
+	if targetPrincipal != "" {
+		clientCreds, err = utils.ImpersonateGoogleCredentials(ctx, googleCreds, targetPrincipal, delegates, config.UniverseDomain.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("impersonate_service_account"),
+				"Unable to Impersonate Service Account",
+				"Could not impersonate Service Account ("+targetPrincipal+"): "+utils.ErrDetail(err),
+			)
+			return
+		}
+	}
+
+	connOptions := conn.Options{
+		Credentials:    clientCreds,
+		Endpoint:       config.SpannerEndpoint.ValueString(),
+		UniverseDomain: config.UniverseDomain.ValueString(),
+		EmulatorHost:   config.EmulatorHost.ValueString(),
+	}
+
 	// Make the Bigtable and Spanner services available during DataSource and Resource
-	// type Configure methods.
+	// type Configure methods, along with the defaults their project, instance
+	// and database attributes fall back to.
 	providerConfig := &internal.ProviderConfig{
 		GoogleProjectId: config.Project.ValueString(),
+		SpannerInstance: config.Instance.ValueString(),
+		SpannerDatabase: config.Database.ValueString(),
 		// conn.New is the single place the resolved credentials reach every
 		// Spanner client.
 		SpannerService: spannerservices.NewSpannerService(
 			sharedConnection(
-				connectionKey(config.Project.ValueString(), credentials, accessToken, googleCreds),
+				connectionKey(config.Project.ValueString(), credentials, accessToken, targetPrincipal, delegates, connOptions, googleCreds),
 				func() conn.Connection {
-					return conn.New(conn.Options{Credentials: googleCreds})
+					return conn.New(connOptions)
 				},
 			),
 		),
 	}
 	resp.DataSourceData = providerConfig
 	resp.ResourceData = providerConfig
+	resp.ListResourceData = providerConfig
 
 	tflog.Info(ctx, "Done initializing alis provider", map[string]any{"success": true})
 }
@@ -266,20 +493,51 @@ func (p *googleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *googleProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		spanner.NewDatabaseRolesDataSource,
+		spanner.NewDatabaseRolePrivilegesDataSource,
+		spanner.NewDatabaseDdlDataSource,
 		spanner.NewTableIamBindingDataSource,
+		spanner.NewTableIamPolicyDataSource,
+		spanner.NewTableDataSource,
+		spanner.NewTablesDataSource,
+		spanner.NewTableIndexesDataSource,
+		spanner.NewTableConstraintsDataSource,
+		spanner.NewDatabaseSequenceStateDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *googleProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		spanner.NewDatabaseResource,
+		spanner.NewDatabaseOptionsResource,
+		spanner.NewBackupScheduleResource,
 		spanner.NewSpannerTableResource,
 		spanner.NewSpannerTableIndexResource,
 		spanner.NewTableForeignKeyResource,
 		spanner.NewDatabaseRoleResource,
+		spanner.NewDatabaseRoleMembershipResource,
+		spanner.NewDatabaseIamBindingResource,
 		spanner.NewTableIamBindingResource,
+		spanner.NewTableIamPolicyResource,
+		spanner.NewTableIamMemberResource,
 		spanner.NewTableTtlPolicyResource,
 		spanner.NewDatabaseSequenceResource,
+		spanner.NewSchemaResource,
+	}
+}
+
+// ListResources defines the list resources implemented in the provider, used
+// by terraform query to discover existing objects for import. They require
+// Terraform 1.14 or later.
+func (p *googleProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		spanner.NewSpannerTableListResource,
+		spanner.NewSpannerTableIndexListResource,
+		spanner.NewTableForeignKeyListResource,
+		spanner.NewDatabaseRoleListResource,
+		spanner.NewTableIamBindingListResource,
+		spanner.NewTableTtlPolicyListResource,
+		spanner.NewDatabaseSequenceListResource,
 	}
 }
 
@@ -0,0 +1,65 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpannerBackupSchedule_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not implement the backup schedule admin API")
+	const schedule = "tftest-daily"
+	scheduleName := env.DatabaseName + "/backupSchedules/" + schedule
+
+	config := func(retention string) string {
+		return env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_backup_schedule" "test" {
+  project            = %q
+  instance           = %q
+  database           = %q
+  name               = %q
+  cron_spec          = "0 2 * * *"
+  retention_duration = %q
+}
+`, env.Project, env.Instance, env.Database, schedule, retention)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy: acctest.CheckNotFound("backup schedule", schedule, func() error {
+			_, err := env.Service.GetSpannerBackupSchedule(t.Context(), scheduleName)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config("604800s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_backup_schedule.test", "backup_type", "full"),
+					resource.TestCheckResourceAttr("alis_google_spanner_backup_schedule.test", "retention_duration", "604800s"),
+					resource.TestCheckNoResourceAttr("alis_google_spanner_backup_schedule.test", "encryption_config"),
+				),
+			},
+			{
+				Config: config("86400s"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_backup_schedule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("alis_google_spanner_backup_schedule.test", "retention_duration", "86400s"),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_backup_schedule.test",
+				ImportState:                          true,
+				ImportStateId:                        scheduleName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpannerDatabase_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	// The resource creates its own database, so it takes a sibling of the
+	// one Setup provisioned rather than colliding with it.
+	database := env.Database + "-db"
+	databaseName := fmt.Sprintf("projects/%s/instances/%s/databases/%s", env.Project, env.Instance, database)
+
+	config := func(dropProtection bool) string {
+		return env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database" "test" {
+  project  = %q
+  instance = %q
+  name     = %q
+  extra_statements = [
+    "CREATE TABLE tftest_seeded (id INT64 NOT NULL) PRIMARY KEY (id)",
+  ]
+  enable_drop_protection = %t
+}
+`, env.Project, env.Instance, database, dropProtection)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy: acctest.CheckNotFound("database", database, func() error {
+			_, err := env.Service.GetSpannerDatabase(t.Context(), databaseName)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_database.test", "dialect", "GOOGLE_STANDARD_SQL"),
+					resource.TestCheckResourceAttr("alis_google_spanner_database.test", "enable_drop_protection", "true"),
+					resource.TestCheckResourceAttr("alis_google_spanner_database.test", "state", "READY"),
+					resource.TestCheckResourceAttrSet("alis_google_spanner_database.test", "create_time"),
+				),
+			},
+			{
+				// Drop protection is the one in-place update; turning it off
+				// here is also what lets the final destroy succeed.
+				Config: config(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_database.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("alis_google_spanner_database.test", "enable_drop_protection", "false"),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_database.test",
+				ImportState:                          true,
+				ImportStateId:                        databaseName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				// Creation-only inputs are not reported back by Spanner.
+				ImportStateVerifyIgnore: []string{"extra_statements", "proto_descriptors"},
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpannerDatabaseDdlDataSource_basic(t *testing.T) {
+	env := acctest.Setup(t)
+
+	config := env.ProviderBlock() + fmt.Sprintf(`
+data "alis_google_spanner_database_ddl" "schema" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+}
+`, env.Project, env.Instance, env.Database)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.alis_google_spanner_database_ddl.schema", "fingerprint", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttrSet("data.alis_google_spanner_database_ddl.schema", "statements.#"),
+				),
+			},
+		},
+	})
+}
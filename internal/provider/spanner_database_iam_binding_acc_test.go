@@ -0,0 +1,85 @@
+package provider_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpannerDatabaseIamBinding_fineGrainedAccess(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not implement the database IAM policy API")
+	// IAM rejects principals that do not exist, so the member must be real.
+	member := os.Getenv("SPANNER_IAM_MEMBER")
+	if member == "" {
+		t.Skip("set SPANNER_IAM_MEMBER to a principal, e.g. serviceAccount:{email}, to run database IAM binding tests")
+	}
+	const role = "tftest_fgac_role"
+	databaseRoleUser := env.DatabaseName + "/iamBindings/" + services.DatabaseIamRole_DatabaseRoleUser + "/conditions/" + role
+
+	config := func(expression string) string {
+		return env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database_iam_binding" "fgac_user" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[5]q
+  members  = [%[4]q]
+}
+
+resource "alis_google_spanner_database_iam_binding" "role_user" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[6]q
+  members  = [%[4]q]
+
+  condition = {
+    title      = %[7]q
+    expression = %[8]q
+  }
+}
+`, env.Project, env.Instance, env.Database, member,
+			services.DatabaseIamRole_FineGrainedAccessUser, services.DatabaseIamRole_DatabaseRoleUser, role, expression)
+	}
+	roleExpression := fmt.Sprintf(`resource.type == "spanner.googleapis.com/DatabaseRole" && resource.name.endsWith("/databaseRoles/%s")`, role)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy: acctest.CheckNotFound("database IAM binding", databaseRoleUser, func() error {
+			_, err := env.Service.GetDatabaseIamBinding(t.Context(), databaseRoleUser)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config(roleExpression),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("alis_google_spanner_database_iam_binding.fgac_user", "members.*", member),
+					resource.TestCheckResourceAttr("alis_google_spanner_database_iam_binding.role_user", "condition.expression", roleExpression),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_database_iam_binding.role_user",
+				ImportState:                          true,
+				ImportStateId:                        databaseRoleUser,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "role",
+			},
+			{
+				// Editing the expression keeps the binding's identity.
+				Config: config(roleExpression + ` || resource.name.endsWith("/databaseRoles/tftest_other_role")`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_database_iam_binding.role_user", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,73 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpannerDatabaseOptions_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not apply ALTER DATABASE SET OPTIONS or report INFORMATION_SCHEMA.DATABASE_OPTIONS")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database_options" "test" {
+  project                  = %q
+  instance                 = %q
+  database                 = %q
+  version_retention_period = "2d"
+  optimizer_version        = 5
+}
+`, env.Project, env.Instance, env.Database),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_database_options.test", "version_retention_period", "2d"),
+					resource.TestCheckResourceAttr("alis_google_spanner_database_options.test", "optimizer_version", "5"),
+				),
+			},
+			{
+				// Dropping optimizer_version from config resets it in place.
+				Config: env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database_options" "test" {
+  project                  = %q
+  instance                 = %q
+  database                 = %q
+  version_retention_period = "3d"
+}
+`, env.Project, env.Instance, env.Database),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_database_options.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_database_options.test", "version_retention_period", "3d"),
+					resource.TestCheckNoResourceAttr("alis_google_spanner_database_options.test", "optimizer_version"),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_database_options.test",
+				ImportState:                          true,
+				ImportStateId:                        env.DatabaseName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "database",
+				// Import adopts every option the database reports, including
+				// ones this config leaves to Spanner's defaults.
+				ImportStateVerifyIgnore: []string{
+					"optimizer_version",
+					"optimizer_statistics_package",
+					"default_leader",
+					"default_sequence_kind",
+					"default_time_zone",
+				},
+			},
+		},
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"terraform-provider-alis/internal/acctest"
+	"terraform-provider-alis/internal/spanner/services"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccSpannerDatabaseRole_basic(t *testing.T) {
@@ -59,3 +61,55 @@ data "alis_google_spanner_database_roles" "all" {
 		},
 	})
 }
+
+// A role that still holds a grant made outside Terraform cannot be dropped;
+// force_destroy revokes the grant in the same batch instead.
+func TestAccSpannerDatabaseRole_forceDestroy(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNoRoleListing(t)
+	env.SkipIfNotLive(t, "emulator does not surface INFORMATION_SCHEMA.TABLE_PRIVILEGES, which force_destroy reads")
+	const (
+		table = "tftest_force_destroy_table"
+		role  = "tftest_force_destroy_role"
+	)
+
+	tableConfig := env.ProviderBlock() + baseTableConfig(env, "base", table)
+	config := tableConfig + fmt.Sprintf(`
+resource "alis_google_spanner_database_role" "test" {
+  project       = %[1]q
+  instance      = %[2]q
+  database      = %[3]q
+  role          = %[4]q
+  force_destroy = true
+}
+`, env.Project, env.Instance, env.Database, role)
+
+	roleGone := acctest.CheckNotFound("role", role, func() error {
+		_, err := env.Service.GetDatabaseRole(t.Context(), env.DatabaseName+"/databaseRoles/"+role)
+		return err
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy:             roleGone,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_database_role.test", "force_destroy", "true"),
+					func(*terraform.State) error {
+						_, err := env.Service.AddTableIamMember(t.Context(), env.DatabaseName+"/tables/"+table, &services.TablePolicyMember{
+							Role:       role,
+							Permission: services.TablePolicyBindingPermission_SELECT,
+						})
+						return err
+					},
+				),
+			},
+			{
+				Config: tableConfig,
+				Check:  roleGone,
+			},
+		},
+	})
+}
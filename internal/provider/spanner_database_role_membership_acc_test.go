@@ -0,0 +1,69 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpannerDatabaseRoleMembership_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	// The role resources read back through the role listing API.
+	env.SkipIfNoRoleListing(t)
+	const (
+		parent = "tftest_parent_role"
+		child  = "tftest_child_role"
+	)
+	membershipName := fmt.Sprintf("%s/databaseRoles/%s/members/%s", env.DatabaseName, parent, child)
+
+	config := env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database_role" "parent" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[4]q
+}
+
+resource "alis_google_spanner_database_role" "child" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[5]q
+}
+
+resource "alis_google_spanner_database_role_membership" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = alis_google_spanner_database_role.parent.role
+  member   = alis_google_spanner_database_role.child.role
+}
+`, env.Project, env.Instance, env.Database, parent, child)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy: acctest.CheckNotFound("role membership", membershipName, func() error {
+			_, err := env.Service.GetDatabaseRoleMembership(t.Context(), membershipName)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_database_role_membership.test", "role", parent),
+					resource.TestCheckResourceAttr("alis_google_spanner_database_role_membership.test", "member", child),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_database_role_membership.test",
+				ImportState:                          true,
+				ImportStateId:                        membershipName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "member",
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpannerDatabaseRolePrivilegesDataSource_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not surface INFORMATION_SCHEMA.TABLE_PRIVILEGES, which the report reads")
+	// The report checks the role exists through the role listing API.
+	env.SkipIfNoRoleListing(t)
+	const (
+		table  = "tftest_role_report_table"
+		parent = "tftest_report_parent"
+		child  = "tftest_report_child"
+	)
+
+	config := env.ProviderBlock() + baseTableConfig(env, "base", table) + fmt.Sprintf(`
+resource "alis_google_spanner_database_role" "parent" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[4]q
+}
+
+resource "alis_google_spanner_database_role" "child" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[5]q
+}
+
+resource "alis_google_spanner_database_role_membership" "child" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = alis_google_spanner_database_role.parent.role
+  member   = alis_google_spanner_database_role.child.role
+}
+
+resource "alis_google_spanner_table_iam_member" "parent_select" {
+  project    = %[1]q
+  instance   = %[2]q
+  database   = %[3]q
+  table      = alis_google_spanner_table.base.name
+  role       = alis_google_spanner_database_role.parent.role
+  permission = "SELECT"
+}
+
+resource "alis_google_spanner_table_iam_member" "child_update" {
+  project    = %[1]q
+  instance   = %[2]q
+  database   = %[3]q
+  table      = alis_google_spanner_table.base.name
+  role       = alis_google_spanner_database_role.child.role
+  permission = "UPDATE"
+  columns    = ["display_name"]
+}
+
+data "alis_google_spanner_database_role_privileges" "child" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = alis_google_spanner_database_role.child.role
+
+  depends_on = [
+    alis_google_spanner_database_role_membership.child,
+    alis_google_spanner_table_iam_member.parent_select,
+    alis_google_spanner_table_iam_member.child_update,
+  ]
+}
+`, env.Project, env.Instance, env.Database, parent, child)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.alis_google_spanner_database_role_privileges.child", "inherited_roles.*", parent),
+					resource.TestCheckTypeSetElemNestedAttrs("data.alis_google_spanner_database_role_privileges.child", "privileges.*", map[string]string{
+						"object_type": "COLUMN",
+						"object":      table,
+						"column":      "display_name",
+						"privilege":   "UPDATE",
+						"granted_via": child,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.alis_google_spanner_database_role_privileges.child", "privileges.*", map[string]string{
+						"object_type": "TABLE",
+						"object":      table,
+						"privilege":   "SELECT",
+						"granted_via": parent,
+					}),
+				),
+			},
+		},
+	})
+}
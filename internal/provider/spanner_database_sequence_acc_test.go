@@ -54,6 +54,8 @@ resource "alis_google_spanner_database_sequence" "test" {
 					),
 					resource.TestCheckResourceAttr("alis_google_spanner_database_sequence.test", "options.skip_range.min", "1000"),
 					resource.TestCheckResourceAttr("alis_google_spanner_database_sequence.test", "options.start_with_counter", "1000"),
+					// Nothing has drawn from the sequence yet.
+					resource.TestCheckNoResourceAttr("alis_google_spanner_database_sequence.test", "internal_counter"),
 				),
 			},
 			{
@@ -85,3 +87,40 @@ resource "alis_google_spanner_database_sequence" "test" {
 		},
 	})
 }
+
+func TestAccSpannerDatabaseSequenceStateDataSource_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	const sequence = "tftest_seq_state"
+
+	config := env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database_sequence" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  sequence = %[4]q
+  options = {
+    sequence_kind = "bit_reversed_positive"
+  }
+}
+
+data "alis_google_spanner_database_sequence_state" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  sequence = alis_google_spanner_database_sequence.test.sequence
+}
+`, env.Project, env.Instance, env.Database, sequence)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alis_google_spanner_database_sequence_state.test", "sequence", sequence),
+					resource.TestCheckNoResourceAttr("data.alis_google_spanner_database_sequence_state.test", "internal_counter"),
+				),
+			},
+		},
+	})
+}
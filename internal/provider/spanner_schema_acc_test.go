@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpannerSchema_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	// The resource drops every table the DDL does not declare, so it gets a
+	// database of its own rather than the shared one.
+	database := env.Database + "-schema"
+	databaseName := fmt.Sprintf("projects/%s/instances/%s/databases/%s", env.Project, env.Instance, database)
+
+	config := func(ddl string) string {
+		return env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_database" "test" {
+  project                = %q
+  instance               = %q
+  name                   = %q
+  enable_drop_protection = false
+}
+
+resource "alis_google_spanner_schema" "test" {
+  project  = alis_google_spanner_database.test.project
+  instance = alis_google_spanner_database.test.instance
+  database = alis_google_spanner_database.test.name
+  ddl      = [%q]
+}
+`, env.Project, env.Instance, database, ddl)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+CREATE TABLE tftest_users (id STRING(36) NOT NULL, name STRING(100)) PRIMARY KEY (id);
+CREATE INDEX tftest_users_by_name ON tftest_users (name);
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_schema.test", "migration_ddl.#", "2"),
+				),
+			},
+			{
+				// Widening a column is an in-place ALTER, previewed at plan time.
+				Config: config(`
+CREATE TABLE tftest_users (id STRING(36) NOT NULL, name STRING(200)) PRIMARY KEY (id);
+CREATE INDEX tftest_users_by_name ON tftest_users (name);
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_schema.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_schema.test", "migration_ddl.#", "1"),
+					resource.TestCheckResourceAttr("alis_google_spanner_schema.test", "migration_ddl.0", "ALTER TABLE `tftest_users` ALTER COLUMN `name` STRING(200)"),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_schema.test",
+				ImportState:                          true,
+				ImportStateId:                        databaseName,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "database",
+				// Import renders the live schema as canonical DDL, one
+				// statement per element, and has no migration to report.
+				ImportStateVerifyIgnore: []string{"ddl", "migration_ddl"},
+			},
+		},
+	})
+}
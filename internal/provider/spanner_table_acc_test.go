@@ -182,6 +182,81 @@ resource "alis_google_spanner_table" "test" {
 	})
 }
 
+func TestAccSpannerTable_descendingKey(t *testing.T) {
+	env := acctest.Setup(t)
+	const table = "tftest_desc_key"
+
+	// The key is (tenant_id, create_time DESC) although create_time is
+	// declared first, exercising both key_position and key_order.
+	config := func(order string) string {
+		return env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_table" "test" {
+  project         = %q
+  instance        = %q
+  database        = %q
+  name            = %q
+  prevent_destroy = false
+  schema = {
+    columns = [
+      {
+        name           = "create_time",
+        type           = "TIMESTAMP",
+        is_primary_key = true,
+        required       = true,
+        key_position   = 2,
+        key_order      = %q,
+      },
+      {
+        name           = "tenant_id",
+        type           = "STRING",
+        size           = 36,
+        is_primary_key = true,
+        required       = true,
+      },
+      {
+        name = "payload",
+        type = "STRING",
+      },
+    ]
+  }
+}
+`, env.Project, env.Instance, env.Database, table, order)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy:             checkTableDestroy(env, t, table),
+		Steps: []resource.TestStep{
+			{
+				Config: config("desc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_table.test", "schema.columns.0.key_order", "desc"),
+					resource.TestCheckResourceAttr("alis_google_spanner_table.test", "schema.columns.0.key_position", "2"),
+					resource.TestCheckNoResourceAttr("alis_google_spanner_table.test", "schema.columns.1.key_position"),
+				),
+			},
+			{
+				// Refresh must read the key back without drift.
+				Config: config("desc"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// A key column's sort order cannot be altered in place.
+				Config: config("asc"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_table.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccSpannerTable_preventDestroyGuard(t *testing.T) {
 	env := acctest.Setup(t)
 	const table = "tftest_guarded"
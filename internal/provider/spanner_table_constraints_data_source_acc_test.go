@@ -0,0 +1,134 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpannerTableIndexesAndConstraintsDataSources_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	const (
+		usersTable  = "tftest_ds_users"
+		ordersTable = "tftest_ds_orders"
+	)
+
+	config := env.ProviderBlock() + fmt.Sprintf(`
+resource "alis_google_spanner_table" "users" {
+  project         = %[1]q
+  instance        = %[2]q
+  database        = %[3]q
+  name            = %[4]q
+  prevent_destroy = false
+  schema = {
+    columns = [
+      {
+        name           = "id",
+        type           = "INT64",
+        is_primary_key = true,
+        required       = true,
+      },
+    ]
+  }
+}
+
+resource "alis_google_spanner_table" "orders" {
+  project         = %[1]q
+  instance        = %[2]q
+  database        = %[3]q
+  name            = %[5]q
+  prevent_destroy = false
+  schema = {
+    columns = [
+      {
+        name           = "id",
+        type           = "INT64",
+        is_primary_key = true,
+        required       = true,
+      },
+      {
+        name     = "user_id",
+        type     = "INT64",
+        required = true,
+      },
+    ]
+  }
+}
+
+resource "alis_google_spanner_table_foreign_key" "orders_user" {
+  project           = %[1]q
+  instance          = %[2]q
+  database          = %[3]q
+  table             = alis_google_spanner_table.orders.name
+  name              = "FK_tftest_ds_orders_user"
+  column            = "user_id"
+  referenced_table  = alis_google_spanner_table.users.name
+  referenced_column = "id"
+}
+
+resource "alis_google_spanner_table_index" "orders_by_user" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  table    = alis_google_spanner_table.orders.name
+  name     = "tftest_ds_orders_by_user"
+  columns = [
+    {
+      name  = "user_id",
+      order = "desc",
+    },
+  ]
+}
+
+data "alis_google_spanner_table_indexes" "orders" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  table    = alis_google_spanner_table_index.orders_by_user.table
+}
+
+data "alis_google_spanner_table_constraints" "orders" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  table    = alis_google_spanner_table_foreign_key.orders_user.table
+}
+`, env.Project, env.Instance, env.Database, usersTable, ordersTable)
+
+	const (
+		indexes     = "data.alis_google_spanner_table_indexes.orders"
+		constraints = "data.alis_google_spanner_table_constraints.orders"
+	)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Spanner backs the foreign key with its own managed
+					// index, which is listed alongside the declared one.
+					resource.TestCheckTypeSetElemNestedAttrs(indexes, "indexes.*", map[string]string{
+						"name":            "tftest_ds_orders_by_user",
+						"columns.#":       "1",
+						"columns.0.name":  "user_id",
+						"columns.0.order": "desc",
+						"unique":          "false",
+						"storing.#":       "0",
+					}),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.#", "1"),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.0.name", "FK_tftest_ds_orders_user"),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.0.columns.0", "user_id"),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.0.referenced_table", usersTable),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.0.referenced_columns.0", "id"),
+					resource.TestCheckResourceAttr(constraints, "foreign_keys.0.enforced", "true"),
+					// The NOT NULL columns do not surface as checks.
+					resource.TestCheckResourceAttr(constraints, "checks.#", "0"),
+				),
+			},
+		},
+	})
+}
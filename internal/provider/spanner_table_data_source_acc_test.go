@@ -0,0 +1,52 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpannerTableDataSources_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	const table = "tftest_ds_table"
+
+	config := tableConfig(env, table, 255, false) + fmt.Sprintf(`
+data "alis_google_spanner_table" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  name     = alis_google_spanner_table.test.name
+}
+
+data "alis_google_spanner_tables" "test" {
+  project    = %[1]q
+  instance   = %[2]q
+  database   = %[3]q
+  name_regex = "^${alis_google_spanner_table.test.name}$"
+}
+`, env.Project, env.Instance, env.Database)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		CheckDestroy:             checkTableDestroy(env, t, table),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table.test", "schema.columns.#", "4"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table.test", "schema.columns.1.name", "display_name"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table.test", "schema.columns.1.size", "255"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table.test", "primary_key.#", "1"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table.test", "primary_key.0", "id"),
+					resource.TestCheckNoResourceAttr("data.alis_google_spanner_table.test", "interleave"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_tables.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_tables.test", "names.0", table),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_tables.test", "tables.0.name", table),
+				),
+			},
+		},
+	})
+}
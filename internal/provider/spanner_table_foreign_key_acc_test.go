@@ -67,7 +67,7 @@ resource "alis_google_spanner_table" "orders" {
 
 `, env.Project, env.Instance, env.Database, usersTable, ordersTable)
 
-	config := func(onDelete string) string {
+	config := func(onDelete string, enforced bool) string {
 		return tablesOnly + fmt.Sprintf(`
 resource "alis_google_spanner_table_foreign_key" "test" {
   project           = %[1]q
@@ -79,8 +79,9 @@ resource "alis_google_spanner_table_foreign_key" "test" {
   referenced_table  = alis_google_spanner_table.users.name
   referenced_column = "id"
   on_delete         = %[5]q
+  enforced          = %[6]t
 }
-`, env.Project, env.Instance, env.Database, fkName, onDelete)
+`, env.Project, env.Instance, env.Database, fkName, onDelete, enforced)
 	}
 
 	foreignKeyGone := acctest.CheckNotFound("foreign key", fkName, func() error {
@@ -93,20 +94,31 @@ resource "alis_google_spanner_table_foreign_key" "test" {
 		CheckDestroy:             foreignKeyGone,
 		Steps: []resource.TestStep{
 			{
-				Config: config("CASCADE"),
+				Config: config("CASCADE", true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "name", fkName),
 					resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "table", ordersTable),
 					resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "referenced_table", usersTable),
 					resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "on_delete", "CASCADE"),
+					resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "enforced", "true"),
 				),
 			},
 			{
-				// Every foreign-key attribute requires replacement.
-				Config: config("NO ACTION"),
+				// enforced and on_delete are recreated in place by Update
+				// rather than replaced.
+				Config: config("CASCADE", false),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("alis_google_spanner_table_foreign_key.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("alis_google_spanner_table_foreign_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "enforced", "false"),
+			},
+			{
+				Config: config("NO ACTION", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_table_foreign_key.test", plancheck.ResourceActionUpdate),
 					},
 				},
 				Check: resource.TestCheckResourceAttr("alis_google_spanner_table_foreign_key.test", "on_delete", "NO ACTION"),
@@ -28,7 +28,7 @@ resource "alis_google_spanner_database_role" "grantee" {
 }
 `, env.Project, env.Instance, env.Database, role)
 
-	config := func(permissions string) string {
+	config := func(permissions, columns string) string {
 		return tableAndRole + fmt.Sprintf(`
 resource "alis_google_spanner_table_iam_binding" "test" {
   project     = %[1]q
@@ -37,6 +37,7 @@ resource "alis_google_spanner_table_iam_binding" "test" {
   table       = alis_google_spanner_table.base.name
   role        = alis_google_spanner_database_role.grantee.role
   permissions = [%[5]s]
+  columns     = %[6]s
 }
 
 data "alis_google_spanner_table_iam_binding" "test" {
@@ -48,7 +49,7 @@ data "alis_google_spanner_table_iam_binding" "test" {
 
   depends_on = [alis_google_spanner_table_iam_binding.test]
 }
-`, env.Project, env.Instance, env.Database, role, permissions)
+`, env.Project, env.Instance, env.Database, role, permissions, columns)
 	}
 
 	parent := env.DatabaseName + "/tables/" + table
@@ -84,7 +85,7 @@ data "alis_google_spanner_table_iam_binding" "test" {
 		CheckDestroy:             bindingGone,
 		Steps: []resource.TestStep{
 			{
-				Config: config(`"SELECT", "INSERT", "UPDATE", "DELETE"`),
+				Config: config(`"SELECT", "INSERT", "UPDATE", "DELETE"`, "null"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_binding.test", "role", role),
 					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_binding.test", "permissions.#", "4"),
@@ -96,7 +97,7 @@ data "alis_google_spanner_table_iam_binding" "test" {
 				// Dropping a permission is an in-place update, and it must
 				// REVOKE: a grant-only update would leave DELETE in place and
 				// state permanently out of step with the database.
-				Config: config(`"SELECT", "INSERT", "UPDATE"`),
+				Config: config(`"SELECT", "INSERT", "UPDATE"`, "null"),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectResourceAction("alis_google_spanner_table_iam_binding.test", plancheck.ResourceActionUpdate),
@@ -107,6 +108,21 @@ data "alis_google_spanner_table_iam_binding" "test" {
 					grantedPermissions(3),
 				),
 			},
+			{
+				// Narrowing SELECT to columns replaces the table-wide grant
+				// in place, and reads back from COLUMN_PRIVILEGES.
+				Config: config(`"SELECT", "INSERT", "UPDATE"`, `{ SELECT = ["id", "display_name"] }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_table_iam_binding.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_binding.test", "columns.SELECT.#", "2"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table_iam_binding.test", "columns.SELECT.#", "2"),
+					grantedPermissions(3),
+				),
+			},
 			{
 				ResourceName:                         "alis_google_spanner_table_iam_binding.test",
 				ImportState:                          true,
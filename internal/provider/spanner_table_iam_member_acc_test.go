@@ -0,0 +1,99 @@
+package provider_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccSpannerTableIamMember_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not surface INFORMATION_SCHEMA.TABLE_PRIVILEGES, which member reads require")
+	const (
+		table = "tftest_iam_member_table"
+		role  = "tftest_member_role"
+	)
+	parent := env.DatabaseName + "/tables/" + table
+
+	tableAndRole := env.ProviderBlock() + baseTableConfig(env, "base", table) + fmt.Sprintf(`
+resource "alis_google_spanner_database_role" "shared" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[4]q
+}
+`, env.Project, env.Instance, env.Database, role)
+
+	member := func(label, permission, columns string) string {
+		return fmt.Sprintf(`
+resource "alis_google_spanner_table_iam_member" %[4]q {
+  project    = %[1]q
+  instance   = %[2]q
+  database   = %[3]q
+  table      = alis_google_spanner_table.base.name
+  role       = alis_google_spanner_database_role.shared.role
+  permission = %[5]q
+  columns    = %[6]s
+}
+`, env.Project, env.Instance, env.Database, label, permission, columns)
+	}
+	selectMember := member("select", "SELECT", `["id", "display_name"]`)
+	insertMember := member("insert", "INSERT", "null")
+
+	// Read the permissions Spanner actually holds for the shared role.
+	heldPermissions := func(want ...services.TablePolicyBindingPermission) func(*terraform.State) error {
+		return func(*terraform.State) error {
+			for _, permission := range services.TablePolicyBindingPermissions {
+				_, err := env.Service.GetTableIamMember(t.Context(), parent, role, permission)
+				held := err == nil
+				if err != nil && status.Code(err) != codes.NotFound {
+					return fmt.Errorf("reading %s of %q: %w", permission, role, err)
+				}
+				if wanted := slices.Contains(want, permission); held != wanted {
+					return fmt.Errorf("role %q holds %s in Spanner: %t, want %t", role, permission, held, wanted)
+				}
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: tableAndRole + selectMember + insertMember,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_member.select", "columns.#", "2"),
+					resource.TestCheckNoResourceAttr("alis_google_spanner_table_iam_member.insert", "columns"),
+					heldPermissions(services.TablePolicyBindingPermission_SELECT, services.TablePolicyBindingPermission_INSERT),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_table_iam_member.select",
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s/tableRoles/%s/permissions/SELECT", parent, role),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "permission",
+			},
+			{
+				// Destroying one member is the point of the resource: it
+				// revokes its own permission and leaves the other's grant.
+				Config: tableAndRole + selectMember,
+				Check:  heldPermissions(services.TablePolicyBindingPermission_SELECT),
+			},
+			{
+				Config: tableAndRole,
+				Check:  heldPermissions(),
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-alis/internal/acctest"
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSpannerTableIamPolicy_basic(t *testing.T) {
+	env := acctest.Setup(t)
+	env.SkipIfNotLive(t, "emulator does not surface INFORMATION_SCHEMA.TABLE_PRIVILEGES, which policy reads require")
+	const (
+		table    = "tftest_iam_policy_table"
+		reader   = "tftest_policy_reader"
+		intruder = "tftest_policy_intruder"
+	)
+	parent := env.DatabaseName + "/tables/" + table
+
+	tableAndRoles := env.ProviderBlock() + baseTableConfig(env, "base", table) + fmt.Sprintf(`
+resource "alis_google_spanner_database_role" "reader" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[4]q
+}
+
+resource "alis_google_spanner_database_role" "intruder" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  role     = %[5]q
+}
+`, env.Project, env.Instance, env.Database, reader, intruder)
+
+	config := tableAndRoles + fmt.Sprintf(`
+resource "alis_google_spanner_table_iam_policy" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  table    = alis_google_spanner_table.base.name
+
+  bindings = [
+    {
+      role        = alis_google_spanner_database_role.reader.role
+      permissions = ["SELECT"]
+    },
+  ]
+}
+
+data "alis_google_spanner_table_iam_policy" "test" {
+  project  = %[1]q
+  instance = %[2]q
+  database = %[3]q
+  table    = alis_google_spanner_table.base.name
+
+  depends_on = [alis_google_spanner_table_iam_policy.test]
+}
+`, env.Project, env.Instance, env.Database)
+
+	// Read the roles Spanner actually grants on the table, not the ones state
+	// claims.
+	grantedRoles := func(want ...string) func(*terraform.State) error {
+		return func(*terraform.State) error {
+			policy, err := env.Service.GetTableIamPolicy(t.Context(), parent)
+			if err != nil {
+				return fmt.Errorf("reading IAM policy of %q: %w", parent, err)
+			}
+			var got []string
+			for _, binding := range policy.Bindings {
+				got = append(got, binding.Role)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				return fmt.Errorf("roles %v hold privileges on %q in Spanner, want %v", got, table, want)
+			}
+
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_policy.test", "bindings.#", "1"),
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_policy.test", "revocations.#", "0"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table_iam_policy.test", "bindings.#", "1"),
+					resource.TestCheckResourceAttr("data.alis_google_spanner_table_iam_policy.test", "bindings.0.role", reader),
+					grantedRoles(reader),
+				),
+			},
+			{
+				// A grant made out of band is drift the policy owns: the plan
+				// lists its REVOKE and the apply runs it.
+				PreConfig: func() {
+					if _, err := env.Service.SetTableIamBinding(t.Context(), parent, &services.TablePolicyBinding{
+						Role:        intruder,
+						Permissions: []services.TablePolicyBindingPermission{services.TablePolicyBindingPermission_INSERT},
+					}); err != nil {
+						t.Fatalf("granting %q out of band: %v", intruder, err)
+					}
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("alis_google_spanner_table_iam_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_policy.test", "revocations.#", "1"),
+					resource.TestCheckResourceAttr("alis_google_spanner_table_iam_policy.test", "revocations.0",
+						fmt.Sprintf("REVOKE INSERT ON TABLE %s FROM ROLE %s", table, intruder)),
+					grantedRoles(reader),
+				),
+			},
+			{
+				ResourceName:                         "alis_google_spanner_table_iam_policy.test",
+				ImportState:                          true,
+				ImportStateId:                        parent,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "table",
+				// State keeps the revocations of the last apply; a read
+				// revokes nothing.
+				ImportStateVerifyIgnore: []string{"revocations"},
+			},
+			{
+				// Dropping the policy while keeping the table proves Delete
+				// revokes every grant on it.
+				Config: tableAndRoles,
+				Check:  grantedRoles(),
+			},
+		},
+	})
+}
@@ -64,11 +64,12 @@ resource "alis_google_spanner_table_index" "test" {
 				),
 			},
 			{
-				// Every index attribute requires replacement.
+				// A column change rebuilds the index in place: one DDL batch
+				// from Update, not a destroy followed by a create.
 				Config: config(false),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						plancheck.ExpectResourceAction("alis_google_spanner_table_index.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectResourceAction("alis_google_spanner_table_index.test", plancheck.ResourceActionUpdate),
 					},
 				},
 				Check: resource.TestCheckResourceAttr("alis_google_spanner_table_index.test", "columns.#", "1"),
@@ -0,0 +1,523 @@
+package spanner
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/services"
+	"terraform-provider-alis/internal/utils"
+	"terraform-provider-alis/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &backupScheduleResource{}
+	_ resource.ResourceWithConfigure      = &backupScheduleResource{}
+	_ resource.ResourceWithImportState    = &backupScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &backupScheduleResource{}
+	_ resource.ResourceWithModifyPlan     = &backupScheduleResource{}
+)
+
+// Backup type attribute values.
+const (
+	backupTypeFull        = "full"
+	backupTypeIncremental = "incremental"
+)
+
+// NewBackupScheduleResource is a helper function to simplify the provider implementation.
+func NewBackupScheduleResource() resource.Resource {
+	return &backupScheduleResource{}
+}
+
+type backupScheduleResource struct {
+	config *internal.ProviderConfig
+}
+
+type backupScheduleModel struct {
+	Project           types.String                    `tfsdk:"project"`
+	Instance          types.String                    `tfsdk:"instance"`
+	Database          types.String                    `tfsdk:"database"`
+	Name              types.String                    `tfsdk:"name"`
+	BackupType        types.String                    `tfsdk:"backup_type"`
+	CronSpec          types.String                    `tfsdk:"cron_spec"`
+	RetentionDuration types.String                    `tfsdk:"retention_duration"`
+	EncryptionConfig  *backupScheduleEncryptionConfig `tfsdk:"encryption_config"`
+	Timeouts          timeouts.Value                  `tfsdk:"timeouts"`
+}
+
+type backupScheduleEncryptionConfig struct {
+	EncryptionType types.String `tfsdk:"encryption_type"`
+	KmsKeyName     types.String `tfsdk:"kms_key_name"`
+	KmsKeyNames    types.List   `tfsdk:"kms_key_names"`
+}
+
+// equal reports whether c and other request the same encryption. A nil
+// config stands for USE_DATABASE_ENCRYPTION, the Spanner default.
+func (c *backupScheduleEncryptionConfig) equal(other *backupScheduleEncryptionConfig) bool {
+	if c == nil || other == nil {
+		return c.effectiveType() == other.effectiveType() && c.effectiveType() == services.BackupEncryptionType_UseDatabaseEncryption
+	}
+
+	return c.EncryptionType.Equal(other.EncryptionType) &&
+		c.KmsKeyName.Equal(other.KmsKeyName) &&
+		c.KmsKeyNames.Equal(other.KmsKeyNames)
+}
+
+func (c *backupScheduleEncryptionConfig) effectiveType() string {
+	if c == nil {
+		return services.BackupEncryptionType_UseDatabaseEncryption
+	}
+
+	return c.EncryptionType.ValueString()
+}
+
+// Metadata returns the resource type name.
+func (r *backupScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_backup_schedule"
+}
+
+// Schema defines the schema for the resource.
+func (r *backupScheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID the schedule backs up.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The backup schedule ID within the database. It must start with a lowercase letter, " +
+					"be at most 60 characters long, and contain only lowercase letters, numbers, underscores, or hyphens.\n" +
+					"Changing this forces a new resource.",
+				Validators: []validator.String{
+					validators.RegexMatches([]*regexp.Regexp{
+						utils.Pattern(utils.SpannerBackupScheduleIdRegex),
+					}, "Name must be a valid Spanner backup schedule ID"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The kind of backup the schedule takes. Possible values are `full` and `incremental`; defaults to `full`.\n" +
+					"Changing this forces a new resource.",
+				Default: stringdefault.StaticString(backupTypeFull),
+				Validators: []validator.String{
+					stringvalidator.OneOf(backupTypeFull, backupTypeIncremental),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cron_spec": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "When backups are taken, as a crontab in UTC, e.g. `0 2 * * *` for every day at 02:00 UTC. " +
+					"Spanner starts each backup within a few hours of the scheduled time.",
+			},
+			"retention_duration": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "How long each backup is kept after it is taken, in seconds with an `s` suffix, e.g. `604800s` for 7 days. " +
+					"Must be between 6 hours and 366 days.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9]+s$`), "must be a whole number of seconds followed by s, e.g. 604800s"),
+					validators.DurationStringMinSeconds(int((6 * time.Hour).Seconds())),
+					validators.DurationStringMaxSeconds(int((366 * 24 * time.Hour).Seconds())),
+				},
+			},
+			"encryption_config": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "How the backups are encrypted. When omitted they use the same encryption as the database.",
+				Attributes: map[string]schema.Attribute{
+					"encryption_type": schema.StringAttribute{
+						Required: true,
+						MarkdownDescription: "Possible values are `USE_DATABASE_ENCRYPTION`, `GOOGLE_DEFAULT_ENCRYPTION`, and `CUSTOMER_MANAGED_ENCRYPTION`. " +
+							"`CUSTOMER_MANAGED_ENCRYPTION` requires `kms_key_name` or `kms_key_names`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(services.BackupEncryptionTypes...),
+						},
+					},
+					"kms_key_name": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The Cloud KMS key encrypting the backups, for a regional instance.\n" +
+							"Format: `projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{key}`.",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("kms_key_names")),
+						},
+					},
+					"kms_key_names": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "The Cloud KMS keys encrypting the backups, one per region of a multi-region instance configuration.",
+					},
+				},
+			},
+		},
+		MarkdownDescription: "A Google Cloud Spanner backup schedule, which takes full or incremental backups of a database on a cron schedule " +
+			"and keeps each for a fixed retention period.\n" +
+			"Destroying the schedule stops new backups; backups it already took are kept until they expire.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *backupScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// Create a new resource.
+func (r *backupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan backupScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	scheduleName := backupScheduleName(plan)
+	schedule, diags := backupScheduleFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.config.SpannerService.CreateSpannerBackupSchedule(ctx, scheduleName.DatabaseName().String(), scheduleName.BackupSchedule, schedule)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Backup Schedule",
+			"Could not create Backup Schedule ("+scheduleName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *backupScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state backupScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleName := backupScheduleName(state)
+
+	schedule, err := r.config.SpannerService.GetSpannerBackupSchedule(ctx, scheduleName.String())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Backup Schedule",
+			"Could not read Backup Schedule ("+scheduleName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(refreshBackupScheduleModel(ctx, &state, schedule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update writes the changed schedule fields with an update mask, so a field
+// changed outside Terraform but left alone in configuration is not touched.
+func (r *backupScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan backupScheduleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state backupScheduleModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	scheduleName := backupScheduleName(plan)
+	schedule, diags := backupScheduleFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	schedule.Name = scheduleName.String()
+
+	_, err := r.config.SpannerService.UpdateSpannerBackupSchedule(ctx, schedule, backupScheduleUpdateFields(state, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Backup Schedule",
+			"Could not update Backup Schedule ("+scheduleName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *backupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state backupScheduleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	scheduleName := backupScheduleName(state)
+
+	err := r.config.SpannerService.DeleteSpannerBackupSchedule(ctx, scheduleName.String())
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError(
+			"Error Deleting Backup Schedule",
+			"Could not delete Backup Schedule ("+scheduleName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *backupScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// projects/{project}/instances/{instance}/databases/{database}/backupSchedules/{schedule}
+	importName, err := names.ParseBackupSchedule(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/backupSchedules/{schedule}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), importName.BackupSchedule)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
+
+// ValidateConfig checks at plan time that KMS keys are given exactly when
+// customer-managed encryption is requested.
+func (r *backupScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var encryption *backupScheduleEncryptionConfig
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("encryption_config"), &encryption)...)
+	if resp.Diagnostics.HasError() || encryption == nil || encryption.EncryptionType.IsUnknown() ||
+		encryption.KmsKeyName.IsUnknown() || encryption.KmsKeyNames.IsUnknown() {
+		return
+	}
+
+	hasKey := !encryption.KmsKeyName.IsNull() || !encryption.KmsKeyNames.IsNull()
+	customerManaged := encryption.EncryptionType.ValueString() == services.DatabaseEncryptionType_CustomerManaged
+	switch {
+	case customerManaged && !hasKey:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("encryption_config").AtName("encryption_type"),
+			"Missing KMS Key",
+			services.DatabaseEncryptionType_CustomerManaged+" requires kms_key_name or kms_key_names.",
+		)
+	case !customerManaged && hasKey:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("encryption_config").AtName("encryption_type"),
+			"Unused KMS Key",
+			"kms_key_name and kms_key_names only apply to "+services.DatabaseEncryptionType_CustomerManaged+".",
+		)
+	}
+}
+
+// backupScheduleName builds the schedule's full resource name from model.
+func backupScheduleName(model backupScheduleModel) names.BackupScheduleName {
+	return names.BackupScheduleName{
+		Project:        model.Project.ValueString(),
+		Instance:       model.Instance.ValueString(),
+		Database:       model.Database.ValueString(),
+		BackupSchedule: model.Name.ValueString(),
+	}
+}
+
+// backupScheduleFromModel converts the model's settings; Name is left for
+// the caller.
+func backupScheduleFromModel(ctx context.Context, model backupScheduleModel) (conn.BackupSchedule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An omitted encryption_config is spelled out, so that removing it from
+	// configuration resets the schedule rather than leaving the old setting.
+	schedule := conn.BackupSchedule{
+		CronText:       model.CronSpec.ValueString(),
+		Incremental:    model.BackupType.ValueString() == backupTypeIncremental,
+		EncryptionType: services.BackupEncryptionType_UseDatabaseEncryption,
+	}
+
+	// The schema validators pin the "<seconds>s" form, which ParseDuration reads.
+	retention, err := time.ParseDuration(model.RetentionDuration.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("retention_duration"), "Invalid Retention Duration", err.Error())
+		return schedule, diags
+	}
+	schedule.RetentionDuration = retention
+
+	if model.EncryptionConfig != nil {
+		schedule.EncryptionType = model.EncryptionConfig.EncryptionType.ValueString()
+		schedule.KmsKeyName = model.EncryptionConfig.KmsKeyName.ValueString()
+		if !model.EncryptionConfig.KmsKeyNames.IsNull() {
+			diags.Append(model.EncryptionConfig.KmsKeyNames.ElementsAs(ctx, &schedule.KmsKeyNames, false)...)
+		}
+	}
+
+	return schedule, diags
+}
+
+// refreshBackupScheduleModel copies what Spanner reports onto model. An
+// omitted encryption_config stays omitted while the schedule still uses the
+// database's encryption, the value Spanner reports for it.
+func refreshBackupScheduleModel(ctx context.Context, model *backupScheduleModel, schedule *conn.BackupSchedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.CronSpec = types.StringValue(schedule.CronText)
+	model.RetentionDuration = types.StringValue(fmt.Sprintf("%ds", int64(schedule.RetentionDuration.Seconds())))
+	model.BackupType = types.StringValue(backupTypeFull)
+	if schedule.Incremental {
+		model.BackupType = types.StringValue(backupTypeIncremental)
+	}
+
+	if model.EncryptionConfig == nil && schedule.EncryptionType == services.BackupEncryptionType_UseDatabaseEncryption {
+		return diags
+	}
+
+	encryption := &backupScheduleEncryptionConfig{
+		EncryptionType: types.StringValue(schedule.EncryptionType),
+		KmsKeyName:     types.StringNull(),
+		KmsKeyNames:    types.ListNull(types.StringType),
+	}
+	if schedule.KmsKeyName != "" {
+		encryption.KmsKeyName = types.StringValue(schedule.KmsKeyName)
+	}
+	if len(schedule.KmsKeyNames) > 0 {
+		keys, d := types.ListValueFrom(ctx, types.StringType, schedule.KmsKeyNames)
+		diags.Append(d...)
+		encryption.KmsKeyNames = keys
+	}
+	model.EncryptionConfig = encryption
+
+	return diags
+}
+
+// backupScheduleUpdateFields names the conn.BackupScheduleField* fields that
+// differ between state and plan.
+func backupScheduleUpdateFields(state, plan backupScheduleModel) []string {
+	var fields []string
+	if !plan.CronSpec.Equal(state.CronSpec) {
+		fields = append(fields, conn.BackupScheduleFieldCronText)
+	}
+	if !plan.RetentionDuration.Equal(state.RetentionDuration) {
+		fields = append(fields, conn.BackupScheduleFieldRetentionDuration)
+	}
+	if !plan.EncryptionConfig.equal(state.EncryptionConfig) {
+		fields = append(fields, conn.BackupScheduleFieldEncryptionConfig)
+	}
+
+	return fields
+}
@@ -0,0 +1,150 @@
+package spanner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testBackupScheduleName = "projects/test-project/instances/test-instance/databases/test-db/backupSchedules/daily"
+
+func testBackupScheduleModel() backupScheduleModel {
+	return backupScheduleModel{
+		Project:           types.StringValue("test-project"),
+		Instance:          types.StringValue("test-instance"),
+		Database:          types.StringValue("test-db"),
+		Name:              types.StringValue("daily"),
+		BackupType:        types.StringValue(backupTypeIncremental),
+		CronSpec:          types.StringValue("0 2 * * *"),
+		RetentionDuration: types.StringValue("604800s"),
+	}
+}
+
+// Create, an out-of-band change, refresh, and update, all against the
+// in-memory admin API: the refresh has to surface the drift, and the update
+// has to write back only what the configuration disagrees with.
+func TestBackupSchedule_DriftRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	fake := connfake.New()
+	svc := services.NewSpannerService(fake)
+	plan := testBackupScheduleModel()
+
+	schedule, diags := backupScheduleFromModel(ctx, plan)
+	if diags.HasError() {
+		t.Fatalf("backupScheduleFromModel: %v", diags)
+	}
+	name := backupScheduleName(plan)
+	if name.String() != testBackupScheduleName {
+		t.Fatalf("backupScheduleName = %q, want %q", name.String(), testBackupScheduleName)
+	}
+	if _, err := svc.CreateSpannerBackupSchedule(ctx, name.DatabaseName().String(), name.BackupSchedule, schedule); err != nil {
+		t.Fatalf("CreateSpannerBackupSchedule: %v", err)
+	}
+
+	// Someone shortens the retention in the console.
+	drifted, err := svc.GetSpannerBackupSchedule(ctx, testBackupScheduleName)
+	if err != nil {
+		t.Fatalf("GetSpannerBackupSchedule: %v", err)
+	}
+	drifted.RetentionDuration = 24 * time.Hour
+	fake.SetBackupSchedule(*drifted)
+
+	state := plan
+	read, err := svc.GetSpannerBackupSchedule(ctx, testBackupScheduleName)
+	if err != nil {
+		t.Fatalf("GetSpannerBackupSchedule: %v", err)
+	}
+	if diags := refreshBackupScheduleModel(ctx, &state, read); diags.HasError() {
+		t.Fatalf("refreshBackupScheduleModel: %v", diags)
+	}
+	if got := state.RetentionDuration.ValueString(); got != "86400s" {
+		t.Errorf("refreshed retention_duration = %q, want the drifted 86400s", got)
+	}
+	if state.EncryptionConfig != nil {
+		t.Error("an omitted encryption_config must stay omitted while Spanner reports the database's encryption")
+	}
+	if got := state.BackupType.ValueString(); got != backupTypeIncremental {
+		t.Errorf("refreshed backup_type = %q, want %q", got, backupTypeIncremental)
+	}
+
+	fields := backupScheduleUpdateFields(state, plan)
+	if len(fields) != 1 || fields[0] != conn.BackupScheduleFieldRetentionDuration {
+		t.Fatalf("update fields = %v, want only %s", fields, conn.BackupScheduleFieldRetentionDuration)
+	}
+	schedule.Name = testBackupScheduleName
+	updated, err := svc.UpdateSpannerBackupSchedule(ctx, schedule, fields)
+	if err != nil {
+		t.Fatalf("UpdateSpannerBackupSchedule: %v", err)
+	}
+	if updated.RetentionDuration != 7*24*time.Hour {
+		t.Errorf("retention after update = %v, want 168h", updated.RetentionDuration)
+	}
+}
+
+// Spanner reports a concrete encryption type even when none was requested;
+// a schedule switched to another type outside Terraform must show up.
+func TestRefreshBackupScheduleModel_ReportsChangedEncryption(t *testing.T) {
+	ctx := context.Background()
+	model := testBackupScheduleModel()
+
+	diags := refreshBackupScheduleModel(ctx, &model, &conn.BackupSchedule{
+		Name:              testBackupScheduleName,
+		CronText:          "0 2 * * *",
+		RetentionDuration: time.Hour,
+		EncryptionType:    services.DatabaseEncryptionType_CustomerManaged,
+		KmsKeyName:        "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+	})
+	if diags.HasError() {
+		t.Fatalf("refreshBackupScheduleModel: %v", diags)
+	}
+	if model.EncryptionConfig == nil {
+		t.Fatal("encryption_config not populated")
+	}
+	if got := model.EncryptionConfig.EncryptionType.ValueString(); got != services.DatabaseEncryptionType_CustomerManaged {
+		t.Errorf("encryption_type = %q", got)
+	}
+	if got := model.RetentionDuration.ValueString(); got != "3600s" {
+		t.Errorf("retention_duration = %q, want 3600s", got)
+	}
+}
+
+// Removing encryption_config from configuration has to reset the schedule;
+// an explicit USE_DATABASE_ENCRYPTION is the same request as omitting it.
+func TestBackupScheduleUpdateFields_Encryption(t *testing.T) {
+	base := testBackupScheduleModel()
+
+	explicitDefault := base
+	explicitDefault.EncryptionConfig = &backupScheduleEncryptionConfig{
+		EncryptionType: types.StringValue(services.BackupEncryptionType_UseDatabaseEncryption),
+		KmsKeyName:     types.StringNull(),
+		KmsKeyNames:    types.ListNull(types.StringType),
+	}
+	if fields := backupScheduleUpdateFields(explicitDefault, base); len(fields) != 0 {
+		t.Errorf("explicit default vs omitted: fields = %v, want none", fields)
+	}
+
+	googleDefault := base
+	googleDefault.EncryptionConfig = &backupScheduleEncryptionConfig{
+		EncryptionType: types.StringValue(services.DatabaseEncryptionType_GoogleDefaultEncryption),
+		KmsKeyName:     types.StringNull(),
+		KmsKeyNames:    types.ListNull(types.StringType),
+	}
+	fields := backupScheduleUpdateFields(googleDefault, base)
+	if len(fields) != 1 || fields[0] != conn.BackupScheduleFieldEncryptionConfig {
+		t.Errorf("removing encryption_config: fields = %v, want only %s", fields, conn.BackupScheduleFieldEncryptionConfig)
+	}
+
+	schedule, diags := backupScheduleFromModel(context.Background(), base)
+	if diags.HasError() {
+		t.Fatalf("backupScheduleFromModel: %v", diags)
+	}
+	if schedule.EncryptionType != services.BackupEncryptionType_UseDatabaseEncryption {
+		t.Errorf("omitted encryption_config sends %q, want %s", schedule.EncryptionType, services.BackupEncryptionType_UseDatabaseEncryption)
+	}
+}
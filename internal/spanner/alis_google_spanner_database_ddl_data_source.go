@@ -0,0 +1,141 @@
+package spanner
+
+import (
+	"context"
+	"encoding/base64"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &databaseDdlDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseDdlDataSource{}
+)
+
+// NewDatabaseDdlDataSource is a helper function to simplify the data source implementation.
+func NewDatabaseDdlDataSource() datasource.DataSource {
+	return &databaseDdlDataSource{}
+}
+
+type databaseDdlDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseDdlModel struct {
+	Project          types.String   `tfsdk:"project"`
+	Instance         types.String   `tfsdk:"instance"`
+	Database         types.String   `tfsdk:"database"`
+	Statements       []types.String `tfsdk:"statements"`
+	ProtoDescriptors types.String   `tfsdk:"proto_descriptors"`
+	Fingerprint      types.String   `tfsdk:"fingerprint"`
+}
+
+// Metadata returns the data source type name.
+func (d *databaseDdlDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_ddl"
+}
+
+// Schema defines the schema for the data source.
+func (d *databaseDdlDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the complete schema of a Cloud Spanner database as Spanner reports it, together with a fingerprint " +
+			"that changes only when the schema does. Useful for archiving the exact DDL alongside a release, or for triggering " +
+			"downstream changes (for example an application redeploy) whenever the schema changes.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID whose schema is read.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"statements": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The DDL statements that define the database schema, in the order that recreates it.",
+			},
+			"proto_descriptors": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The base64-encoded `FileDescriptorSet` of the database's proto bundle. " +
+					"Empty when the database has no proto bundle.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The hex SHA-256 of the normalized schema: the ordered statements with insignificant whitespace " +
+					"and trailing semicolons removed, followed by the proto descriptors. Stable across reads of an unchanged schema.",
+			},
+		},
+	}
+}
+
+// Read data source information.
+func (d *databaseDdlDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var state databaseDdlModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+	}.String()
+
+	ddl, err := d.config.SpannerService.GetSpannerDatabaseDdl(ctx, databaseName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database DDL",
+			"Could not read the DDL of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.Statements = make([]types.String, 0, len(ddl.Statements))
+	for _, statement := range ddl.Statements {
+		state.Statements = append(state.Statements, types.StringValue(statement))
+	}
+	state.ProtoDescriptors = types.StringValue(base64.StdEncoding.EncodeToString(ddl.ProtoDescriptors))
+	state.Fingerprint = types.StringValue(ddl.Fingerprint)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseDdlDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
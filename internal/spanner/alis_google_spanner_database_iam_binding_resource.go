@@ -0,0 +1,444 @@
+package spanner
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseIamBindingResource{}
+	_ resource.ResourceWithConfigure   = &databaseIamBindingResource{}
+	_ resource.ResourceWithImportState = &databaseIamBindingResource{}
+	_ resource.ResourceWithIdentity    = &databaseIamBindingResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseIamBindingResource{}
+)
+
+// databaseIamRoleRegex matches predefined and custom IAM role names.
+var databaseIamRoleRegex = regexp.MustCompile(`^(roles|projects/[^/]+/roles|organizations/[^/]+/roles)/[a-zA-Z0-9_.]+$`)
+
+// NewDatabaseIamBindingResource is a helper function to simplify the provider implementation.
+func NewDatabaseIamBindingResource() resource.Resource {
+	return &databaseIamBindingResource{}
+}
+
+type databaseIamBindingResource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseIamBindingModel struct {
+	Project   types.String                 `tfsdk:"project"`
+	Instance  types.String                 `tfsdk:"instance"`
+	Database  types.String                 `tfsdk:"database"`
+	Role      types.String                 `tfsdk:"role"`
+	Members   []types.String               `tfsdk:"members"`
+	Condition *databaseIamBindingCondition `tfsdk:"condition"`
+	Timeouts  timeouts.Value               `tfsdk:"timeouts"`
+}
+
+type databaseIamBindingCondition struct {
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Expression  types.String `tfsdk:"expression"`
+}
+
+// resourceName returns the fully qualified name of the binding, which is the
+// resource identity and import ID.
+func (m databaseIamBindingModel) resourceName() names.DatabaseIamBindingName {
+	name := names.DatabaseIamBindingName{
+		Project:  m.Project.ValueString(),
+		Instance: m.Instance.ValueString(),
+		Database: m.Database.ValueString(),
+		Role:     m.Role.ValueString(),
+	}
+	if m.Condition != nil {
+		name.ConditionTitle = m.Condition.Title.ValueString()
+	}
+
+	return name
+}
+
+// binding converts the model to the IAM binding it declares.
+func (m databaseIamBindingModel) binding() conn.IamBinding {
+	binding := conn.IamBinding{Role: m.Role.ValueString()}
+	for _, member := range m.Members {
+		binding.Members = append(binding.Members, member.ValueString())
+	}
+	if m.Condition != nil {
+		binding.Condition = &conn.IamCondition{
+			Title:       m.Condition.Title.ValueString(),
+			Description: m.Condition.Description.ValueString(),
+			Expression:  m.Condition.Expression.ValueString(),
+		}
+	}
+
+	return binding
+}
+
+// setBinding maps binding, as read back, onto the model.
+func (m *databaseIamBindingModel) setBinding(binding *conn.IamBinding) {
+	m.Members = make([]types.String, 0, len(binding.Members))
+	for _, member := range binding.Members {
+		m.Members = append(m.Members, types.StringValue(member))
+	}
+
+	m.Condition = nil
+	if binding.Condition != nil {
+		description := types.StringNull()
+		if binding.Condition.Description != "" {
+			description = types.StringValue(binding.Condition.Description)
+		}
+		m.Condition = &databaseIamBindingCondition{
+			Title:       types.StringValue(binding.Condition.Title),
+			Description: description,
+			Expression:  types.StringValue(binding.Condition.Expression),
+		}
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *databaseIamBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_iam_binding"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseIamBindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID whose IAM policy holds the binding.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(databaseIamRoleRegex, "must be an IAM role, e.g. roles/spanner.databaseRoleUser"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The IAM role granted, e.g. `roles/spanner.fineGrainedAccessUser` or `roles/spanner.databaseRoleUser`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"members": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				MarkdownDescription: "The principals granted the role, e.g. `user:{email}`, `serviceAccount:{email}` or `group:{email}`.",
+			},
+			"condition": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Limits where the role applies. For `roles/spanner.databaseRoleUser`, this names the database roles the members may act as, " +
+					"e.g. `resource.type == \"spanner.googleapis.com/DatabaseRole\" && resource.name.endsWith(\"/databaseRoles/analyst\")`.\n" +
+					"Adding or removing the condition, or changing its title, forces a new resource.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						databaseIamConditionTitleChanged,
+						"Adding, removing or retitling the condition makes it a different binding.",
+						"Adding, removing or retitling the condition makes it a different binding.",
+					),
+				},
+				Attributes: map[string]schema.Attribute{
+					"title": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The condition's title, which tells the binding apart from the role's other bindings.",
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "A description of the condition.",
+					},
+					"expression": schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+						MarkdownDescription: "The condition, in Common Expression Language.",
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Authoritative for a given role and condition. Updates the IAM policy of a database to grant a role to a list of members, " +
+			"optionally under an IAM condition — the bindings fine-grained access control needs, such as `roles/spanner.databaseRoleUser` " +
+			"conditioned on the database roles its members may use.\n" +
+			"Other bindings within the IAM policy for the database are preserved. " +
+			"The policy is changed with an etag-guarded read-modify-write, so concurrent changes to other bindings are not lost.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *databaseIamBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *databaseIamBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/iamBindings/{role}[/conditions/{title}]")
+}
+
+// databaseIamConditionTitleChanged requires replacement when the condition is
+// added, removed or retitled: the title is part of the binding's identity.
+func databaseIamConditionTitleChanged(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	title := func(value types.Object) attr.Value {
+		if value.IsNull() || value.IsUnknown() {
+			return types.StringNull()
+		}
+		return value.Attributes()["title"]
+	}
+	if req.StateValue.IsNull() != req.PlanValue.IsNull() {
+		resp.RequiresReplace = true
+		return
+	}
+	resp.RequiresReplace = !title(req.StateValue).Equal(title(req.PlanValue))
+}
+
+// Create a new resource.
+func (r *databaseIamBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseIamBindingModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	bindingName := plan.resourceName()
+	binding, err := r.config.SpannerService.SetDatabaseIamBinding(ctx, bindingName.DatabaseName().String(), plan.binding())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Database IAM Binding",
+			"Could not create Database IAM Binding ("+bindingName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	plan.setBinding(binding)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, bindingName.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *databaseIamBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseIamBindingModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindingName := state.resourceName()
+	binding, err := r.config.SpannerService.GetDatabaseIamBinding(ctx, bindingName.String())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Database IAM Binding",
+			"Could not read Database IAM Binding ("+bindingName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	state.setBinding(binding)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, bindingName.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *databaseIamBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseIamBindingModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	bindingName := plan.resourceName()
+	binding, err := r.config.SpannerService.SetDatabaseIamBinding(ctx, bindingName.DatabaseName().String(), plan.binding())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Database IAM Binding",
+			"Could not update Database IAM Binding ("+bindingName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	plan.setBinding(binding)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseIamBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseIamBindingModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	bindingName := state.resourceName()
+	err := r.config.SpannerService.DeleteDatabaseIamBinding(ctx, bindingName.String())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Deleting Database IAM Binding",
+			"Could not delete Database IAM Binding ("+bindingName.String()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *databaseIamBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseDatabaseIamBinding(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/iamBindings/{role}[/conditions/{title}]: "+err.Error(),
+		)
+		return
+	}
+
+	if !utils.Pattern(utils.SpannerGoogleSqlDatabaseNameRegex).MatchString(importName.DatabaseName().String()) &&
+		!utils.Pattern(utils.SpannerPostgresSqlDatabaseNameRegex).MatchString(importName.DatabaseName().String()) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+id+") contains an invalid project, instance or database ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/iamBindings/{role}[/conditions/{title}].",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), importName.Role)...)
+	// Read finds the binding by its condition title; the rest of the
+	// condition is read back from the policy.
+	if importName.ConditionTitle != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("condition"), &databaseIamBindingCondition{
+			Title:       types.StringValue(importName.ConditionTitle),
+			Description: types.StringNull(),
+			Expression:  types.StringNull(),
+		})...)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseIamBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
@@ -0,0 +1,51 @@
+package spanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The condition title is part of the binding's identity; its expression and
+// description are not, so editing them updates the binding in place.
+func TestDatabaseIamConditionTitleChanged(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"title":       types.StringType,
+		"description": types.StringType,
+		"expression":  types.StringType,
+	}
+	condition := func(title, expression string) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"title":       types.StringValue(title),
+			"description": types.StringNull(),
+			"expression":  types.StringValue(expression),
+		})
+	}
+	none := types.ObjectNull(attrTypes)
+
+	tests := []struct {
+		name        string
+		state, plan types.Object
+		want        bool
+	}{
+		{name: "unchanged", state: condition("analyst", "true"), plan: condition("analyst", "true")},
+		{name: "expression edited", state: condition("analyst", "true"), plan: condition("analyst", "false")},
+		{name: "retitled", state: condition("analyst", "true"), plan: condition("reader", "true"), want: true},
+		{name: "added", state: none, plan: condition("analyst", "true"), want: true},
+		{name: "removed", state: condition("analyst", "true"), plan: none, want: true},
+		{name: "still unconditional", state: none, plan: none},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &objectplanmodifier.RequiresReplaceIfFuncResponse{}
+			databaseIamConditionTitleChanged(context.Background(), planmodifier.ObjectRequest{StateValue: tt.state, PlanValue: tt.plan}, resp)
+			if resp.RequiresReplace != tt.want {
+				t.Errorf("RequiresReplace = %t, want %t", resp.RequiresReplace, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package spanner
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	spannerschema "terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &databaseOptionsResource{}
+	_ resource.ResourceWithConfigure        = &databaseOptionsResource{}
+	_ resource.ResourceWithImportState      = &databaseOptionsResource{}
+	_ resource.ResourceWithConfigValidators = &databaseOptionsResource{}
+	_ resource.ResourceWithModifyPlan       = &databaseOptionsResource{}
+)
+
+// NewDatabaseOptionsResource is a helper function to simplify the provider implementation.
+func NewDatabaseOptionsResource() resource.Resource {
+	return &databaseOptionsResource{}
+}
+
+type databaseOptionsResource struct {
+	config *internal.ProviderConfig
+}
+
+// databaseOptionsImportedKey is the private state key ImportState sets so
+// the Read that follows adopts every option the database reports.
+const databaseOptionsImportedKey = "imported"
+
+type databaseOptionsModel struct {
+	Project                    types.String   `tfsdk:"project"`
+	Instance                   types.String   `tfsdk:"instance"`
+	Database                   types.String   `tfsdk:"database"`
+	VersionRetentionPeriod     types.String   `tfsdk:"version_retention_period"`
+	OptimizerVersion           types.Int64    `tfsdk:"optimizer_version"`
+	OptimizerStatisticsPackage types.String   `tfsdk:"optimizer_statistics_package"`
+	DefaultLeader              types.String   `tfsdk:"default_leader"`
+	DefaultSequenceKind        types.String   `tfsdk:"default_sequence_kind"`
+	DefaultTimeZone            types.String   `tfsdk:"default_time_zone"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *databaseOptionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_options"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseOptionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID whose options are managed. The database must already exist.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"version_retention_period": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long Spanner keeps old versions of data for point-in-time recovery and stale reads, " +
+					"from `1h` to `7d`, e.g. `3d` or `36h`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9]+[smhd]$`),
+						"must be a whole number followed by a unit of s, m, h, or d, e.g. 7d",
+					),
+				},
+			},
+			"optimizer_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The query optimizer version queries use unless they override it.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"optimizer_statistics_package": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The optimizer statistics package queries use unless they override it, e.g. `auto_20191128_14_47_22UTC`.",
+			},
+			"default_leader": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The leader region of a database in a multi-region instance configuration, e.g. `us-east1`.",
+			},
+			"default_sequence_kind": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The sequence kind used by sequences and identity columns that do not name one. The only possible value is `bit_reversed_positive`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(spannerschema.SpannerSequenceKindBitReversedPositive.String()),
+				},
+			},
+			"default_time_zone": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The time zone timestamp functions use when none is given, e.g. `America/New_York`.\n" +
+					"Spanner only accepts a change while the database has no tables.",
+			},
+		},
+		MarkdownDescription: "Manages the database-level options of an existing Cloud Spanner database with ALTER DATABASE.\n" +
+			"Only the options set in configuration are managed; each is read back from INFORMATION_SCHEMA.DATABASE_OPTIONS to detect drift. " +
+			"Removing an option from configuration, or destroying the resource, resets it to the Spanner default.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *databaseOptionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// Create applies the configured options to the database.
+func (r *databaseOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseOptionsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	databaseName := names.DatabaseName{
+		Project:  plan.Project.ValueString(),
+		Instance: plan.Instance.ValueString(),
+		Database: plan.Database.ValueString(),
+	}.String()
+
+	_, err := r.config.SpannerService.UpdateSpannerDatabaseOptions(ctx, databaseName, databaseOptionsFromModel(plan), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Database Options",
+			"Could not set options on Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the managed options. Options the configuration leaves
+// unset are not tracked, except right after import, when every option the
+// database reports is adopted.
+func (r *databaseOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseOptionsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+	}.String()
+
+	options, err := r.config.SpannerService.GetSpannerDatabaseOptions(ctx, databaseName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Database Options",
+			"Could not read options of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	importedFlag, diags := req.Private.GetKey(ctx, databaseOptionsImportedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	imported := importedFlag != nil
+	refreshString := func(current types.String, reported *wrapperspb.StringValue) types.String {
+		if current.IsNull() && !imported {
+			return current
+		}
+		if reported == nil {
+			return types.StringNull()
+		}
+		return types.StringValue(reported.GetValue())
+	}
+
+	state.VersionRetentionPeriod = refreshString(state.VersionRetentionPeriod, options.GetVersionRetentionPeriod())
+	state.OptimizerStatisticsPackage = refreshString(state.OptimizerStatisticsPackage, options.GetOptimizerStatisticsPackage())
+	state.DefaultLeader = refreshString(state.DefaultLeader, options.GetDefaultLeader())
+	state.DefaultSequenceKind = refreshString(state.DefaultSequenceKind, options.GetDefaultSequenceKind())
+	state.DefaultTimeZone = refreshString(state.DefaultTimeZone, options.GetDefaultTimeZone())
+	if !state.OptimizerVersion.IsNull() || imported {
+		state.OptimizerVersion = types.Int64Null()
+		if options.GetOptimizerVersion() != nil {
+			state.OptimizerVersion = types.Int64Value(options.GetOptimizerVersion().GetValue())
+		}
+	}
+
+	// Only the first read after import adopts; later ones track what the
+	// state manages, however few options that has left.
+	if imported {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseOptionsImportedKey, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update applies the planned options and resets any option that was
+// removed from configuration, in one ALTER DATABASE batch.
+func (r *databaseOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseOptionsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state databaseOptionsModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	databaseName := names.DatabaseName{
+		Project:  plan.Project.ValueString(),
+		Instance: plan.Instance.ValueString(),
+		Database: plan.Database.ValueString(),
+	}.String()
+
+	planned := map[string]bool{}
+	for _, option := range managedDatabaseOptions(plan) {
+		planned[option] = true
+	}
+	var reset []string
+	for _, option := range managedDatabaseOptions(state) {
+		if !planned[option] {
+			reset = append(reset, option)
+		}
+	}
+
+	_, err := r.config.SpannerService.UpdateSpannerDatabaseOptions(ctx, databaseName, databaseOptionsFromModel(plan), reset)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Database Options",
+			"Could not update options of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resets every managed option to its Spanner default. The database
+// itself is left in place.
+func (r *databaseOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseOptionsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+	}.String()
+
+	_, err := r.config.SpannerService.UpdateSpannerDatabaseOptions(ctx, databaseName, nil, managedDatabaseOptions(state))
+	if err != nil {
+		// A dropped database has no options left to reset.
+		if status.Code(err) == codes.NotFound {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Resetting Database Options",
+			"Could not reset options of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *databaseOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Split import ID to get project, instance, and database id
+	// projects/{project}/instances/{instance}/databases/{database}
+	importName, err := names.ParseDatabase(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, databaseOptionsImportedKey, []byte("true"))...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseOptionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
+
+func (r *databaseOptionsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	expressions := make([]path.Expression, 0, len(spannerschema.DatabaseOptions))
+	for _, option := range spannerschema.DatabaseOptions {
+		expressions = append(expressions, path.MatchRoot(option))
+	}
+
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(expressions...),
+	}
+}
+
+// databaseOptionsFromModel converts the model's non-null options.
+func databaseOptionsFromModel(model databaseOptionsModel) *spannerschema.SpannerDatabaseOptions {
+	stringOption := func(v types.String) *wrapperspb.StringValue {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		return wrapperspb.String(v.ValueString())
+	}
+
+	options := &spannerschema.SpannerDatabaseOptions{
+		VersionRetentionPeriod:     stringOption(model.VersionRetentionPeriod),
+		OptimizerStatisticsPackage: stringOption(model.OptimizerStatisticsPackage),
+		DefaultLeader:              stringOption(model.DefaultLeader),
+		DefaultSequenceKind:        stringOption(model.DefaultSequenceKind),
+		DefaultTimeZone:            stringOption(model.DefaultTimeZone),
+	}
+	if !model.OptimizerVersion.IsNull() && !model.OptimizerVersion.IsUnknown() {
+		options.OptimizerVersion = wrapperspb.Int64(model.OptimizerVersion.ValueInt64())
+	}
+
+	return options
+}
+
+// managedDatabaseOptions names the options model sets, in
+// spannerschema.DatabaseOptions order.
+func managedDatabaseOptions(model databaseOptionsModel) []string {
+	set := map[string]bool{
+		spannerschema.DatabaseOptionVersionRetentionPeriod:     !model.VersionRetentionPeriod.IsNull(),
+		spannerschema.DatabaseOptionOptimizerVersion:           !model.OptimizerVersion.IsNull(),
+		spannerschema.DatabaseOptionOptimizerStatisticsPackage: !model.OptimizerStatisticsPackage.IsNull(),
+		spannerschema.DatabaseOptionDefaultLeader:              !model.DefaultLeader.IsNull(),
+		spannerschema.DatabaseOptionDefaultSequenceKind:        !model.DefaultSequenceKind.IsNull(),
+		spannerschema.DatabaseOptionDefaultTimeZone:            !model.DefaultTimeZone.IsNull(),
+	}
+
+	var managed []string
+	for _, option := range spannerschema.DatabaseOptions {
+		if set[option] {
+			managed = append(managed, option)
+		}
+	}
+
+	return managed
+}
@@ -0,0 +1,543 @@
+package spanner
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"time"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/services"
+	"terraform-provider-alis/internal/utils"
+	"terraform-provider-alis/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &databaseResource{}
+	_ resource.ResourceWithConfigure      = &databaseResource{}
+	_ resource.ResourceWithImportState    = &databaseResource{}
+	_ resource.ResourceWithValidateConfig = &databaseResource{}
+	_ resource.ResourceWithModifyPlan     = &databaseResource{}
+)
+
+// NewDatabaseResource is a helper function to simplify the provider implementation.
+func NewDatabaseResource() resource.Resource {
+	return &databaseResource{}
+}
+
+type databaseResource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseModel struct {
+	Project              types.String              `tfsdk:"project"`
+	Instance             types.String              `tfsdk:"instance"`
+	Name                 types.String              `tfsdk:"name"`
+	Dialect              types.String              `tfsdk:"dialect"`
+	ExtraStatements      types.List                `tfsdk:"extra_statements"`
+	ProtoDescriptors     types.String              `tfsdk:"proto_descriptors"`
+	EncryptionConfig     *databaseEncryptionConfig `tfsdk:"encryption_config"`
+	EnableDropProtection types.Bool                `tfsdk:"enable_drop_protection"`
+	State                types.String              `tfsdk:"state"`
+	CreateTime           types.String              `tfsdk:"create_time"`
+	Timeouts             timeouts.Value            `tfsdk:"timeouts"`
+}
+
+type databaseEncryptionConfig struct {
+	KmsKeyName  types.String `tfsdk:"kms_key_name"`
+	KmsKeyNames types.List   `tfsdk:"kms_key_names"`
+}
+
+// databaseCreationOnlyRequiresReplace forces a replace when a creation-time
+// value changes, except when the prior value is null. Only import leaves
+// these null (their defaults are non-null), and Spanner cannot report what a
+// database was created with, so an imported database adopts the configured
+// value rather than being rebuilt to match it.
+func databaseCreationOnlyRequiresReplace(stateValue attr.Value) bool {
+	return !stateValue.IsNull()
+}
+
+// Metadata returns the resource type name.
+func (r *databaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID the database is created in.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The database ID within the instance.\n" +
+					"It must start with a letter, be 2-30 characters long, and contain only lowercase letters, numbers, underscores, or hyphens " +
+					"(letters, numbers, and underscores for PostgreSQL).\n" +
+					"Changing this forces a new resource.",
+				Validators: []validator.String{
+					validators.RegexMatches([]*regexp.Regexp{
+						utils.Pattern(utils.SpannerGoogleSqlDatabaseIdRegex),
+						utils.Pattern(utils.SpannerPostgresSqlDatabaseIdRegex),
+					}, "Name must be a valid Spanner Database ID, See https://cloud.google.com/spanner/docs/reference/rest/v1/projects.instances.databases/create"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dialect": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The SQL dialect of the database. Possible values are `GOOGLE_STANDARD_SQL` and `POSTGRESQL`.\n" +
+					"Changing this forces a new resource.",
+				Default: stringdefault.StaticString(services.DatabaseDialect_GoogleStandardSQL),
+				Validators: []validator.String{
+					stringvalidator.OneOf(services.DatabaseDialect_GoogleStandardSQL, services.DatabaseDialect_PostgreSQL),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"extra_statements": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				MarkdownDescription: "DDL statements applied in the same request that creates the database, so the database never exists without them.\n" +
+					"A PostgreSQL-dialect database, which Spanner creates without them, gets them in a schema update right after creation; " +
+					"if that update fails, the next apply replaces the database.\n" +
+					"Statements are applied in order; a failing statement fails the creation.\n" +
+					"Spanner does not report these back, so later schema drift is not detected here; manage long-lived objects with their own resources.\n" +
+					"Changing this forces a new resource, except on an imported database, which adopts the configured value.",
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, nil)),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = databaseCreationOnlyRequiresReplace(req.StateValue)
+						},
+						"Changing the statements recreates the database.",
+						"Changing the statements recreates the database.",
+					),
+				},
+			},
+			"proto_descriptors": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "A base64-encoded serialized `FileDescriptorSet` for any `CREATE PROTO BUNDLE` statement in `extra_statements`, " +
+					"for example `filebase64(\"descriptors.pb\")`.\n" +
+					"Changing this forces a new resource, except on an imported database, which adopts the configured value.",
+				Default: stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = databaseCreationOnlyRequiresReplace(req.StateValue)
+						},
+						"Changing the descriptors recreates the database.",
+						"Changing the descriptors recreates the database.",
+					),
+				},
+			},
+			"encryption_config": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Customer-managed encryption for the database. When omitted the database uses Google default encryption.\n" +
+					"Changing this forces a new resource.",
+				Attributes: map[string]schema.Attribute{
+					"kms_key_name": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The Cloud KMS key protecting the database, for a regional instance.\n" +
+							"Format: `projects/{project}/locations/{location}/keyRings/{key_ring}/cryptoKeys/{key}`.",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("kms_key_names")),
+						},
+					},
+					"kms_key_names": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "The Cloud KMS keys protecting the database, one per region of a multi-region instance configuration.",
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_drop_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Whether Spanner refuses to drop the database, or to delete its instance, while this is on.\n" +
+					"Unlike a Terraform lifecycle rule it also guards against deletion from other tools. " +
+					"Destroying the resource fails until this is set to false and applied.",
+				Default: booldefault.StaticBool(false),
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The lifecycle state of the database, e.g. `READY` or `READY_OPTIMIZING`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the database was created, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "A Google Cloud Spanner database resource.\n" +
+			"Creates the database with its creation-time settings (dialect, initial DDL, proto descriptors, and customer-managed encryption), " +
+			"which cannot change afterwards. Tables, indexes, and other schema objects are managed by their own resources.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project and instance.
+func (r *databaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance")
+}
+
+// Create a new resource.
+func (r *databaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	databaseName := names.DatabaseName{
+		Project:  plan.Project.ValueString(),
+		Instance: plan.Instance.ValueString(),
+		Database: plan.Name.ValueString(),
+	}.String()
+
+	spec := conn.DatabaseSpec{
+		Dialect: databaseDialectFromString(plan.Dialect.ValueString()),
+	}
+	resp.Diagnostics.Append(plan.ExtraStatements.ElementsAs(ctx, &spec.ExtraStatements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if descriptors := plan.ProtoDescriptors.ValueString(); descriptors != "" {
+		// ValidateConfig has already rejected anything that is not base64.
+		spec.ProtoDescriptors, _ = base64.StdEncoding.DecodeString(descriptors)
+	}
+	if plan.EncryptionConfig != nil {
+		spec.KmsKeyName = plan.EncryptionConfig.KmsKeyName.ValueString()
+		if !plan.EncryptionConfig.KmsKeyNames.IsNull() {
+			resp.Diagnostics.Append(plan.EncryptionConfig.KmsKeyNames.ElementsAs(ctx, &spec.KmsKeyNames, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	database, err := r.config.SpannerService.CreateSpannerDatabase(ctx, databaseName, spec, plan.EnableDropProtection.ValueBool())
+	if err != nil {
+		// A database that exists but could not be protected is still tracked,
+		// so the next apply retries the protection instead of a create. One
+		// whose extra statements failed is tracked without them, so the next
+		// apply replaces it with one created as configured.
+		if database != nil {
+			if errors.Is(err, services.ErrExtraStatementsNotApplied) {
+				plan.ExtraStatements = types.ListValueMust(types.StringType, nil)
+				plan.ProtoDescriptors = types.StringValue("")
+			}
+			plan.EnableDropProtection = types.BoolValue(database.EnableDropProtection)
+			setDatabaseComputed(&plan, database)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating Database",
+			"Could not create Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	setDatabaseComputed(&plan, database)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Name.ValueString(),
+	}.String()
+
+	database, err := r.config.SpannerService.GetSpannerDatabase(ctx, databaseName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Database",
+			"Could not read Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.Dialect = types.StringValue(databaseDialectString(database.Dialect))
+	state.EnableDropProtection = types.BoolValue(database.EnableDropProtection)
+	setDatabaseComputed(&state, database)
+
+	// Encryption is the one creation-time setting Spanner reports back.
+	state.EncryptionConfig = nil
+	if database.KmsKeyName != "" || len(database.KmsKeyNames) > 0 {
+		encryption := &databaseEncryptionConfig{
+			KmsKeyName:  types.StringNull(),
+			KmsKeyNames: types.ListNull(types.StringType),
+		}
+		if database.KmsKeyName != "" {
+			encryption.KmsKeyName = types.StringValue(database.KmsKeyName)
+		}
+		if len(database.KmsKeyNames) > 0 {
+			keys, d := types.ListValueFrom(ctx, types.StringType, database.KmsKeyNames)
+			resp.Diagnostics.Append(d...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			encryption.KmsKeyNames = keys
+		}
+		state.EncryptionConfig = encryption
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update applies enable_drop_protection, the only setting that changes in
+// place. Anything else reaching Update is an imported database adopting its
+// configured creation-time values, which only needs persisting.
+func (r *databaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state databaseModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EnableDropProtection.Equal(state.EnableDropProtection) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ctx, cancel := withTimeout(ctx, updateTimeout)
+		defer cancel()
+
+		databaseName := names.DatabaseName{
+			Project:  plan.Project.ValueString(),
+			Instance: plan.Instance.ValueString(),
+			Database: plan.Name.ValueString(),
+		}.String()
+
+		database, err := r.config.SpannerService.UpdateSpannerDatabaseDropProtection(ctx, databaseName, plan.EnableDropProtection.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Database",
+				"Could not update drop protection on Database ("+databaseName+"): "+utils.ErrDetail(err),
+			)
+			return
+		}
+		setDatabaseComputed(&plan, database)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *databaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Name.ValueString(),
+	}.String()
+
+	// Spanner would refuse anyway; failing here names the attribute to change.
+	if state.EnableDropProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enable_drop_protection"),
+			"Error Deleting Database",
+			"Database ("+databaseName+") has drop protection enabled. Set `enable_drop_protection` to false and apply before destroying it.",
+		)
+		return
+	}
+
+	err := r.config.SpannerService.DeleteSpannerDatabase(ctx, databaseName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Database",
+			"Could not delete Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *databaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// projects/{project}/instances/{instance}/databases/{database}
+	importName, err := names.ParseDatabase(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), importName.Database)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
+
+// ValidateConfig rejects proto_descriptors that are not base64 at plan time,
+// before anything is created.
+func (r *databaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var descriptors types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proto_descriptors"), &descriptors)...)
+	if resp.Diagnostics.HasError() || descriptors.IsNull() || descriptors.IsUnknown() {
+		return
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(descriptors.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proto_descriptors"),
+			"Invalid Proto Descriptors",
+			"Expected proto_descriptors to be a base64-encoded FileDescriptorSet: "+err.Error(),
+		)
+	}
+}
+
+// setDatabaseComputed copies the read-only attributes from database.
+func setDatabaseComputed(model *databaseModel, database *conn.DatabaseInfo) {
+	model.State = types.StringValue(database.State)
+	model.CreateTime = types.StringNull()
+	if !database.CreateTime.IsZero() {
+		model.CreateTime = types.StringValue(database.CreateTime.Format(time.RFC3339))
+	}
+}
+
+// databaseDialectFromString maps the dialect attribute onto conn.Dialect;
+// anything but POSTGRESQL is GoogleSQL, the Spanner default.
+func databaseDialectFromString(dialect string) conn.Dialect {
+	if dialect == services.DatabaseDialect_PostgreSQL {
+		return conn.DialectPostgreSQL
+	}
+	return conn.DialectGoogleSQL
+}
+
+// databaseDialectString is the inverse of databaseDialectFromString.
+func databaseDialectString(dialect conn.Dialect) string {
+	if dialect == conn.DialectPostgreSQL {
+		return services.DatabaseDialect_PostgreSQL
+	}
+	return services.DatabaseDialect_GoogleStandardSQL
+}
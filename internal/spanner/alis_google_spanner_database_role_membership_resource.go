@@ -0,0 +1,302 @@
+package spanner
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+	"terraform-provider-alis/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &databaseRoleMembershipResource{}
+	_ resource.ResourceWithConfigure   = &databaseRoleMembershipResource{}
+	_ resource.ResourceWithImportState = &databaseRoleMembershipResource{}
+	_ resource.ResourceWithIdentity    = &databaseRoleMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseRoleMembershipResource{}
+)
+
+// NewDatabaseRoleMembershipResource is a helper function to simplify the provider implementation.
+func NewDatabaseRoleMembershipResource() resource.Resource {
+	return &databaseRoleMembershipResource{}
+}
+
+type databaseRoleMembershipResource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseRoleMembershipModel struct {
+	Project  types.String   `tfsdk:"project"`
+	Instance types.String   `tfsdk:"instance"`
+	Database types.String   `tfsdk:"database"`
+	Role     types.String   `tfsdk:"role"`
+	Member   types.String   `tfsdk:"member"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// resourceName returns the fully qualified name of the membership, which is
+// the resource identity and import ID.
+func (m databaseRoleMembershipModel) resourceName() string {
+	return names.DatabaseRoleMemberName{
+		Project:  m.Project.ValueString(),
+		Instance: m.Instance.ValueString(),
+		Database: m.Database.ValueString(),
+		Role:     m.Role.ValueString(),
+		Member:   m.Member.ValueString(),
+	}.String()
+}
+
+// roleIdValidators accepts a Spanner database role ID in either dialect.
+func roleIdValidators(attribute string) []validator.String {
+	return []validator.String{
+		validators.RegexMatches([]*regexp.Regexp{
+			utils.Pattern(utils.SpannerGoogleSqlRoleIdRegex),
+			utils.Pattern(utils.SpannerPostgresSqlRoleIdRegex),
+		}, attribute+" must be a valid Spanner database role ID, See https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#naming_conventions"),
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *databaseRoleMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_role_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *databaseRoleMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains both roles.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"role": schema.StringAttribute{
+				Required:   true,
+				Validators: roleIdValidators("Role"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The role being granted. Either a custom role or a system role such as `spanner_info_reader`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"member": schema.StringAttribute{
+				Required:   true,
+				Validators: roleIdValidators("Member"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The custom role that receives `role` and inherits all of its privileges.\n" +
+					"Changing this forces a new resource.",
+			},
+		},
+		MarkdownDescription: "Grants a database role to another role with `GRANT ROLE`, building a role hierarchy. " +
+			"A grant that already exists is adopted into the state.\n" +
+			"Authoritative for a given role and member pair. Other grants of either role are preserved.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *databaseRoleMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *databaseRoleMembershipResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}/members/{member}")
+}
+
+// Create grants the role unless INFORMATION_SCHEMA.ROLE_GRANTEES already
+// reports the grant, in which case it is adopted as-is.
+func (r *databaseRoleMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan databaseRoleMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	membershipName := plan.resourceName()
+
+	_, err := r.config.SpannerService.GetDatabaseRoleMembership(ctx, membershipName)
+	switch {
+	case err == nil:
+		// Already granted; adopt it.
+	case status.Code(err) == codes.NotFound:
+		if err := r.config.SpannerService.GrantDatabaseRole(ctx, membershipName); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Granting Database Role",
+				"Could not grant Role ("+plan.Role.ValueString()+") to Role ("+plan.Member.ValueString()+"): "+utils.ErrDetail(err),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Error Checking Existing Role Membership",
+			"Could not verify whether Role Membership ("+membershipName+") already exists: "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, membershipName)...)
+}
+
+// Read resource information.
+func (r *databaseRoleMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state databaseRoleMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	membershipName := state.resourceName()
+
+	_, err := r.config.SpannerService.GetDatabaseRoleMembership(ctx, membershipName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Role Membership",
+			"Could not read Role Membership ("+membershipName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, membershipName)...)
+}
+
+func (r *databaseRoleMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan databaseRoleMembershipModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute forces replacement, so only timeouts can change here.
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the role and removes the Terraform state on success.
+func (r *databaseRoleMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state databaseRoleMembershipModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	membershipName := state.resourceName()
+
+	if err := r.config.SpannerService.RevokeDatabaseRole(ctx, membershipName); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Revoking Database Role",
+			"Could not revoke Role Membership ("+membershipName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *databaseRoleMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseDatabaseRoleMember(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}/members/{member}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), importName.Role)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), importName.Member)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *databaseRoleMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
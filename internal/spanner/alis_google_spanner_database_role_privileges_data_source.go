@@ -0,0 +1,182 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &databaseRolePrivilegesDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseRolePrivilegesDataSource{}
+)
+
+// NewDatabaseRolePrivilegesDataSource is a helper function to simplify the data source implementation.
+func NewDatabaseRolePrivilegesDataSource() datasource.DataSource {
+	return &databaseRolePrivilegesDataSource{}
+}
+
+type databaseRolePrivilegesDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseRolePrivilegesModel struct {
+	Project        types.String                  `tfsdk:"project"`
+	Instance       types.String                  `tfsdk:"instance"`
+	Database       types.String                  `tfsdk:"database"`
+	Role           types.String                  `tfsdk:"role"`
+	InheritedRoles []types.String                `tfsdk:"inherited_roles"`
+	Privileges     []*databaseRolePrivilegeModel `tfsdk:"privileges"`
+}
+
+type databaseRolePrivilegeModel struct {
+	ObjectType types.String `tfsdk:"object_type"`
+	Object     types.String `tfsdk:"object"`
+	Column     types.String `tfsdk:"column"`
+	Privilege  types.String `tfsdk:"privilege"`
+	GrantedVia types.String `tfsdk:"granted_via"`
+}
+
+// Metadata returns the data source type name.
+func (d *databaseRolePrivilegesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_role_privileges"
+}
+
+// Schema defines the schema for the data source.
+func (d *databaseRolePrivilegesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports everything a database role can access, for access reviews: every table, view, column, change stream " +
+			"and function privilege it holds, directly or through the roles granted to it.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the role.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The role reported on.",
+			},
+			"inherited_roles": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Every role the role inherits, directly or through another inherited role, sorted.",
+			},
+			"privileges": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The kind of object: `TABLE`, `VIEW`, `COLUMN`, `CHANGE_STREAM` or `FUNCTION`.",
+						},
+						"object": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the object. For a column privilege, the name of its table.",
+						},
+						"column": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The column, for a column privilege; null otherwise.",
+						},
+						"privilege": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The privilege, e.g. `SELECT` or `EXECUTE`.",
+						},
+						"granted_via": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role the privilege is granted to: `role` itself, or one of `inherited_roles`.",
+						},
+					},
+				},
+				MarkdownDescription: "Every privilege of the role and of its inherited roles, sorted by object type, object, column, privilege and `granted_via`. " +
+					"Column privileges on a column whose table the same role holds the privilege on are left out.",
+			},
+		},
+	}
+}
+
+// Read data source information.
+func (d *databaseRolePrivilegesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databaseRolePrivilegesModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := names.DatabaseRoleName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Role:     state.Role.ValueString(),
+	}.String()
+
+	report, err := d.config.SpannerService.GetDatabaseRolePrivileges(ctx, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Database Role Privileges",
+			"Could not read privileges of Database Role ("+roleName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	state.InheritedRoles = make([]types.String, 0, len(report.InheritedRoles))
+	for _, role := range report.InheritedRoles {
+		state.InheritedRoles = append(state.InheritedRoles, types.StringValue(role))
+	}
+	state.Privileges = make([]*databaseRolePrivilegeModel, 0, len(report.Privileges))
+	for _, privilege := range report.Privileges {
+		column := types.StringNull()
+		if privilege.Column != "" {
+			column = types.StringValue(privilege.Column)
+		}
+		state.Privileges = append(state.Privileges, &databaseRolePrivilegeModel{
+			ObjectType: types.StringValue(privilege.ObjectType),
+			Object:     types.StringValue(privilege.Object),
+			Column:     column,
+			Privilege:  types.StringValue(privilege.Privilege),
+			GrantedVia: types.StringValue(privilege.GrantedVia),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseRolePrivilegesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,6 +27,9 @@ var (
 	_ resource.Resource                = &databaseRoleResource{}
 	_ resource.ResourceWithConfigure   = &databaseRoleResource{}
 	_ resource.ResourceWithImportState = &databaseRoleResource{}
+	_ resource.ResourceWithIdentity    = &databaseRoleResource{}
+	_ resource.ResourceWithIdentity    = &databaseRoleResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseRoleResource{}
 )
 
 // NewDatabaseRoleResource is a helper function to simplify the provider implementation.
@@ -38,11 +42,18 @@ type databaseRoleResource struct {
 }
 
 type databaseRoleModel struct {
-	Project  types.String   `tfsdk:"project"`
-	Instance types.String   `tfsdk:"instance"`
-	Database types.String   `tfsdk:"database"`
-	Role     types.String   `tfsdk:"role"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Project      types.String   `tfsdk:"project"`
+	Instance     types.String   `tfsdk:"instance"`
+	Database     types.String   `tfsdk:"database"`
+	Role         types.String   `tfsdk:"role"`
+	ForceDestroy types.Bool     `tfsdk:"force_destroy"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// resourceName returns the fully qualified name of the role, which is
+// the resource identity and import ID.
+func (m databaseRoleModel) resourceName() string {
+	return names.DatabaseRoleName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Role: m.Role.ValueString()}.String()
 }
 
 // Metadata returns the resource type name.
@@ -63,28 +74,25 @@ func (r *databaseRoleResource) Schema(ctx context.Context, _ resource.SchemaRequ
 		},
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"instance": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner database ID within the instance where the role is created.\n" +
+					"Defaults to the provider's `database`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"role": schema.StringAttribute{
 				Required: true,
@@ -100,12 +108,31 @@ func (r *databaseRoleResource) Schema(ctx context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "The role that should be applied.\n" +
 					"The role must satisfy the expression `^[a-zA-Z0-9_]{1,64}$`.",
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "Whether destroying the role first revokes everything that references it: its privileges, " +
+					"the roles granted to it and its grants to other roles, all in the same DDL batch as `DROP ROLE`.\n" +
+					"When false, destroying a role that is still referenced fails and lists the grants in the way.",
+			},
 		},
 		MarkdownDescription: "Creates a custom role in the database if it does not exist. If the role already exists, it will be imported into the state.\n" +
 			"Authoritative for a given role. Other roles within the database are preserved.",
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *databaseRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *databaseRoleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}")
+}
+
 // Create ensures the role exists: a role already present in the database is
 // adopted into state as-is rather than treated as a conflict; otherwise
 // CREATE ROLE DDL is issued.
@@ -147,6 +174,7 @@ func (r *databaseRoleResource) Create(ctx context.Context, req resource.CreateRe
 		// Set state to fully populated data
 		diags = resp.State.Set(ctx, plan)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 		return
 	}
 
@@ -162,6 +190,7 @@ func (r *databaseRoleResource) Create(ctx context.Context, req resource.CreateRe
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -200,9 +229,16 @@ func (r *databaseRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	// State written before force_destroy existed, or just imported, has no
+	// value for it; record the default so it does not show as a change.
+	if state.ForceDestroy.IsNull() {
+		state.ForceDestroy = types.BoolValue(false)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -254,7 +290,12 @@ func (r *databaseRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 
 	roleName := names.DatabaseRoleName{Project: project, Instance: instance, Database: database, Role: role}.String()
 
-	err := r.config.SpannerService.DeleteDatabaseRole(ctx, roleName)
+	var err error
+	if state.ForceDestroy.ValueBool() {
+		err = r.config.SpannerService.ForceDeleteDatabaseRole(ctx, roleName)
+	} else {
+		err = r.config.SpannerService.DeleteDatabaseRole(ctx, roleName)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Database Role",
@@ -265,11 +306,16 @@ func (r *databaseRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *databaseRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importName, err := names.ParseDatabaseRole(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseDatabaseRole(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/databaseRoles/{role}: "+err.Error(),
 		)
 		return
 	}
@@ -46,16 +46,22 @@ func (d *databaseRolesDataSource) Schema(_ context.Context, _ datasource.SchemaR
 			"(for example via the `alis_google_spanner_database_role` resource).",
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Spanner instance ID that contains the database.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Spanner database ID whose roles are listed.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID whose roles are listed.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"roles": schema.ListAttribute{
 				Computed:            true,
@@ -76,6 +82,13 @@ func (d *databaseRolesDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	project := state.Project.ValueString()
 	instance := state.Instance.ValueString()
@@ -2,6 +2,7 @@ package spanner
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"terraform-provider-alis/internal"
@@ -11,6 +12,7 @@ import (
 	"terraform-provider-alis/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,6 +30,8 @@ var (
 	_ resource.Resource                = &databaseSequenceResource{}
 	_ resource.ResourceWithConfigure   = &databaseSequenceResource{}
 	_ resource.ResourceWithImportState = &databaseSequenceResource{}
+	_ resource.ResourceWithIdentity    = &databaseSequenceResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseSequenceResource{}
 )
 
 // NewDatabaseSequenceResource is a helper function to simplify the provider implementation.
@@ -45,7 +49,16 @@ type databaseSequenceModel struct {
 	Database types.String            `tfsdk:"database"`
 	Sequence types.String            `tfsdk:"sequence"`
 	Options  *spannerSequenceOptions `tfsdk:"options"`
-	Timeouts timeouts.Value          `tfsdk:"timeouts"`
+	// InternalCounter is the live GET_INTERNAL_SEQUENCE_STATE, refreshed on
+	// every read.
+	InternalCounter types.Int64    `tfsdk:"internal_counter"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// resourceName returns the fully qualified name of the sequence, which is
+// the resource identity and import ID.
+func (m databaseSequenceModel) resourceName() string {
+	return names.SequenceName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Sequence: m.Sequence.ValueString()}.String()
 }
 
 type spannerSequenceOptions struct {
@@ -77,28 +90,25 @@ func (r *databaseSequenceResource) Schema(ctx context.Context, _ resource.Schema
 		},
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"instance": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner database ID within the instance where sequence DDL is applied.\n" +
+					"Defaults to the provider's `database`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"sequence": schema.StringAttribute{
 				Required: true,
@@ -142,17 +152,83 @@ func (r *databaseSequenceResource) Schema(ctx context.Context, _ resource.Schema
 					},
 					"start_with_counter": schema.Int64Attribute{
 						Optional: true,
-						MarkdownDescription: "Sets the sequence counter (start_with_counter in Spanner OPTIONS). Changing this affects future generated values; review operational impact before updating.\n" +
+						MarkdownDescription: "Sets the sequence counter (start_with_counter in Spanner OPTIONS). It is only applied when it changes, " +
+							"so updating other options does not rewind the counter. Planning a value at or below `internal_counter` is reported as a warning: " +
+							"applying it would make the sequence produce values it has already produced.\n" +
 							"See https://cloud.google.com/spanner/docs/sequence-tasks",
 					},
 				},
 			},
+			"internal_counter": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The internal counter of the sequence as GET_INTERNAL_SEQUENCE_STATE reports it, read on every refresh. " +
+					"Null until the sequence produces its first value.",
+			},
 		},
 		MarkdownDescription: "Manages a Cloud Spanner database sequence using DDL. If the sequence does not exist it is created; if it already exists it is imported into Terraform state.\n" +
 			"Updates apply ALTER SEQUENCE ... SET OPTIONS for Google-standard-SQL. Binding a sequence to table columns (defaults) and dropping a sequence are separate DDL or console steps; see https://cloud.google.com/spanner/docs/sequence-tasks",
 	}
 }
 
+// IdentitySchema defines the identity of the resource.
+func (r *databaseSequenceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/sequences/{sequence}")
+}
+
+// ModifyPlan applies the provider's defaults, and warns when a changed
+// start_with_counter would rewind the sequence behind the values it has
+// already produced.
+func (r *databaseSequenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only an update applies the counter to a sequence that has been used;
+	// Create either starts a new sequence or adopts one as it is.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.config == nil {
+		return
+	}
+
+	var plan, state databaseSequenceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Options == nil || plan.Options.StartWithCounter.IsNull() || plan.Options.StartWithCounter.IsUnknown() {
+		return
+	}
+	if state.Options != nil && state.Options.StartWithCounter.Equal(plan.Options.StartWithCounter) {
+		return
+	}
+
+	sequenceName := plan.resourceName()
+	counter, err := r.config.SpannerService.GetSpannerSequenceState(ctx, sequenceName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Could Not Check Sequence Counter",
+			"Could not read the internal counter of Sequence ("+sequenceName+") to check start_with_counter against it: "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	if sequenceCounterRewinds(plan.Options.StartWithCounter, counter) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("options").AtName("start_with_counter"),
+			"Sequence Counter Rewinds",
+			fmt.Sprintf("The planned start_with_counter (%d) is not above the internal counter of Sequence (%s), which is at %d. "+
+				"Applying it makes the sequence produce values it has already produced, which fails inserts into unique "+
+				"columns such as primary keys. Set start_with_counter above %d, or remove it to leave the counter where it is.",
+				plan.Options.StartWithCounter.ValueInt64(), sequenceName, counter.GetValue(), counter.GetValue()),
+		)
+	}
+}
+
 // Create ensures the sequence exists: a sequence already present in the
 // database is adopted into state as-is rather than treated as a conflict;
 // otherwise CREATE SEQUENCE DDL is issued with the planned options.
@@ -190,43 +266,20 @@ func (r *databaseSequenceResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 	if existingSequence != nil {
+		plan.InternalCounter = r.readInternalCounter(ctx, sequenceName, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		// Set state to fully populated data
 		diags = resp.State.Set(ctx, plan)
 		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 		return
 	}
 
 	// Create sequence from plan
-	sequence := &sequenceschema.SpannerSequence{
-		Name: sequenceName,
-	}
-
-	// Populate options if any
-	if plan.Options != nil {
-		sequenceOptions := &sequenceschema.SpannerSequenceOptions{
-			SequenceKind: sequenceschema.SpannerSequenceKindBitReversedPositive,
-		}
-
-		if !plan.Options.SequenceKind.IsNull() {
-			sequenceOptions.SequenceKind = sequenceschema.SpannerSequenceKindFromString(plan.Options.SequenceKind.ValueString())
-		}
-
-		if plan.Options.SkipRange != nil {
-			sequenceOptions.SkipRange = &sequenceschema.SpannerSequenceSkipRange{}
-			if !plan.Options.SkipRange.Min.IsNull() {
-				sequenceOptions.SkipRange.Min = wrapperspb.Int64(plan.Options.SkipRange.Min.ValueInt64())
-			}
-			if !plan.Options.SkipRange.Max.IsNull() {
-				sequenceOptions.SkipRange.Max = wrapperspb.Int64(plan.Options.SkipRange.Max.ValueInt64())
-			}
-		}
-
-		if !plan.Options.StartWithCounter.IsNull() {
-			sequenceOptions.StartWithCounter = wrapperspb.Int64(plan.Options.StartWithCounter.ValueInt64())
-		}
-
-		sequence.Options = sequenceOptions
-	}
+	sequence := sequenceFromModel(sequenceName, plan.Options, nil)
 
 	_, err = r.config.SpannerService.CreateSpannerSequence(ctx,
 		names.DatabaseName{Project: project, Instance: instance, Database: databaseId}.String(),
@@ -239,10 +292,15 @@ func (r *databaseSequenceResource) Create(ctx context.Context, req resource.Crea
 		)
 		return
 	}
+	plan.InternalCounter = r.readInternalCounter(ctx, sequenceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -306,19 +364,26 @@ func (r *databaseSequenceResource) Read(ctx context.Context, req resource.ReadRe
 		state.Options = options
 	}
 
+	state.InternalCounter = r.readInternalCounter(ctx, sequenceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *databaseSequenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan databaseSequenceModel
+	// Retrieve values from plan and prior state
+	var plan, state databaseSequenceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -339,37 +404,9 @@ func (r *databaseSequenceResource) Update(ctx context.Context, req resource.Upda
 
 	sequenceName := names.SequenceName{Project: project, Instance: instanceName, Database: databaseId, Sequence: sequenceId}.String()
 
-	// Generate sequence from plan
-	sequence := &sequenceschema.SpannerSequence{
-		Name: sequenceName,
-	}
-
-	// Populate options if any
-	if plan.Options != nil {
-		sequenceOptions := &sequenceschema.SpannerSequenceOptions{
-			SequenceKind: sequenceschema.SpannerSequenceKindBitReversedPositive,
-		}
-
-		if !plan.Options.SequenceKind.IsNull() {
-			sequenceOptions.SequenceKind = sequenceschema.SpannerSequenceKindFromString(plan.Options.SequenceKind.ValueString())
-		}
-
-		if plan.Options.SkipRange != nil {
-			sequenceOptions.SkipRange = &sequenceschema.SpannerSequenceSkipRange{}
-			if !plan.Options.SkipRange.Min.IsNull() {
-				sequenceOptions.SkipRange.Min = wrapperspb.Int64(plan.Options.SkipRange.Min.ValueInt64())
-			}
-			if !plan.Options.SkipRange.Max.IsNull() {
-				sequenceOptions.SkipRange.Max = wrapperspb.Int64(plan.Options.SkipRange.Max.ValueInt64())
-			}
-		}
-
-		if !plan.Options.StartWithCounter.IsNull() {
-			sequenceOptions.StartWithCounter = wrapperspb.Int64(plan.Options.StartWithCounter.ValueInt64())
-		}
-
-		sequence.Options = sequenceOptions
-	}
+	// Generate sequence from plan. An unchanged start_with_counter is left
+	// out: setting it again would rewind the counter to where it started.
+	sequence := sequenceFromModel(sequenceName, plan.Options, state.Options)
 
 	_, err := r.config.SpannerService.UpdateSpannerSequence(ctx, sequence)
 	if err != nil {
@@ -382,6 +419,10 @@ func (r *databaseSequenceResource) Update(ctx context.Context, req resource.Upda
 
 	// Map response body to schema and populate Computed attribute values
 	plan.Sequence = types.StringValue(sequenceId)
+	plan.InternalCounter = r.readInternalCounter(ctx, sequenceName, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -428,13 +469,18 @@ func (r *databaseSequenceResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *databaseSequenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Split import ID to get project, instance, and database id
 	// projects/{project}/instances/{instance}/databases/{database}/sequences/{sequence}
-	importName, err := names.ParseSequence(req.ID)
+	importName, err := names.ParseSequence(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/sequences/{sequence}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/sequences/{sequence}: "+err.Error(),
 		)
 		return
 	}
@@ -467,3 +513,71 @@ func (r *databaseSequenceResource) ConfigValidators(ctx context.Context) []resou
 		//),
 	}
 }
+
+// readInternalCounter reads the live internal counter of the sequence, null
+// while the sequence has not produced a value.
+func (r *databaseSequenceResource) readInternalCounter(ctx context.Context, sequenceName string, diags *diag.Diagnostics) types.Int64 {
+	counter, err := r.config.SpannerService.GetSpannerSequenceState(ctx, sequenceName)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Database Sequence",
+			"Could not read the internal counter of Sequence ("+sequenceName+"): "+utils.ErrDetail(err),
+		)
+		return types.Int64Null()
+	}
+	if counter == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(counter.GetValue())
+}
+
+// sequenceFromModel builds the sequence to create or alter from the planned
+// options. When prior, the options in state, already holds the planned
+// start_with_counter, the counter is left out so it is not set again.
+func sequenceFromModel(sequenceName string, options, prior *spannerSequenceOptions) *sequenceschema.SpannerSequence {
+	sequence := &sequenceschema.SpannerSequence{
+		Name: sequenceName,
+	}
+	if options == nil {
+		return sequence
+	}
+
+	sequenceOptions := &sequenceschema.SpannerSequenceOptions{
+		SequenceKind: sequenceschema.SpannerSequenceKindBitReversedPositive,
+	}
+
+	if !options.SequenceKind.IsNull() {
+		sequenceOptions.SequenceKind = sequenceschema.SpannerSequenceKindFromString(options.SequenceKind.ValueString())
+	}
+
+	if options.SkipRange != nil {
+		sequenceOptions.SkipRange = &sequenceschema.SpannerSequenceSkipRange{}
+		if !options.SkipRange.Min.IsNull() {
+			sequenceOptions.SkipRange.Min = wrapperspb.Int64(options.SkipRange.Min.ValueInt64())
+		}
+		if !options.SkipRange.Max.IsNull() {
+			sequenceOptions.SkipRange.Max = wrapperspb.Int64(options.SkipRange.Max.ValueInt64())
+		}
+	}
+
+	counterUnchanged := prior != nil && prior.StartWithCounter.Equal(options.StartWithCounter)
+	if !options.StartWithCounter.IsNull() && !counterUnchanged {
+		sequenceOptions.StartWithCounter = wrapperspb.Int64(options.StartWithCounter.ValueInt64())
+	}
+
+	sequence.Options = sequenceOptions
+
+	return sequence
+}
+
+// sequenceCounterRewinds reports whether setting the counter to planned would
+// make the sequence produce again the value behind live, the counter it has
+// reached. A sequence that has not produced a value yet cannot be rewound.
+func sequenceCounterRewinds(planned types.Int64, live *wrapperspb.Int64Value) bool {
+	if planned.IsNull() || planned.IsUnknown() || live == nil {
+		return false
+	}
+
+	return planned.ValueInt64() <= live.GetValue()
+}
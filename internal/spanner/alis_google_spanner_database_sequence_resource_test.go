@@ -0,0 +1,88 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testSequenceName = "projects/test-project/instances/test-instance/databases/test-db/sequences/order_ids"
+
+func testSequenceOptions(startWithCounter types.Int64, skipMax int64) *spannerSequenceOptions {
+	return &spannerSequenceOptions{
+		SequenceKind:     types.StringValue("bit_reversed_positive"),
+		SkipRange:        &spannerSequenceSkipRange{Min: types.Int64Value(1), Max: types.Int64Value(skipMax)},
+		StartWithCounter: startWithCounter,
+	}
+}
+
+// Every ALTER that sets start_with_counter moves the counter back to it, so
+// an update that only touches another option must leave the counter out.
+func TestSequenceFromModel_CounterOnlyWhenChanged(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     *spannerSequenceOptions
+		prior       *spannerSequenceOptions
+		wantCounter *wrapperspb.Int64Value
+	}{
+		{
+			name:        "create",
+			options:     testSequenceOptions(types.Int64Value(500), 1000),
+			wantCounter: wrapperspb.Int64(500),
+		},
+		{
+			name:    "other option changed",
+			options: testSequenceOptions(types.Int64Value(500), 2000),
+			prior:   testSequenceOptions(types.Int64Value(500), 1000),
+		},
+		{
+			name:        "counter changed",
+			options:     testSequenceOptions(types.Int64Value(900), 1000),
+			prior:       testSequenceOptions(types.Int64Value(500), 1000),
+			wantCounter: wrapperspb.Int64(900),
+		},
+		{
+			name:        "counter added",
+			options:     testSequenceOptions(types.Int64Value(900), 1000),
+			prior:       testSequenceOptions(types.Int64Null(), 1000),
+			wantCounter: wrapperspb.Int64(900),
+		},
+		{
+			name:    "counter removed",
+			options: testSequenceOptions(types.Int64Null(), 1000),
+			prior:   testSequenceOptions(types.Int64Value(500), 1000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sequence := sequenceFromModel(testSequenceName, tt.options, tt.prior)
+
+			assert.Equal(t, testSequenceName, sequence.GetName())
+			assert.Equal(t, tt.options.SkipRange.Max.ValueInt64(), sequence.GetOptions().GetSkipRange().GetMax().GetValue())
+			assert.Equal(t, tt.wantCounter, sequence.GetOptions().GetStartWithCounter())
+		})
+	}
+}
+
+func TestSequenceCounterRewinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		planned types.Int64
+		live    *wrapperspb.Int64Value
+		want    bool
+	}{
+		{"ahead of the counter", types.Int64Value(101), wrapperspb.Int64(100), false},
+		{"at the counter", types.Int64Value(100), wrapperspb.Int64(100), true},
+		{"behind the counter", types.Int64Value(10), wrapperspb.Int64(100), true},
+		{"unused sequence", types.Int64Value(10), nil, false},
+		{"no counter planned", types.Int64Null(), wrapperspb.Int64(100), false},
+		{"counter not yet known", types.Int64Unknown(), wrapperspb.Int64(100), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sequenceCounterRewinds(tt.planned, tt.live))
+		})
+	}
+}
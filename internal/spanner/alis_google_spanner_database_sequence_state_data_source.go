@@ -0,0 +1,129 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &databaseSequenceStateDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseSequenceStateDataSource{}
+)
+
+// NewDatabaseSequenceStateDataSource is a helper function to simplify the provider implementation.
+func NewDatabaseSequenceStateDataSource() datasource.DataSource {
+	return &databaseSequenceStateDataSource{}
+}
+
+type databaseSequenceStateDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type databaseSequenceStateDataSourceModel struct {
+	Project         types.String `tfsdk:"project"`
+	Instance        types.String `tfsdk:"instance"`
+	Database        types.String `tfsdk:"database"`
+	Sequence        types.String `tfsdk:"sequence"`
+	InternalCounter types.Int64  `tfsdk:"internal_counter"`
+}
+
+// Metadata returns the data source type name.
+func (d *databaseSequenceStateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_database_sequence_state"
+}
+
+// Schema defines the schema for the data source.
+func (d *databaseSequenceStateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the sequence.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"sequence": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the sequence to read.",
+			},
+			"internal_counter": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The internal counter of the sequence before bit reversal, as GET_INTERNAL_SEQUENCE_STATE reports it. " +
+					"Null until the sequence produces its first value.",
+			},
+		},
+		MarkdownDescription: "Reads where a Spanner sequence is: the internal counter behind the last value it produced. " +
+			"Use it to pick a start_with_counter that moves a sequence forward, for example after importing rows with their own keys.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *databaseSequenceStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state databaseSequenceStateDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sequenceName := names.SequenceName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Sequence: state.Sequence.ValueString(),
+	}.String()
+
+	counter, err := d.config.SpannerService.GetSpannerSequenceState(ctx, sequenceName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Sequence State",
+			"Could not read the internal counter of Sequence ("+sequenceName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.InternalCounter = types.Int64Null()
+	if counter != nil {
+		state.InternalCounter = types.Int64Value(counter.GetValue())
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *databaseSequenceStateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
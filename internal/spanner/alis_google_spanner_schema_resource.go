@@ -0,0 +1,463 @@
+package spanner
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	spannerschema "terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &schemaResource{}
+	_ resource.ResourceWithConfigure      = &schemaResource{}
+	_ resource.ResourceWithImportState    = &schemaResource{}
+	_ resource.ResourceWithModifyPlan     = &schemaResource{}
+	_ resource.ResourceWithValidateConfig = &schemaResource{}
+)
+
+// NewSchemaResource is a helper function to simplify the provider implementation.
+func NewSchemaResource() resource.Resource {
+	return &schemaResource{}
+}
+
+type schemaResource struct {
+	config *internal.ProviderConfig
+}
+
+type schemaModel struct {
+	Project      types.String   `tfsdk:"project"`
+	Instance     types.String   `tfsdk:"instance"`
+	Database     types.String   `tfsdk:"database"`
+	Ddl          types.List     `tfsdk:"ddl"`
+	MigrationDdl types.List     `tfsdk:"migration_ddl"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *schemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_schema"
+}
+
+// Schema defines the schema for the resource.
+func (r *schemaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The GoogleSQL database ID whose schema is managed. The database must already exist.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"ddl": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				MarkdownDescription: "The complete desired schema as GoogleSQL DDL. An element may hold several statements separated by `;`, " +
+					"so a whole `.sql` file can be passed with `file()`.\n" +
+					"Only `CREATE TABLE`, `CREATE [UNIQUE] [NULL_FILTERED] INDEX`, and `ALTER TABLE ... ADD CONSTRAINT ... FOREIGN KEY` " +
+					"or `ADD ROW DELETION POLICY` statements are accepted. Tables, indexes, foreign keys and row deletion policies " +
+					"the database has but this list does not declare are dropped; other objects, such as roles and views, are left alone.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"migration_ddl": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "The statements the planned apply runs, in order, as one schema update; empty when the database already matches `ddl`.\n" +
+					"The apply fails without running anything if the live schema changed since plan time and the statements no longer match.",
+			},
+		},
+		MarkdownDescription: "Manages the tables, indexes, foreign keys and row deletion policies of a Cloud Spanner GoogleSQL database " +
+			"declaratively from a DDL file.\n" +
+			"The desired DDL is diffed against the live schema object by object, and only the statements needed to migrate are run. " +
+			"Changes the table resources would plan as a replace (a changed column type, primary key or interleave) drop and recreate " +
+			"the table here too, and are reported as plan warnings along with every dropped table and column.\n" +
+			"Do not manage the same objects with this resource and the granular table, index, foreign key or TTL policy resources.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults, previews the migration as
+// migration_ddl and surfaces the replace and data-loss warnings of the diff
+// at plan time.
+func (r *schemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Schema is left in place",
+			"Destroying alis_google_spanner_schema only removes it from state; no table, index, or constraint is dropped.",
+		)
+		return
+	}
+
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan schemaModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a configured provider (e.g. during validate) there is nothing
+	// to diff against yet.
+	if r.config == nil || plan.Project.IsUnknown() || plan.Instance.IsUnknown() || plan.Database.IsUnknown() || plan.Ddl.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("migration_ddl"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	var ddl []types.String
+	resp.Diagnostics.Append(plan.Ddl.ElementsAs(ctx, &ddl, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	statements := make([]string, 0, len(ddl))
+	for _, statement := range ddl {
+		if statement.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("migration_ddl"), types.ListUnknown(types.StringType))...)
+			return
+		}
+		statements = append(statements, statement.ValueString())
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  plan.Project.ValueString(),
+		Instance: plan.Instance.ValueString(),
+		Database: plan.Database.ValueString(),
+	}.String()
+
+	migration, err := r.config.SpannerService.PlanSpannerDatabaseSchema(ctx, databaseName, statements)
+	if err != nil {
+		// The database may be created in the same apply.
+		if status.Code(err) == codes.NotFound {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("migration_ddl"), types.ListUnknown(types.StringType))...)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Planning Schema Migration",
+			"Could not diff the schema of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	migrationDdl, diags := types.ListValueFrom(ctx, types.StringType, migrationStatements(migration))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("migration_ddl"), migrationDdl)...)
+
+	for _, warning := range migration.Warnings {
+		resp.Diagnostics.AddWarning(warning.Summary, warning.Detail)
+	}
+}
+
+// Create migrates the database to the configured schema.
+func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan schemaModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.migrate(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read detects drift by diffing the schema in state against the live one.
+// On drift, or right after import, ddl is replaced with the live schema
+// rendered as DDL, so the next plan shows the statements that restore the
+// configuration.
+func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state schemaModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+	}.String()
+
+	live, err := r.config.SpannerService.GetSpannerDatabaseSchema(ctx, databaseName)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Schema",
+			"Could not read the schema of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	drifted := state.Ddl.IsNull()
+	if !drifted {
+		var ddl []string
+		resp.Diagnostics.Append(state.Ddl.ElementsAs(ctx, &ddl, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A state that no longer parses is treated as drifted and replaced.
+		recorded, err := spannerschema.ParseDdl(databaseName, ddl...)
+		if err != nil {
+			drifted = true
+		} else {
+			migration, err := recorded.AlterDdl(live)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading Schema",
+					"Could not diff the schema of Database ("+databaseName+"): "+err.Error(),
+				)
+				return
+			}
+			drifted = len(migration.Statements) > 0
+		}
+	}
+
+	if drifted {
+		ddl, err := live.CreateDdl()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Schema",
+				"Could not render the schema of Database ("+databaseName+"): "+err.Error(),
+			)
+			return
+		}
+
+		state.Ddl, diags = types.ListValueFrom(ctx, types.StringType, ddl)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	state.MigrationDdl = types.ListValueMust(types.StringType, nil)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update migrates the database from its live schema to the planned one.
+func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan schemaModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.migrate(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the resource from state. Dropping every table of a
+// database because a resource was destroyed or moved is never what is
+// wanted; drop the database itself to remove its data.
+func (r *schemaResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Split import ID to get project, instance, and database id
+	// projects/{project}/instances/{instance}/databases/{database}
+	importName, err := names.ParseDatabase(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *schemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
+
+// ValidateConfig parses ddl offline, so syntax errors and statements the
+// diff does not manage are reported before the database is contacted.
+func (r *schemaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ddl types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("ddl"), &ddl)...)
+	if resp.Diagnostics.HasError() || ddl.IsNull() || ddl.IsUnknown() {
+		return
+	}
+
+	var elements []types.String
+	resp.Diagnostics.Append(ddl.ElementsAs(ctx, &elements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Table names embed the database name, which plays no part in
+	// validation, so any well-formed name will do.
+	placeholder := names.DatabaseName{Project: "project", Instance: "instance", Database: "database"}.String()
+	for i, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+
+		parsed, err := spannerschema.ParseDdl(placeholder, element.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ddl").AtListIndex(i),
+				"Invalid Schema DDL",
+				"Could not parse the DDL: "+err.Error(),
+			)
+			continue
+		}
+		for _, object := range parsed.UndiffedObjects() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ddl").AtListIndex(i),
+				"Unsupported Schema DDL",
+				fmt.Sprintf("The DDL declares %s, which is not a table, index, foreign key, or row deletion policy. "+
+					"Manage other objects with their own resources.", object),
+			)
+		}
+	}
+}
+
+// migrate runs the migration to model.Ddl and records the statements it ran
+// as model.MigrationDdl. A known planned migration_ddl must still be the
+// migration, or nothing runs.
+func (r *schemaResource) migrate(ctx context.Context, model *schemaModel, diagnostics *diag.Diagnostics) {
+	databaseName := names.DatabaseName{
+		Project:  model.Project.ValueString(),
+		Instance: model.Instance.ValueString(),
+		Database: model.Database.ValueString(),
+	}.String()
+
+	var ddl []string
+	diagnostics.Append(model.Ddl.ElementsAs(ctx, &ddl, false)...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	var planned []string
+	if !model.MigrationDdl.IsUnknown() && !model.MigrationDdl.IsNull() {
+		planned = []string{}
+		diagnostics.Append(model.MigrationDdl.ElementsAs(ctx, &planned, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	migration, err := r.config.SpannerService.UpdateSpannerDatabaseSchema(ctx, databaseName, ddl, planned)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Migrating Schema",
+			"Could not migrate the schema of Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	var diags diag.Diagnostics
+	model.MigrationDdl, diags = types.ListValueFrom(ctx, types.StringType, migrationStatements(migration))
+	diagnostics.Append(diags...)
+}
+
+// migrationStatements returns the statements of migration, never nil, so an
+// empty migration is an empty list rather than a null one.
+func migrationStatements(migration *spannerschema.SchemaMigration) []string {
+	if migration.Statements == nil {
+		return []string{}
+	}
+	return migration.Statements
+}
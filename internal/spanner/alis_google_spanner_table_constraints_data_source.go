@@ -0,0 +1,236 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/services"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tableConstraintsDataSource{}
+	_ datasource.DataSourceWithConfigure = &tableConstraintsDataSource{}
+)
+
+// NewTableConstraintsDataSource is a helper function to simplify the provider implementation.
+func NewTableConstraintsDataSource() datasource.DataSource {
+	return &tableConstraintsDataSource{}
+}
+
+type tableConstraintsDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type tableConstraintsDataSourceModel struct {
+	Project     types.String                      `tfsdk:"project"`
+	Instance    types.String                      `tfsdk:"instance"`
+	Database    types.String                      `tfsdk:"database"`
+	Table       types.String                      `tfsdk:"table"`
+	ForeignKeys []*tableConstraintsDataForeignKey `tfsdk:"foreign_keys"`
+	Checks      []*tableConstraintsDataCheck      `tfsdk:"checks"`
+}
+
+type tableConstraintsDataForeignKey struct {
+	Name              types.String   `tfsdk:"name"`
+	Columns           []types.String `tfsdk:"columns"`
+	ReferencedTable   types.String   `tfsdk:"referenced_table"`
+	ReferencedColumns []types.String `tfsdk:"referenced_columns"`
+	OnDelete          types.String   `tfsdk:"on_delete"`
+	Enforced          types.Bool     `tfsdk:"enforced"`
+}
+
+type tableConstraintsDataCheck struct {
+	Name       types.String `tfsdk:"name"`
+	Expression types.String `tfsdk:"expression"`
+	Enforced   types.Bool   `tfsdk:"enforced"`
+}
+
+// Metadata returns the data source type name.
+func (d *tableConstraintsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_constraints"
+}
+
+// Schema defines the schema for the data source.
+func (d *tableConstraintsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"table": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The table whose constraints are listed.",
+			},
+			"foreign_keys": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the foreign key constraint.",
+						},
+						"columns": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The referencing columns of this table, in key order.",
+						},
+						"referenced_table": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the referenced table.",
+						},
+						"referenced_columns": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The referenced columns, paired by position with `columns`.",
+						},
+						"on_delete": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The action taken when the referenced row is deleted, `CASCADE` or `NO ACTION`.",
+						},
+						"enforced": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Spanner validates the constraint on writes.",
+						},
+					},
+				},
+				MarkdownDescription: "The foreign keys of the table, in name order.",
+			},
+			"checks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the check constraint.",
+						},
+						"expression": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The boolean expression every row must satisfy.",
+						},
+						"enforced": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether Spanner validates the constraint on writes.",
+						},
+					},
+				},
+				MarkdownDescription: "The check constraints of the table, in name order. " +
+					"The checks Spanner derives from `NOT NULL` columns are not included.",
+			},
+		},
+		MarkdownDescription: "Lists the foreign key and check constraints of a Spanner table, whether or not this configuration manages them.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tableConstraintsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tableConstraintsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := names.TableName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Table:    state.Table.ValueString(),
+	}.String()
+
+	// An unknown table would read as one without constraints.
+	if _, err := d.config.SpannerService.GetSpannerTable(ctx, tableName); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table Constraints",
+			"Could not read Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	constraints, err := d.config.SpannerService.ListSpannerTableConstraints(ctx, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table Constraints",
+			"Could not list constraints for Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.ForeignKeys = make([]*tableConstraintsDataForeignKey, 0)
+	state.Checks = make([]*tableConstraintsDataCheck, 0)
+	for _, constraint := range constraints {
+		switch constraint.Type {
+		case services.TableConstraintTypeForeignKey:
+			onDelete := types.StringNull()
+			if action := constraint.OnDelete.String(); action != "" {
+				onDelete = types.StringValue(action)
+			}
+			state.ForeignKeys = append(state.ForeignKeys, &tableConstraintsDataForeignKey{
+				Name:              types.StringValue(constraint.Name),
+				Columns:           stringValues(constraint.Columns),
+				ReferencedTable:   types.StringValue(constraint.ReferencedTable),
+				ReferencedColumns: stringValues(constraint.ReferencedColumns),
+				OnDelete:          onDelete,
+				Enforced:          types.BoolValue(constraint.Enforced),
+			})
+		case services.TableConstraintTypeCheck:
+			state.Checks = append(state.Checks, &tableConstraintsDataCheck{
+				Name:       types.StringValue(constraint.Name),
+				Expression: types.StringValue(constraint.CheckClause),
+				Enforced:   types.BoolValue(constraint.Enforced),
+			})
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tableConstraintsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
+
+// stringValues converts values to Terraform strings, keeping an empty slice
+// as an empty list rather than null.
+func stringValues(values []string) []types.String {
+	res := make([]types.String, 0, len(values))
+	for _, value := range values {
+		res = append(res, types.StringValue(value))
+	}
+
+	return res
+}
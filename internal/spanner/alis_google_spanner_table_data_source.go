@@ -0,0 +1,239 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tableDataSource{}
+	_ datasource.DataSourceWithConfigure = &tableDataSource{}
+)
+
+// NewTableDataSource is a helper function to simplify the provider implementation.
+func NewTableDataSource() datasource.DataSource {
+	return &tableDataSource{}
+}
+
+// tableDataSource reads a table the configuration does not manage — for
+// example one owned by another state — as SpannerTable.Get hydrates it from
+// INFORMATION_SCHEMA.
+type tableDataSource struct {
+	config *internal.ProviderConfig
+}
+
+// tableDataSourceModel shares the schema and interleave shapes of
+// spannerTableModel so references to either read the same; the
+// resource-only prevent_destroy and timeouts are left out.
+type tableDataSourceModel struct {
+	Project    types.String            `tfsdk:"project"`
+	Instance   types.String            `tfsdk:"instance"`
+	Database   types.String            `tfsdk:"database"`
+	Name       types.String            `tfsdk:"name"`
+	Schema     *spannerTableSchema     `tfsdk:"schema"`
+	PrimaryKey []types.String          `tfsdk:"primary_key"`
+	Interleave *spannerTableInterleave `tfsdk:"interleave"`
+}
+
+// Metadata returns the data source type name.
+func (d *tableDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table"
+}
+
+// tableColumnsDataSourceAttribute is the computed counterpart of the table
+// resource's schema.columns attribute.
+func tableColumnsDataSourceAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The name of the column.",
+				},
+				"is_primary_key": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Indicates if the column is part of the primary key.",
+				},
+				"is_computed": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Indicates if the column is a generated column.",
+				},
+				"computation_ddl": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The expression of the generated column.",
+				},
+				"is_stored": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Indicates if the generated column is stored.",
+				},
+				"auto_update_time": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Indicates if the column auto populates on row update.",
+				},
+				"type": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The data type of the column, as the table resource names it.",
+				},
+				"size": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The maximum size of the column, when the type has one.",
+				},
+				"required": schema.BoolAttribute{
+					Computed:            true,
+					MarkdownDescription: "Indicates if the column is `NOT NULL`.",
+				},
+				"default_value": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The column default expression.",
+				},
+				"proto_package": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The full name of the proto message or enum of a `PROTO` or `ENUM` column.",
+				},
+				"key_order": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The sort order of the column within the primary key, `asc` or `desc`.",
+				},
+				"key_position": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "The 1-based position of the column within the primary key.",
+				},
+			},
+		},
+		MarkdownDescription: "The columns of the table, in declaration order.",
+	}
+}
+
+// tableInterleaveDataSourceAttribute is the computed counterpart of the table
+// resource's interleave attribute.
+func tableInterleaveDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed: true,
+		Attributes: map[string]schema.Attribute{
+			"parent_table": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the parent table.",
+			},
+			"on_delete": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The action taken on the rows of this table when a parent row is deleted, `CASCADE` or `NO ACTION`.\n" +
+					"Null for a table interleaved with `INTERLEAVE IN`, which has no such action.",
+			},
+		},
+		MarkdownDescription: "The parent the table is interleaved in. Null for a top-level table.",
+	}
+}
+
+// Schema defines the schema for the data source.
+func (d *tableDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the table to read.",
+			},
+			"schema": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"columns": tableColumnsDataSourceAttribute(),
+				},
+				MarkdownDescription: "The schema of the table.",
+			},
+			"primary_key": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the primary key columns, in key order.",
+			},
+			"interleave": tableInterleaveDataSourceAttribute(),
+		},
+		MarkdownDescription: "Reads the columns, primary key and interleave of an existing Spanner table, " +
+			"whether or not this configuration manages it.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tableDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := names.TableName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Table:    state.Name.ValueString(),
+	}.String()
+
+	table, err := d.config.SpannerService.GetSpannerTable(ctx, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table",
+			"Could not read Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	columns, diags := tableColumnsToModel(ctx, table.GetSchema().GetColumns())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Schema = &spannerTableSchema{Columns: columns}
+
+	state.PrimaryKey = make([]types.String, 0)
+	for _, column := range table.GetSchema().PrimaryKey() {
+		state.PrimaryKey = append(state.PrimaryKey, types.StringValue(column.GetName()))
+	}
+
+	state.Interleave = tableInterleaveToModel(table.GetInterleave())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tableDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,6 +30,8 @@ var (
 	_ resource.Resource                = &spannerTableForeignKeyResource{}
 	_ resource.ResourceWithConfigure   = &spannerTableForeignKeyResource{}
 	_ resource.ResourceWithImportState = &spannerTableForeignKeyResource{}
+	_ resource.ResourceWithIdentity    = &spannerTableForeignKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &spannerTableForeignKeyResource{}
 )
 
 // NewTableForeignKeyResource is a helper function to simplify the provider implementation.
@@ -49,9 +53,22 @@ type spannerTableForeignKeyModel struct {
 	Column           types.String   `tfsdk:"column"`
 	ReferencedColumn types.String   `tfsdk:"referenced_column"`
 	OnDelete         types.String   `tfsdk:"on_delete"`
+	Enforced         types.Bool     `tfsdk:"enforced"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
+// resourceName returns the fully qualified name of the foreign key, which is
+// the resource identity and import ID.
+func (m spannerTableForeignKeyModel) resourceName() string {
+	return names.ForeignKeyName{
+		Project:    m.Project.ValueString(),
+		Instance:   m.Instance.ValueString(),
+		Database:   m.Database.ValueString(),
+		Table:      m.Table.ValueString(),
+		Constraint: m.Name.ValueString(),
+	}.String()
+}
+
 // Metadata returns the resource type name.
 func (r *spannerTableForeignKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_foreign_key"
@@ -70,25 +87,22 @@ func (r *spannerTableForeignKeyResource) Schema(ctx context.Context, _ resource.
 		},
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID in which the table belongs.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID in which the table belongs.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the Spanner instance.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the Spanner instance.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the parent database.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the parent database.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"table": schema.StringAttribute{
 				Required: true,
@@ -165,19 +179,37 @@ func (r *spannerTableForeignKeyResource) Schema(ctx context.Context, _ resource.
 				Required: true,
 				MarkdownDescription: "The action to take when the referenced row is deleted.\n" +
 					"Supported values are `CASCADE`, `NO_ACTION`.\n" +
+					"Changing this drops and re-adds the constraint in a single schema update.\n" +
 					"See https://cloud.google.com/spanner/docs/foreign-keys/overview#how-to-define-foreign-key-action",
 				Validators: []validator.String{
 					stringvalidator.OneOf(tableschema.SpannerTableConstraintActions...),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+			},
+			"enforced": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether Spanner validates the constraint on writes. Defaults to `true`.\n" +
+					"When `false` the key is created `NOT ENFORCED`: the query optimizer still uses it, but writes are not checked against it.\n" +
+					"Changing this drops and re-adds the constraint in a single schema update.\n" +
+					"See https://cloud.google.com/spanner/docs/foreign-keys/overview#informational-foreign-keys",
 			},
 		},
 		MarkdownDescription: "A Spanner Table Foreign Key resource. See https://cloud.google.com/spanner/docs/foreign-keys/overview",
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *spannerTableForeignKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *spannerTableForeignKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}/constraints/{constraint}")
+}
+
 // Create a new resource.
 func (r *spannerTableForeignKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -209,6 +241,7 @@ func (r *spannerTableForeignKeyResource) Create(ctx context.Context, req resourc
 		ReferencedColumn: plan.ReferencedColumn.ValueString(),
 		Column:           plan.Column.ValueString(),
 		OnDelete:         tableschema.SpannerTableConstraintActionFromString(plan.OnDelete.ValueString()),
+		Enforced:         wrapperspb.Bool(plan.Enforced.ValueBool()),
 	}
 
 	tableName := names.TableName{Project: project, Instance: instanceName, Database: databaseId, Table: tableId}.String()
@@ -226,6 +259,7 @@ func (r *spannerTableForeignKeyResource) Create(ctx context.Context, req resourc
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -272,29 +306,68 @@ func (r *spannerTableForeignKeyResource) Read(ctx context.Context, req resource.
 	state.ReferencedColumn = types.StringValue(constraint.ReferencedColumn)
 	state.Column = types.StringValue(constraint.Column)
 	state.OnDelete = types.StringValue(constraint.OnDelete.String())
+	state.Enforced = types.BoolValue(constraint.IsEnforced())
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *spannerTableForeignKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan spannerTableForeignKeyModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	// Retrieve values from plan and state
+	var plan, state spannerTableForeignKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Every real attribute carries RequiresReplace, so reaching Update means
-	// only the timeouts block changed; persist the plan without touching
-	// Spanner. Erroring here would make a timeouts-only change un-applyable.
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	// on_delete and enforced are the only attributes without RequiresReplace.
+	// When both are unchanged, only the timeouts block did; persist the plan
+	// without touching Spanner. Erroring here would make a timeouts-only
+	// change un-applyable.
+	if !plan.OnDelete.Equal(state.OnDelete) || !plan.Enforced.Equal(state.Enforced) {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ctx, cancel := withTimeout(ctx, updateTimeout)
+		defer cancel()
+
+		constraint := &tableschema.SpannerTableForeignKeyConstraint{
+			Name:             plan.Name.ValueString(),
+			ReferencedTable:  plan.ReferencedTable.ValueString(),
+			ReferencedColumn: plan.ReferencedColumn.ValueString(),
+			Column:           plan.Column.ValueString(),
+			OnDelete:         tableschema.SpannerTableConstraintActionFromString(plan.OnDelete.ValueString()),
+			Enforced:         wrapperspb.Bool(plan.Enforced.ValueBool()),
+		}
+
+		tableName := names.TableName{
+			Project:  plan.Project.ValueString(),
+			Instance: plan.Instance.ValueString(),
+			Database: plan.Database.ValueString(),
+			Table:    plan.Table.ValueString(),
+		}.String()
+
+		// Spanner has no ALTER CONSTRAINT; drop and re-add in one batch.
+		_, err := r.config.SpannerService.RecreateSpannerTableForeignKeyConstraint(ctx, tableName, constraint)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Foreign Key Constraint",
+				"Could not update Foreign Key Constraint ("+constraint.Name+") on Table ("+tableName+"): "+utils.ErrDetail(err),
+			)
+			return
+		}
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -351,11 +424,16 @@ func (r *spannerTableForeignKeyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	importName, err := names.ParseForeignKey(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseForeignKey(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/constraints/{constraint}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/constraints/{constraint}: "+err.Error(),
 		)
 		return
 	}
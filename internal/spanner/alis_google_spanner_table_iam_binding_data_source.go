@@ -37,6 +37,7 @@ type tableIamBindingModel struct {
 	Table       types.String   `tfsdk:"table"`
 	Role        types.String   `tfsdk:"role"`
 	Permissions []types.String `tfsdk:"permissions"`
+	Columns     types.Map      `tfsdk:"columns"`
 }
 
 // Metadata returns the resource type name.
@@ -49,16 +50,22 @@ func (r *tableIamBindingDataSource) Schema(_ context.Context, _ datasource.Schem
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Spanner instance ID that contains the database.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Spanner database ID that contains the table.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"table": schema.StringAttribute{
 				Required:            true,
@@ -74,6 +81,12 @@ func (r *tableIamBindingDataSource) Schema(_ context.Context, _ datasource.Schem
 				MarkdownDescription: "The permissions that should be granted to the role.\n" +
 					"Valid permissions are: `SELECT`, `INSERT`, `UPDATE`, `DELETE`.",
 			},
+			"columns": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.SetType{ElemType: types.StringType},
+				MarkdownDescription: "The columns a permission is limited to, keyed by permission. " +
+					"Permissions without a key are granted on the whole table; null when none are limited.",
+			},
 		},
 		MarkdownDescription: "Authoritative for a given role. Updates the table IAM policy to grant a role along with permissions.\n" +
 			"Other roles and permissions within the IAM policy for the table are preserved.",
@@ -90,6 +103,13 @@ func (r *tableIamBindingDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	state.Project = withProviderDefault(r.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(r.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(r.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Retrieve values from state
 	project := state.Project.ValueString()
 	instance := state.Instance.ValueString()
@@ -118,6 +138,11 @@ func (r *tableIamBindingDataSource) Read(ctx context.Context, req datasource.Rea
 			state.Permissions = append(state.Permissions, types.StringValue(permission.String()))
 		}
 	}
+	state.Columns, diags = tableIamBindingColumnsValue(ctx, binding)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -11,8 +11,10 @@ import (
 	"terraform-provider-alis/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,9 +28,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &tableIamBindingResource{}
-	_ resource.ResourceWithConfigure   = &tableIamBindingResource{}
-	_ resource.ResourceWithImportState = &tableIamBindingResource{}
+	_ resource.Resource                   = &tableIamBindingResource{}
+	_ resource.ResourceWithConfigure      = &tableIamBindingResource{}
+	_ resource.ResourceWithImportState    = &tableIamBindingResource{}
+	_ resource.ResourceWithIdentity       = &tableIamBindingResource{}
+	_ resource.ResourceWithValidateConfig = &tableIamBindingResource{}
+	_ resource.ResourceWithModifyPlan     = &tableIamBindingResource{}
 )
 
 // NewTableIamBindingResource is a helper function to simplify the provider implementation.
@@ -49,9 +54,16 @@ type tableIamBindingResourceModel struct {
 	Table       types.String   `tfsdk:"table"`
 	Role        types.String   `tfsdk:"role"`
 	Permissions []types.String `tfsdk:"permissions"`
+	Columns     types.Map      `tfsdk:"columns"`
 	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
+// resourceName returns the fully qualified name of the binding of the role on the table, which is
+// the resource identity and import ID.
+func (m tableIamBindingResourceModel) resourceName() string {
+	return names.TableRoleName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Table: m.Table.ValueString(), Role: m.Role.ValueString()}.String()
+}
+
 // Metadata returns the resource type name.
 func (r *tableIamBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_iam_binding"
@@ -70,28 +82,25 @@ func (r *tableIamBindingResource) Schema(ctx context.Context, _ resource.SchemaR
 		},
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"instance": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"database": schema.StringAttribute{
-				Required: true,
+				Optional: true,
+				Computed: true,
 				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.\n" +
 					"Changing this forces a new resource.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"table": schema.StringAttribute{
 				Required: true,
@@ -124,12 +133,31 @@ func (r *tableIamBindingResource) Schema(ctx context.Context, _ resource.SchemaR
 				MarkdownDescription: "The permissions that should be granted to the role.\n" +
 					"Valid permissions are: `SELECT`, `INSERT`, `UPDATE`, `DELETE`.",
 			},
+			"columns": schema.MapAttribute{
+				ElementType: types.SetType{ElemType: types.StringType},
+				Optional:    true,
+				Validators:  tableIamBindingColumnsValidators(),
+				MarkdownDescription: "Narrows permissions to some columns of the table, keyed by permission, e.g. `{ SELECT = [\"name\", \"email\"] }`. " +
+					"Each key must also be listed in `permissions`; permissions without a key are granted on the whole table.\n" +
+					"Valid keys are: `SELECT`, `INSERT`, `UPDATE`.",
+			},
 		},
 		MarkdownDescription: "Authoritative for a given role. Updates the table IAM policy to grant a role along with permissions.\n" +
 			"Other roles and permissions within the IAM policy for the table are preserved.",
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *tableIamBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *tableIamBindingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}")
+}
+
 // Create a new resource.
 func (r *tableIamBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -178,6 +206,12 @@ func (r *tableIamBindingResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
+	columns, diags := tableIamBindingColumns(ctx, plan.Columns)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tableName := names.TableName{Project: project, Instance: instance, Database: database, Table: table}.String()
 
 	binding, err := r.config.SpannerService.SetTableIamBinding(ctx,
@@ -185,6 +219,7 @@ func (r *tableIamBindingResource) Create(ctx context.Context, req resource.Creat
 		&services.TablePolicyBinding{
 			Role:        role,
 			Permissions: permissions,
+			Columns:     columns,
 		},
 	)
 	if err != nil {
@@ -207,6 +242,7 @@ func (r *tableIamBindingResource) Create(ctx context.Context, req resource.Creat
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -254,10 +290,16 @@ func (r *tableIamBindingResource) Read(ctx context.Context, req resource.ReadReq
 			state.Permissions = append(state.Permissions, types.StringValue(permission.String()))
 		}
 	}
+	state.Columns, diags = tableIamBindingColumnsValue(ctx, binding)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -310,6 +352,12 @@ func (r *tableIamBindingResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
+	columns, diags := tableIamBindingColumns(ctx, plan.Columns)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tableName := names.TableName{Project: project, Instance: instance, Database: database, Table: table}.String()
 
 	binding, err := r.config.SpannerService.SetTableIamBinding(ctx,
@@ -317,6 +365,7 @@ func (r *tableIamBindingResource) Update(ctx context.Context, req resource.Updat
 		&services.TablePolicyBinding{
 			Role:        role,
 			Permissions: permissions,
+			Columns:     columns,
 		},
 	)
 	if err != nil {
@@ -382,20 +431,25 @@ func (r *tableIamBindingResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *tableIamBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importName, err := names.ParseTableRole(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseTableRole(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}: "+err.Error(),
 		)
 		return
 	}
 
-	if !utils.Pattern(utils.SpannerGoogleSqlTableRoleNameRegex).MatchString(req.ID) &&
-		!utils.Pattern(utils.SpannerPostgresSqlTableRoleNameRegex).MatchString(req.ID) {
+	if !utils.Pattern(utils.SpannerGoogleSqlTableRoleNameRegex).MatchString(id) &&
+		!utils.Pattern(utils.SpannerPostgresSqlTableRoleNameRegex).MatchString(id) {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") contains an invalid project, instance, database, table or role ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}.",
+			"Import ID ("+id+") contains an invalid project, instance, database, table or role ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}.",
 		)
 		return
 	}
@@ -423,6 +477,101 @@ func (r *tableIamBindingResource) Configure(_ context.Context, req resource.Conf
 	r.config = config
 }
 
+// ValidateConfig rejects column lists for permissions the binding does not
+// grant, which would otherwise only fail at apply.
+func (r *tableIamBindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var permissions types.Set
+	var columns types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("columns"), &columns)...)
+	if resp.Diagnostics.HasError() || permissions.IsUnknown() || columns.IsNull() || columns.IsUnknown() {
+		return
+	}
+
+	granted := map[string]bool{}
+	for _, element := range permissions.Elements() {
+		permission, ok := element.(types.String)
+		if !ok || permission.IsUnknown() {
+			return
+		}
+		granted[permission.ValueString()] = true
+	}
+
+	for permission := range columns.Elements() {
+		if !granted[permission] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("columns").AtMapKey(permission),
+				"Ungranted Column Permission",
+				"Columns are listed for "+permission+", which is not in permissions.",
+			)
+		}
+	}
+}
+
+// tableIamBindingColumnPermissions are the permissions that can be narrowed
+// to columns. DELETE removes whole rows, so Spanner only grants it per table.
+var tableIamBindingColumnPermissions = []string{
+	services.TablePolicyBindingPermission_SELECT.String(),
+	services.TablePolicyBindingPermission_INSERT.String(),
+	services.TablePolicyBindingPermission_UPDATE.String(),
+}
+
+// tableIamBindingColumnsValidators validates a columns map: keys are
+// permissions that can be narrowed and values non-empty sets of column names.
+func tableIamBindingColumnsValidators() []validator.Map {
+	return []validator.Map{
+		// A binding narrowing nothing reads back as null, so an empty map
+		// would plan a change on every run.
+		mapvalidator.SizeAtLeast(1),
+		mapvalidator.KeysAre(stringvalidator.OneOf(tableIamBindingColumnPermissions...)),
+		mapvalidator.ValueSetsAre(
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(validators.RegexMatches([]*regexp.Regexp{
+				utils.Pattern(utils.SpannerGoogleSqlColumnIdRegex),
+				utils.Pattern(utils.SpannerPostgresSqlColumnIdRegex),
+			}, "Column must be a valid Spanner column name")),
+		),
+	}
+}
+
+// tableIamBindingColumns converts the columns attribute to the binding's
+// per-permission column lists. A null map narrows nothing.
+func tableIamBindingColumns(ctx context.Context, value types.Map) (map[services.TablePolicyBindingPermission][]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var raw map[string][]string
+	if diags := value.ElementsAs(ctx, &raw, false); diags.HasError() {
+		return nil, diags
+	}
+
+	columns := make(map[services.TablePolicyBindingPermission][]string, len(raw))
+	for _, permission := range services.TablePolicyBindingPermissions {
+		if list, ok := raw[permission.String()]; ok {
+			columns[permission] = list
+		}
+	}
+
+	return columns, nil
+}
+
+// tableIamBindingColumnsValue renders the binding's column lists as the
+// columns attribute, null when every permission covers the whole table.
+func tableIamBindingColumnsValue(ctx context.Context, binding *services.TablePolicyBinding) (types.Map, diag.Diagnostics) {
+	elementType := types.SetType{ElemType: types.StringType}
+	if len(binding.Columns) == 0 {
+		return types.MapNull(elementType), nil
+	}
+
+	raw := make(map[string][]string, len(binding.Columns))
+	for permission, columns := range binding.Columns {
+		raw[permission.String()] = columns
+	}
+
+	return types.MapValueFrom(ctx, elementType, raw)
+}
+
 func (r *tableIamBindingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
 	return []resource.ConfigValidator{
 		//resourcevalidator.Conflicting(
@@ -0,0 +1,414 @@
+package spanner
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/services"
+	"terraform-provider-alis/internal/utils"
+	"terraform-provider-alis/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &tableIamMemberResource{}
+	_ resource.ResourceWithConfigure      = &tableIamMemberResource{}
+	_ resource.ResourceWithImportState    = &tableIamMemberResource{}
+	_ resource.ResourceWithIdentity       = &tableIamMemberResource{}
+	_ resource.ResourceWithValidateConfig = &tableIamMemberResource{}
+	_ resource.ResourceWithModifyPlan     = &tableIamMemberResource{}
+)
+
+// NewTableIamMemberResource is a helper function to simplify the provider implementation.
+func NewTableIamMemberResource() resource.Resource {
+	return &tableIamMemberResource{}
+}
+
+type tableIamMemberResource struct {
+	config *internal.ProviderConfig
+}
+
+type tableIamMemberModel struct {
+	Project    types.String   `tfsdk:"project"`
+	Instance   types.String   `tfsdk:"instance"`
+	Database   types.String   `tfsdk:"database"`
+	Table      types.String   `tfsdk:"table"`
+	Role       types.String   `tfsdk:"role"`
+	Permission types.String   `tfsdk:"permission"`
+	Columns    types.Set      `tfsdk:"columns"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// tableName returns the fully qualified name of the table the permission is
+// granted on.
+func (m tableIamMemberModel) tableName() string {
+	return names.TableName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Table: m.Table.ValueString()}.String()
+}
+
+// resourceName returns the fully qualified name of the member, which is the
+// resource identity and import ID.
+func (m tableIamMemberModel) resourceName() string {
+	return names.TablePermissionName{
+		Project:    m.Project.ValueString(),
+		Instance:   m.Instance.ValueString(),
+		Database:   m.Database.ValueString(),
+		Table:      m.Table.ValueString(),
+		Role:       m.Role.ValueString(),
+		Permission: m.Permission.ValueString(),
+	}.String()
+}
+
+// member converts the model to the service member.
+func (m tableIamMemberModel) member(ctx context.Context) (*services.TablePolicyMember, diag.Diagnostics) {
+	member := &services.TablePolicyMember{
+		Role:       m.Role.ValueString(),
+		Permission: tableIamPermission(m.Permission.ValueString()),
+	}
+	if m.Columns.IsNull() || m.Columns.IsUnknown() {
+		return member, nil
+	}
+
+	member.Columns = []string{}
+	diags := m.Columns.ElementsAs(ctx, &member.Columns, false)
+
+	return member, diags
+}
+
+// Metadata returns the resource type name.
+func (r *tableIamMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_iam_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *tableIamMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"table": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The table the permission is granted on.\n" +
+					"Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:   true,
+				Validators: roleIdValidators("Role"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The role the permission is granted to.\n" +
+					"Changing this forces a new resource.",
+			},
+			"permission": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(services.SpannerTablePolicyBindingPermissions...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "The permission granted to the role.\n" +
+					"Valid permissions are: `SELECT`, `INSERT`, `UPDATE`, `DELETE`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"columns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.RegexMatches([]*regexp.Regexp{
+						utils.Pattern(utils.SpannerGoogleSqlColumnIdRegex),
+						utils.Pattern(utils.SpannerPostgresSqlColumnIdRegex),
+					}, "Column must be a valid Spanner column name")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				MarkdownDescription: "Narrows the permission to these columns of the table. " +
+					"Unset grants it on the whole table. `DELETE` cannot be narrowed.\n" +
+					"Changing this forces a new resource.",
+			},
+		},
+		MarkdownDescription: "Additive. Grants a role one permission on a table, optionally on some of its columns only.\n" +
+			"The role's other permissions are preserved, and destroying the member revokes only its own permission, " +
+			"so several configurations can each grant a permission to a shared role. " +
+			"Do not use with `alis_google_spanner_table_iam_policy` on the same table, or with " +
+			"`alis_google_spanner_table_iam_binding` for the same role.",
+	}
+}
+
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *tableIamMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *tableIamMemberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}/permissions/{permission}")
+}
+
+// ValidateConfig rejects columns on DELETE, which Spanner only grants on the
+// whole table.
+func (r *tableIamMemberResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config tableIamMemberModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Permission.ValueString() == services.TablePolicyBindingPermission_DELETE.String() && !config.Columns.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("columns"),
+			"Invalid Column Permission",
+			"DELETE cannot be narrowed to columns. Remove columns to grant it on the whole table.",
+		)
+	}
+}
+
+// Create grants the permission.
+func (r *tableIamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan tableIamMemberModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	member, diags := plan.member(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := plan.tableName()
+	if _, err := r.config.SpannerService.AddTableIamMember(ctx, tableName, member); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Table IAM Member",
+			"Could not grant "+member.Permission.String()+" to Role ("+member.Role+") on Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
+}
+
+// Read resource information.
+func (r *tableIamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state tableIamMemberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, diags := state.member(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberName := state.resourceName()
+	held, err := r.config.SpannerService.GetTableIamMember(ctx, state.tableName(), member.Role, member.Permission)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Table IAM Member",
+			"Could not read Table IAM Member ("+memberName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	columns, ok := tableIamMemberColumns(member.Columns, held.Columns)
+	if !ok {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+	if columns == nil {
+		state.Columns = types.SetNull(types.StringType)
+	} else {
+		state.Columns, diags = types.SetValueFrom(ctx, types.StringType, columns)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, memberName)...)
+}
+
+// tableIamMemberColumns reconciles the columns a member grants with the
+// columns its role holds the permission on (nil when held table-wide). Other
+// members may have granted further columns, so only the member's own columns
+// are reported; a table-wide grant covers all of them. ok is false when none
+// of the member's columns are held any more.
+func tableIamMemberColumns(want, held []string) (columns []string, ok bool) {
+	if held == nil {
+		return want, true
+	}
+	if want == nil {
+		// The table-wide grant was narrowed out of band, which is drift.
+		return held, true
+	}
+
+	for _, column := range want {
+		if slices.Contains(held, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns, len(columns) > 0
+}
+
+func (r *tableIamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan tableIamMemberModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute forces replacement, so only timeouts can change here.
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes the permission and removes the Terraform state on success.
+func (r *tableIamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state tableIamMemberModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	member, diags := state.member(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.config.SpannerService.RemoveTableIamMember(ctx, state.tableName(), member); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Table IAM Member",
+			"Could not revoke Table IAM Member ("+state.resourceName()+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *tableIamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseTablePermission(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/tableRoles/{role}/permissions/{permission}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table"), importName.Table)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), importName.Role)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission"), importName.Permission)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tableIamMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
@@ -0,0 +1,32 @@
+package spanner
+
+import (
+	"reflect"
+	"testing"
+)
+
+// A member reports only its own columns, so a column another member granted
+// on the same permission is never drift, while losing its own columns is.
+func TestTableIamMemberColumns(t *testing.T) {
+	tests := []struct {
+		name        string
+		want, held  []string
+		wantColumns []string
+		wantOk      bool
+	}{
+		{name: "table-wide member held table-wide", wantOk: true},
+		{name: "column member held table-wide", want: []string{"name"}, wantColumns: []string{"name"}, wantOk: true},
+		{name: "table-wide member narrowed out of band", held: []string{"name"}, wantColumns: []string{"name"}, wantOk: true},
+		{name: "other members' columns ignored", want: []string{"name"}, held: []string{"email", "name"}, wantColumns: []string{"name"}, wantOk: true},
+		{name: "some columns revoked", want: []string{"email", "name"}, held: []string{"name"}, wantColumns: []string{"name"}, wantOk: true},
+		{name: "every column revoked", want: []string{"name"}, held: []string{"email"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, ok := tableIamMemberColumns(tt.want, tt.held)
+			if ok != tt.wantOk || !reflect.DeepEqual(columns, tt.wantColumns) {
+				t.Errorf("tableIamMemberColumns(%v, %v) = %v, %v; want %v, %v", tt.want, tt.held, columns, ok, tt.wantColumns, tt.wantOk)
+			}
+		})
+	}
+}
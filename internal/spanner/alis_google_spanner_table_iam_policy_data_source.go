@@ -0,0 +1,159 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tableIamPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &tableIamPolicyDataSource{}
+)
+
+// NewTableIamPolicyDataSource is a helper function to simplify the provider implementation.
+func NewTableIamPolicyDataSource() datasource.DataSource {
+	return &tableIamPolicyDataSource{}
+}
+
+type tableIamPolicyDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type tableIamPolicyDataSourceModel struct {
+	Project  types.String                  `tfsdk:"project"`
+	Instance types.String                  `tfsdk:"instance"`
+	Database types.String                  `tfsdk:"database"`
+	Table    types.String                  `tfsdk:"table"`
+	Bindings []*tableIamPolicyBindingModel `tfsdk:"bindings"`
+}
+
+// Metadata returns the data source type name.
+func (r *tableIamPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_iam_policy"
+}
+
+// Schema defines the schema for the data source.
+func (r *tableIamPolicyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"table": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The table whose IAM policy is read.",
+			},
+			"bindings": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The role holding the permissions.",
+						},
+						"permissions": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The permissions the role holds on the table.",
+						},
+						"columns": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.SetType{ElemType: types.StringType},
+							MarkdownDescription: "The columns a permission is limited to, keyed by permission. " +
+								"Permissions without a key are held on the whole table; null when none are limited.",
+						},
+					},
+				},
+				MarkdownDescription: "One binding per role holding privileges on the table, sorted by role. " +
+					"System roles are left out.",
+			},
+		},
+		MarkdownDescription: "Reads the privileges every role holds on a table from " +
+			"`INFORMATION_SCHEMA.TABLE_PRIVILEGES` and `INFORMATION_SCHEMA.COLUMN_PRIVILEGES`.",
+	}
+}
+
+// Read data source information.
+func (r *tableIamPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tableIamPolicyDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(r.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(r.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(r.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := names.TableName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Table:    state.Table.ValueString(),
+	}.String()
+
+	// The privilege views return nothing for a missing table, which would read
+	// as an empty policy.
+	if _, err := r.config.SpannerService.GetSpannerTable(ctx, tableName); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table IAM Policy",
+			"Could not read Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	policy, err := r.config.SpannerService.GetTableIamPolicy(ctx, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table IAM Policy",
+			"Could not read IAM policy of Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.Bindings, diags = tableIamPolicyBindingsValue(ctx, policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *tableIamPolicyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
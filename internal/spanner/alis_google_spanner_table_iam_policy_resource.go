@@ -0,0 +1,583 @@
+package spanner
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/services"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &tableIamPolicyResource{}
+	_ resource.ResourceWithConfigure      = &tableIamPolicyResource{}
+	_ resource.ResourceWithImportState    = &tableIamPolicyResource{}
+	_ resource.ResourceWithIdentity       = &tableIamPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &tableIamPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &tableIamPolicyResource{}
+)
+
+// NewTableIamPolicyResource is a helper function to simplify the provider implementation.
+func NewTableIamPolicyResource() resource.Resource {
+	return &tableIamPolicyResource{}
+}
+
+type tableIamPolicyResource struct {
+	config *internal.ProviderConfig
+}
+
+type tableIamPolicyModel struct {
+	Project     types.String                  `tfsdk:"project"`
+	Instance    types.String                  `tfsdk:"instance"`
+	Database    types.String                  `tfsdk:"database"`
+	Table       types.String                  `tfsdk:"table"`
+	Bindings    []*tableIamPolicyBindingModel `tfsdk:"bindings"`
+	Revocations types.List                    `tfsdk:"revocations"`
+	Timeouts    timeouts.Value                `tfsdk:"timeouts"`
+}
+
+// tableIamPolicyBindingModel is one role's privileges within a policy. The
+// data source shares it.
+type tableIamPolicyBindingModel struct {
+	Role        types.String   `tfsdk:"role"`
+	Permissions []types.String `tfsdk:"permissions"`
+	Columns     types.Map      `tfsdk:"columns"`
+}
+
+// tableName returns the fully qualified name of the table, which is the
+// resource identity and import ID.
+func (m tableIamPolicyModel) tableName() string {
+	return names.TableName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Table: m.Table.ValueString()}.String()
+}
+
+// Metadata returns the resource type name.
+func (r *tableIamPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_iam_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *tableIamPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: resourceSchemaVersion,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.\n" +
+					"Changing this forces a new resource.",
+			},
+			"table": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The table whose privileges the policy owns.\n" +
+					"Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bindings": schema.SetNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:            true,
+							Validators:          roleIdValidators("Role"),
+							MarkdownDescription: "The role the permissions are granted to.",
+						},
+						"permissions": schema.SetAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(services.SpannerTablePolicyBindingPermissions...)),
+							},
+							MarkdownDescription: "The permissions granted to the role.\n" +
+								"Valid permissions are: `SELECT`, `INSERT`, `UPDATE`, `DELETE`.",
+						},
+						"columns": schema.MapAttribute{
+							ElementType: types.SetType{ElemType: types.StringType},
+							Optional:    true,
+							Validators:  tableIamBindingColumnsValidators(),
+							MarkdownDescription: "Narrows permissions to some columns of the table, keyed by permission. " +
+								"Each key must also be listed in `permissions`; permissions without a key are granted on the whole table.\n" +
+								"Valid keys are: `SELECT`, `INSERT`, `UPDATE`.",
+						},
+					},
+				},
+				MarkdownDescription: "One binding per role that may hold privileges on the table. " +
+					"Privileges held by any role not listed here are revoked; an empty set revokes every grant on the table.",
+			},
+			"revocations": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				MarkdownDescription: "The REVOKE statements the planned apply runs, including those for roles granted out of band; empty when nothing is revoked.\n" +
+					"The apply fails without running anything if the privileges on the table changed since plan time and the revocations no longer match.",
+			},
+		},
+		MarkdownDescription: "Authoritative for a table. Sets the privileges of every role on the table at once: " +
+			"declared bindings are granted and anything else any role holds on the table is revoked.\n" +
+			"Do not use with `alis_google_spanner_table_iam_binding` on the same table, or the two will fight over the grants.",
+	}
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *tableIamPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}")
+}
+
+// ValidateConfig rejects a role bound twice, and column lists for permissions
+// a binding does not grant.
+func (r *tableIamPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var bindings types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bindings"), &bindings)...)
+	if resp.Diagnostics.HasError() || bindings.IsNull() || bindings.IsUnknown() {
+		return
+	}
+
+	var models []*tableIamPolicyBindingModel
+	resp.Diagnostics.Append(bindings.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles := map[string]bool{}
+	for _, binding := range models {
+		if binding.Role.IsUnknown() {
+			continue
+		}
+		role := binding.Role.ValueString()
+		if roles[role] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bindings"),
+				"Duplicate Role",
+				"Role "+role+" is bound more than once. Merge its permissions into one binding.",
+			)
+		}
+		roles[role] = true
+
+		if binding.Columns.IsNull() || binding.Columns.IsUnknown() {
+			continue
+		}
+		granted := map[string]bool{}
+		for _, permission := range binding.Permissions {
+			granted[permission.ValueString()] = true
+		}
+		for permission := range binding.Columns.Elements() {
+			if !granted[permission] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("bindings"),
+					"Ungranted Column Permission",
+					"Columns are listed for "+permission+" on role "+role+", which is not in its permissions.",
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan applies the provider's defaults, then previews the REVOKE
+// statements of the apply as revocations, and warns about them, so a grant
+// made out of band is never dropped unseen.
+func (r *tableIamPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Table Privileges Will Be Revoked",
+			"Destroying alis_google_spanner_table_iam_policy revokes every privilege any role holds on the table.",
+		)
+		return
+	}
+
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unknown := func() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revocations"), types.ListUnknown(types.StringType))...)
+	}
+
+	var bindings types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("bindings"), &bindings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Without a configured provider (e.g. during validate) there is nothing
+	// to diff against yet.
+	if r.config == nil || bindings.IsUnknown() {
+		unknown()
+		return
+	}
+
+	var plan tableIamPolicyModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Project.IsUnknown() || plan.Instance.IsUnknown() || plan.Database.IsUnknown() || plan.Table.IsUnknown() {
+		unknown()
+		return
+	}
+
+	policy, known, diags := tableIamPolicyFromModel(ctx, plan.Bindings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !known {
+		unknown()
+		return
+	}
+
+	tableName := plan.tableName()
+	change, err := r.config.SpannerService.PlanTableIamPolicy(ctx, tableName, policy)
+	if err != nil {
+		// The database may be created in the same apply.
+		if status.Code(err) == codes.NotFound {
+			unknown()
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Planning Table IAM Policy",
+			"Could not diff the IAM policy of Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	revocations, diags := types.ListValueFrom(ctx, types.StringType, tablePolicyRevocations(change))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revocations"), revocations)...)
+
+	if len(change.Revocations) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Table Privileges Will Be Revoked",
+			"Applying the IAM policy of Table ("+tableName+") runs:\n  "+strings.Join(change.Revocations, "\n  "),
+		)
+	}
+}
+
+// Create sets the policy of the table.
+func (r *tableIamPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan tableIamPolicyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, createTimeout)
+	defer cancel()
+
+	r.setPolicy(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.tableName())...)
+}
+
+// Read resource information.
+func (r *tableIamPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state tableIamPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := state.tableName()
+
+	// A dropped table took its grants with it.
+	if _, err := r.config.SpannerService.GetSpannerTable(ctx, tableName); err != nil {
+		if status.Code(err) == codes.NotFound {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Table IAM Policy",
+			"Could not read Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	policy, err := r.config.SpannerService.GetTableIamPolicy(ctx, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table IAM Policy",
+			"Could not read IAM policy of Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	// Map response body to state
+	state.Bindings, diags = tableIamPolicyBindingsValue(ctx, policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Revocations = types.ListValueMust(types.StringType, nil)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, tableName)...)
+}
+
+func (r *tableIamPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan tableIamPolicyModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	r.setPolicy(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete revokes every privilege on the table and removes the Terraform
+// state on success.
+func (r *tableIamPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state tableIamPolicyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tableName := state.tableName()
+
+	// Nothing to revoke on a table that is already gone.
+	if _, err := r.config.SpannerService.GetSpannerTable(ctx, tableName); status.Code(err) == codes.NotFound {
+		return
+	}
+
+	if _, err := r.config.SpannerService.SetTableIamPolicy(ctx, tableName, &services.TablePolicy{}, nil); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Table IAM Policy",
+			"Could not revoke the privileges on Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+}
+
+func (r *tableIamPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseTable(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), importName.Project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance"), importName.Instance)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), importName.Database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("table"), importName.Table)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tableIamPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.config = config
+}
+
+// setPolicy applies model.Bindings as the policy of the table and records the
+// statements it revoked as model.Revocations. Known planned revocations must
+// still be the revocations, or nothing runs.
+func (r *tableIamPolicyResource) setPolicy(ctx context.Context, model *tableIamPolicyModel, diagnostics *diag.Diagnostics) {
+	tableName := model.tableName()
+
+	policy, _, diags := tableIamPolicyFromModel(ctx, model.Bindings)
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	var planned []string
+	if !model.Revocations.IsUnknown() && !model.Revocations.IsNull() {
+		planned = []string{}
+		diagnostics.Append(model.Revocations.ElementsAs(ctx, &planned, false)...)
+		if diagnostics.HasError() {
+			return
+		}
+	}
+
+	change, err := r.config.SpannerService.SetTableIamPolicy(ctx, tableName, policy, planned)
+	if err != nil {
+		diagnostics.AddError(
+			"Error Setting Table IAM Policy",
+			"Could not set the IAM policy of Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	model.Revocations, diags = types.ListValueFrom(ctx, types.StringType, tablePolicyRevocations(change))
+	diagnostics.Append(diags...)
+}
+
+// tablePolicyRevocations returns the revocations of change, never nil, so
+// revoking nothing is an empty list rather than a null one.
+func tablePolicyRevocations(change *services.TablePolicyChange) []string {
+	if change.Revocations == nil {
+		return []string{}
+	}
+
+	return change.Revocations
+}
+
+// tableIamPolicyFromModel converts bindings to a policy. known is false when
+// any value in them is not yet known, as during a plan.
+func tableIamPolicyFromModel(ctx context.Context, bindings []*tableIamPolicyBindingModel) (policy *services.TablePolicy, known bool, diags diag.Diagnostics) {
+	policy = &services.TablePolicy{
+		Bindings: make([]*services.TablePolicyBinding, 0, len(bindings)),
+	}
+	known = true
+	for _, model := range bindings {
+		if model.Role.IsUnknown() || model.Columns.IsUnknown() {
+			known = false
+			continue
+		}
+
+		binding := &services.TablePolicyBinding{
+			Role: model.Role.ValueString(),
+		}
+		for _, permission := range model.Permissions {
+			if permission.IsUnknown() {
+				known = false
+				continue
+			}
+			binding.Permissions = append(binding.Permissions, tableIamPermission(permission.ValueString()))
+		}
+
+		columns, columnDiags := tableIamBindingColumns(ctx, model.Columns)
+		diags.Append(columnDiags...)
+		binding.Columns = columns
+
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+
+	return policy, known, diags
+}
+
+// tableIamPolicyBindingsValue renders the bindings of policy as the bindings
+// attribute.
+func tableIamPolicyBindingsValue(ctx context.Context, policy *services.TablePolicy) ([]*tableIamPolicyBindingModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	bindings := make([]*tableIamPolicyBindingModel, 0, len(policy.Bindings))
+	for _, binding := range policy.Bindings {
+		permissions := make([]types.String, 0, len(binding.Permissions))
+		for _, permission := range binding.Permissions {
+			permissions = append(permissions, types.StringValue(permission.String()))
+		}
+
+		columns, columnDiags := tableIamBindingColumnsValue(ctx, binding)
+		diags.Append(columnDiags...)
+
+		bindings = append(bindings, &tableIamPolicyBindingModel{
+			Role:        types.StringValue(binding.Role),
+			Permissions: permissions,
+			Columns:     columns,
+		})
+	}
+
+	return bindings, diags
+}
+
+// tableIamPermission maps a permission name to its
+// TablePolicyBindingPermission, UNSPECIFIED if it is not one.
+func tableIamPermission(name string) services.TablePolicyBindingPermission {
+	for _, permission := range services.TablePolicyBindingPermissions {
+		if permission.String() == name {
+			return permission
+		}
+	}
+
+	return services.TablePolicyBindingPermission_UNSPECIFIED
+}
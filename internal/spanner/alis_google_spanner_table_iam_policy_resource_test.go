@@ -0,0 +1,72 @@
+package spanner
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// A policy read from Spanner converts back to the same service policy, so a
+// refresh with no drift plans no revocations.
+func TestTableIamPolicyBindings_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	policy := &services.TablePolicy{
+		Bindings: []*services.TablePolicyBinding{
+			{
+				Role:        "analyst",
+				Permissions: []services.TablePolicyBindingPermission{services.TablePolicyBindingPermission_SELECT},
+				Columns: map[services.TablePolicyBindingPermission][]string{
+					services.TablePolicyBindingPermission_SELECT: {"name"},
+				},
+			},
+			{
+				Role: "writer",
+				Permissions: []services.TablePolicyBindingPermission{
+					services.TablePolicyBindingPermission_INSERT,
+					services.TablePolicyBindingPermission_DELETE,
+				},
+			},
+		},
+	}
+
+	bindings, diags := tableIamPolicyBindingsValue(ctx, policy)
+	if diags.HasError() {
+		t.Fatalf("tableIamPolicyBindingsValue: %v", diags)
+	}
+	if !bindings[1].Columns.IsNull() {
+		t.Errorf("columns of a table-wide binding = %v, want null", bindings[1].Columns)
+	}
+
+	got, known, diags := tableIamPolicyFromModel(ctx, bindings)
+	if diags.HasError() {
+		t.Fatalf("tableIamPolicyFromModel: %v", diags)
+	}
+	if !known {
+		t.Fatal("tableIamPolicyFromModel reported unknown values in a fully known policy")
+	}
+	if !reflect.DeepEqual(got, policy) {
+		t.Errorf("tableIamPolicyFromModel = %v, want %v", got, policy)
+	}
+}
+
+// A role that is only known after apply leaves the policy incomplete, so the
+// plan cannot list revocations yet.
+func TestTableIamPolicyFromModel_Unknown(t *testing.T) {
+	_, known, diags := tableIamPolicyFromModel(context.Background(), []*tableIamPolicyBindingModel{
+		{
+			Role:        types.StringUnknown(),
+			Permissions: []types.String{types.StringValue("SELECT")},
+			Columns:     types.MapNull(types.SetType{ElemType: types.StringType}),
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("tableIamPolicyFromModel: %v", diags)
+	}
+	if known {
+		t.Error("tableIamPolicyFromModel reported an unknown role as known")
+	}
+}
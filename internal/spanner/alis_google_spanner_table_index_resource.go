@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -33,6 +32,8 @@ var (
 	_ resource.Resource                = &spannerTableIndexResource{}
 	_ resource.ResourceWithConfigure   = &spannerTableIndexResource{}
 	_ resource.ResourceWithImportState = &spannerTableIndexResource{}
+	_ resource.ResourceWithIdentity    = &spannerTableIndexResource{}
+	_ resource.ResourceWithModifyPlan  = &spannerTableIndexResource{}
 )
 
 // NewSpannerTableIndexResource is a helper function to simplify the provider implementation.
@@ -55,6 +56,18 @@ type spannerTableIndexModel struct {
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// resourceName returns the fully qualified name of the index, which is
+// the resource identity and import ID.
+func (m spannerTableIndexModel) resourceName() string {
+	return names.IndexName{
+		Project:  m.Project.ValueString(),
+		Instance: m.Instance.ValueString(),
+		Database: m.Database.ValueString(),
+		Table:    m.Table.ValueString(),
+		Index:    m.Name.ValueString(),
+	}.String()
+}
+
 type spannerTableIndexColumn struct {
 	Name  types.String `tfsdk:"name"`
 	Order types.String `tfsdk:"order"`
@@ -101,6 +114,46 @@ func resolveUnknownIndexInherited(ctx context.Context, plan spannerTableIndexMod
 	return plan, diags
 }
 
+// indexFromModel builds the service index from a planned model. unique and
+// columns.order are Computed: a value still unknown at apply time has no
+// prior state to inherit and must read as unset (ASC, non-unique), not as
+// an explicit choice.
+func indexFromModel(ctx context.Context, plan spannerTableIndexModel) (*services.SpannerTableIndex, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	index := &services.SpannerTableIndex{
+		Name:    plan.Name.ValueString(),
+		Columns: []*services.SpannerTableIndexColumn{},
+		Unique:  nil,
+	}
+
+	columns := make([]spannerTableIndexColumn, 0, len(plan.Columns.Elements()))
+	diags.Append(plan.Columns.ElementsAs(ctx, &columns, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, column := range columns {
+		order := services.SpannerTableIndexColumnOrder_ASC
+		switch column.Order.ValueString() {
+		case "asc":
+			order = services.SpannerTableIndexColumnOrder_ASC
+		case "desc":
+			order = services.SpannerTableIndexColumnOrder_DESC
+		}
+		index.Columns = append(index.Columns, &services.SpannerTableIndexColumn{
+			Name:  column.Name.ValueString(),
+			Order: order,
+		})
+	}
+
+	if !plan.Unique.IsNull() && !plan.Unique.IsUnknown() {
+		index.Unique = wrapperspb.Bool(plan.Unique.ValueBool())
+	}
+
+	return index, diags
+}
+
 // Metadata returns the resource type name.
 func (r *spannerTableIndexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_index"
@@ -133,25 +186,22 @@ func (r *spannerTableIndexResource) Schema(ctx context.Context, _ resource.Schem
 				},
 			},
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID in which the table belongs.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID in which the table belongs.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the Spanner instance.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the Spanner instance.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the parent database.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the parent database.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"table": schema.StringAttribute{
 				Required: true,
@@ -185,15 +235,12 @@ func (r *spannerTableIndexResource) Schema(ctx context.Context, _ resource.Schem
 									utils.Pattern(utils.SpannerPostgresSqlColumnIdRegex),
 								}, "Name must be a valid Spanner Column ID, See https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#naming_conventions"),
 							},
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
 						},
 						"order": schema.StringAttribute{
 							Optional: true,
 							// Computed with UseStateForUnknown: state always holds the
 							// hydrated order ("asc" when omitted), so unset config must
-							// inherit it instead of reading as a change that recreates
+							// inherit it instead of reading as a change that rebuilds
 							// the index.
 							Computed: true,
 							PlanModifiers: []planmodifier.String{
@@ -210,24 +257,21 @@ func (r *spannerTableIndexResource) Schema(ctx context.Context, _ resource.Schem
 				},
 				MarkdownDescription: "The columns that make up the index.\n" +
 					"The order of the columns is significant.\n" +
-					"**Changing any column will destroy and recreate the index**: Spanner indexes cannot be altered in place.",
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
+					"**Changing any column rebuilds the index**: Spanner indexes cannot be altered in place, so the index is dropped " +
+					"and recreated under the same name in a single schema update.",
 			},
 			"unique": schema.BoolAttribute{
 				Optional: true,
-				// Computed with UseStateForUnknown, which must run before
-				// RequiresReplace: state always holds the hydrated value
-				// (false when omitted), so unset config inherits it and the
-				// replace check compares real values, never unknown.
+				// Computed with UseStateForUnknown: state always holds the
+				// hydrated value (false when omitted), so unset config
+				// inherits it and Update compares real values, never unknown.
 				Computed: true,
 				MarkdownDescription: "Indicates if the index is unique.\n" +
 					"When omitted, the index's current uniqueness in the database is kept.\n" +
-					"**Changing this value explicitly will destroy and recreate the index**: Spanner indexes cannot be altered in place.",
+					"**Changing this value explicitly rebuilds the index**: Spanner indexes cannot be altered in place, so the index is " +
+					"dropped and recreated under the same name in a single schema update.",
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.UseStateForUnknown(),
-					boolplanmodifier.RequiresReplace(),
 				},
 			},
 		},
@@ -236,6 +280,17 @@ func (r *spannerTableIndexResource) Schema(ctx context.Context, _ resource.Schem
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *spannerTableIndexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *spannerTableIndexResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}/indexes/{index}")
+}
+
 // Create a new resource.
 func (r *spannerTableIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -254,13 +309,6 @@ func (r *spannerTableIndexResource) Create(ctx context.Context, req resource.Cre
 	ctx, cancel := withTimeout(ctx, createTimeout)
 	defer cancel()
 
-	// Generate index from plan
-	index := &services.SpannerTableIndex{
-		Name:    plan.Name.ValueString(),
-		Columns: []*services.SpannerTableIndexColumn{},
-		Unique:  nil,
-	}
-
 	// Get project and instance name
 	project := plan.Project.ValueString()
 	instanceName := plan.Instance.ValueString()
@@ -268,33 +316,13 @@ func (r *spannerTableIndexResource) Create(ctx context.Context, req resource.Cre
 	tableId := plan.Table.ValueString()
 	indexName := plan.Name.ValueString()
 
-	columns := make([]spannerTableIndexColumn, 0, len(plan.Columns.Elements()))
-	d := plan.Columns.ElementsAs(ctx, &columns, false)
+	// Generate index from plan
+	index, d := indexFromModel(ctx, plan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	for _, column := range columns {
-		order := services.SpannerTableIndexColumnOrder_ASC
-		switch column.Order.ValueString() {
-		case "asc":
-			order = services.SpannerTableIndexColumnOrder_ASC
-		case "desc":
-			order = services.SpannerTableIndexColumnOrder_DESC
-		}
-		index.Columns = append(index.Columns, &services.SpannerTableIndexColumn{
-			Name:  column.Name.ValueString(),
-			Order: order,
-		})
-	}
-
-	// unique is Computed: at create time an omitted value is still unknown
-	// (no prior state to inherit), which must read as unset, not false.
-	if !plan.Unique.IsNull() && !plan.Unique.IsUnknown() {
-		index.Unique = wrapperspb.Bool(plan.Unique.ValueBool())
-	}
-
 	tableName := names.TableName{Project: project, Instance: instanceName, Database: databaseId, Table: tableId}.String()
 
 	// Create index
@@ -318,6 +346,7 @@ func (r *spannerTableIndexResource) Create(ctx context.Context, req resource.Cre
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -394,29 +423,66 @@ func (r *spannerTableIndexResource) Read(ctx context.Context, req resource.ReadR
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-// Update exists to satisfy the resource interface but is effectively
-// unreachable: a secondary index cannot be altered in place, so every
-// attribute carries a RequiresReplace plan modifier and any change plans as
-// a destroy-and-recreate instead of an update.
+// Update rebuilds the index when its columns or uniqueness change. Spanner
+// cannot alter an index in place, so the drop and create are submitted
+// together in one DDL batch rather than as a Terraform replace, which would
+// run them as two separate schema changes with no index in between.
 func (r *spannerTableIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan spannerTableIndexModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+	// Retrieve values from plan and state
+	var plan, state spannerTableIndexModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Get project and instance name
+	project := plan.Project.ValueString()
+	instanceName := plan.Instance.ValueString()
+	databaseId := plan.Database.ValueString()
+	tableId := plan.Table.ValueString()
 	indexName := plan.Name.ValueString()
 
+	// Only the timeouts block changed; nothing to send to Spanner.
+	if plan.Columns.Equal(state.Columns) && plan.Unique.Equal(state.Unique) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := withTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Generate index from plan
+	index, d := indexFromModel(ctx, plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := names.TableName{Project: project, Instance: instanceName, Database: databaseId, Table: tableId}.String()
+
+	// Rebuild index
+	_, err := r.config.SpannerService.RecreateSpannerTableIndex(ctx, tableName, index)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Index",
+			"Could not update Index ("+indexName+") on Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
 	// Map response body to schema and populate Computed attribute values
-	plan.Name = types.StringValue(indexName)
 	plan, rd := resolveUnknownIndexInherited(ctx, plan)
 	resp.Diagnostics.Append(rd...)
 	if resp.Diagnostics.HasError() {
@@ -470,20 +536,25 @@ func (r *spannerTableIndexResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *spannerTableIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importName, err := names.ParseIndex(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseIndex(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/indexes/{index}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}/indexes/{index}: "+err.Error(),
 		)
 		return
 	}
 
-	if !utils.Pattern(utils.SpannerGoogleSqlTableIndexNameRegex).MatchString(req.ID) &&
-		!utils.Pattern(utils.SpannerPostgresSqlTableIndexNameRegex).MatchString(req.ID) {
+	if !utils.Pattern(utils.SpannerGoogleSqlTableIndexNameRegex).MatchString(id) &&
+		!utils.Pattern(utils.SpannerPostgresSqlTableIndexNameRegex).MatchString(id) {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") contains an invalid project, instance, database, table or index ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}/indexes/{index}.",
+			"Import ID ("+id+") contains an invalid project, instance, database, table or index ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}/indexes/{index}.",
 		)
 		return
 	}
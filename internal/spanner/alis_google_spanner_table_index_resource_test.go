@@ -9,15 +9,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-// Spanner secondary indexes cannot be altered in place: any change to the
-// index definition requires dropping and recreating the index. Every
-// attribute of the index resource must therefore force a replace; without
-// this, a changed attribute would plan as an in-place update that never
-// reaches Spanner.
-func TestSpannerTableIndexSchema_AllAttributesRequireReplace(t *testing.T) {
+// Spanner secondary indexes cannot be altered in place. The identity of the
+// index (its name and location) still forces a replace, but its definition —
+// columns and uniqueness — is rebuilt by Update in a single DDL batch: a
+// RequiresReplace there would split the drop and the create into two schema
+// changes and leave queries running without the index in between.
+//
+// project, instance and database default to the provider's, so their
+// replace is decided in ModifyPlan (applyProviderDefaults) once the default
+// is known; an attribute-level RequiresReplace would fire on the unknown the
+// framework plans for them first.
+func TestSpannerTableIndexSchema_ReplaceOnlyOnIdentity(t *testing.T) {
 	resp := &resource.SchemaResponse{}
 	(&spannerTableIndexResource{}).Schema(context.Background(), resource.SchemaRequest{}, resp)
 
+	rebuiltInPlace := map[string]bool{"columns": true, "unique": true}
+	replacedByModifyPlan := map[string]bool{"project": true, "instance": true, "database": true}
+	if _, ok := any(&spannerTableIndexResource{}).(resource.ResourceWithModifyPlan); !ok {
+		t.Fatal("spannerTableIndexResource must implement ModifyPlan to replace on project, instance and database")
+	}
+
 	for name, attr := range resp.Schema.Attributes {
 		found := false
 		// Every RequiresReplace modifier, regardless of attribute type,
@@ -28,8 +39,14 @@ func TestSpannerTableIndexSchema_AllAttributesRequireReplace(t *testing.T) {
 				found = true
 			}
 		}
-		if !found {
-			t.Errorf("attribute %q has no RequiresReplace plan modifier; index changes must recreate the index", name)
+		switch {
+		case replacedByModifyPlan[name] && found:
+			t.Errorf("attribute %q has a RequiresReplace plan modifier; ModifyPlan decides its replace", name)
+		case replacedByModifyPlan[name]:
+		case rebuiltInPlace[name] && found:
+			t.Errorf("attribute %q has a RequiresReplace plan modifier; definition changes must be rebuilt by Update", name)
+		case !rebuiltInPlace[name] && !found:
+			t.Errorf("attribute %q has no RequiresReplace plan modifier; identity changes must recreate the index", name)
 		}
 	}
 }
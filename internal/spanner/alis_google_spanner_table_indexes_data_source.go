@@ -0,0 +1,193 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tableIndexesDataSource{}
+	_ datasource.DataSourceWithConfigure = &tableIndexesDataSource{}
+)
+
+// NewTableIndexesDataSource is a helper function to simplify the provider implementation.
+func NewTableIndexesDataSource() datasource.DataSource {
+	return &tableIndexesDataSource{}
+}
+
+type tableIndexesDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type tableIndexesDataSourceModel struct {
+	Project  types.String             `tfsdk:"project"`
+	Instance types.String             `tfsdk:"instance"`
+	Database types.String             `tfsdk:"database"`
+	Table    types.String             `tfsdk:"table"`
+	Indexes  []*tableIndexesDataIndex `tfsdk:"indexes"`
+}
+
+type tableIndexesDataIndex struct {
+	Name    types.String              `tfsdk:"name"`
+	Columns []*tableIndexesDataColumn `tfsdk:"columns"`
+	Unique  types.Bool                `tfsdk:"unique"`
+	Storing []types.String            `tfsdk:"storing"`
+}
+
+type tableIndexesDataColumn struct {
+	Name  types.String `tfsdk:"name"`
+	Order types.String `tfsdk:"order"`
+}
+
+// Metadata returns the data source type name.
+func (d *tableIndexesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_indexes"
+}
+
+// Schema defines the schema for the data source.
+func (d *tableIndexesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID that contains the table.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"table": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The table whose indexes are listed.",
+			},
+			"indexes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the index.",
+						},
+						"columns": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the column.",
+									},
+									"order": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The sort order of the column in the index, `asc` or `desc`.",
+									},
+								},
+							},
+							MarkdownDescription: "The key columns of the index, in key order.",
+						},
+						"unique": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Indicates if the index is unique.",
+						},
+						"storing": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The non-key columns the index stores, in name order.",
+						},
+					},
+				},
+				MarkdownDescription: "The secondary indexes of the table, in name order, including those Spanner manages to back foreign keys. The primary key is not included.",
+			},
+		},
+		MarkdownDescription: "Lists the secondary indexes of a Spanner table, whether or not this configuration manages them.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tableIndexesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tableIndexesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := names.TableName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+		Table:    state.Table.ValueString(),
+	}.String()
+
+	// An unknown table would read as one without indexes.
+	if _, err := d.config.SpannerService.GetSpannerTable(ctx, tableName); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table Indexes",
+			"Could not read Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	indexes, err := d.config.SpannerService.ListSpannerTableIndices(ctx, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Table Indexes",
+			"Could not list indexes for Table ("+tableName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.Indexes = make([]*tableIndexesDataIndex, 0, len(indexes))
+	for _, index := range indexes {
+		model := &tableIndexesDataIndex{
+			Name:    types.StringValue(index.Name),
+			Columns: make([]*tableIndexesDataColumn, 0, len(index.Columns)),
+			Unique:  types.BoolValue(index.Unique.GetValue()),
+			Storing: stringValues(index.Storing),
+		}
+		for _, column := range index.Columns {
+			model.Columns = append(model.Columns, &tableIndexesDataColumn{
+				Name:  types.StringValue(column.Name),
+				Order: types.StringValue(column.Order.String()),
+			})
+		}
+		state.Indexes = append(state.Indexes, model)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tableIndexesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
@@ -2,6 +2,7 @@ package spanner
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"terraform-provider-alis/internal"
@@ -11,6 +12,7 @@ import (
 	"terraform-provider-alis/internal/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -34,6 +36,8 @@ var (
 	_ resource.Resource                = &spannerTableResource{}
 	_ resource.ResourceWithConfigure   = &spannerTableResource{}
 	_ resource.ResourceWithImportState = &spannerTableResource{}
+	_ resource.ResourceWithIdentity    = &spannerTableResource{}
+	_ resource.ResourceWithModifyPlan  = &spannerTableResource{}
 )
 
 // NewSpannerTableResource is a helper function to simplify the provider implementation.
@@ -61,6 +65,12 @@ type spannerTableModel struct {
 	Timeouts       timeouts.Value          `tfsdk:"timeouts"`
 }
 
+// resourceName returns the fully qualified name of the table, which is
+// the resource identity and import ID.
+func (m spannerTableModel) resourceName() string {
+	return names.TableName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Table: m.Name.ValueString()}.String()
+}
+
 type spannerTableSchema struct {
 	Columns types.List `tfsdk:"columns"`
 }
@@ -81,6 +91,8 @@ type spannerTableColumn struct {
 	Required       types.Bool   `tfsdk:"required"`
 	DefaultValue   types.String `tfsdk:"default_value"`
 	ProtoPackage   types.String `tfsdk:"proto_package"`
+	KeyOrder       types.String `tfsdk:"key_order"`
+	KeyPosition    types.Int64  `tfsdk:"key_position"`
 }
 
 // attrTypes returns the attribute types of the column object, used to build
@@ -98,6 +110,8 @@ func (o spannerTableColumn) attrTypes() map[string]attr.Type {
 		"required":         types.BoolType,
 		"default_value":    types.StringType,
 		"proto_package":    types.StringType,
+		"key_order":        types.StringType,
+		"key_position":     types.Int64Type,
 	}
 }
 
@@ -138,25 +152,22 @@ func (r *spannerTableResource) Schema(ctx context.Context, _ resource.SchemaRequ
 				},
 			},
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID in which the table belongs.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID in which the table belongs.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the Spanner instance.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the Spanner instance.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the parent database.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the parent database.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"schema": schema.SingleNestedAttribute{
 				Required: true,
@@ -262,6 +273,27 @@ func (r *spannerTableResource) Schema(ctx context.Context, _ resource.SchemaRequ
 										"This field is only required for columns of type `PROTO`\n" +
 										"Example: \"com.example.Message\", where `com.example` is the package name and `Message` is the message name.",
 								},
+								"key_order": schema.StringAttribute{
+									Optional: true,
+									MarkdownDescription: "The sort order of the column within the primary key. Possible values are `asc` and `desc`; " +
+										"when omitted the column is ascending.\n" +
+										"This is only applicable to columns where `is_primary_key` is true.\n" +
+										"**Changing this value will cause a table replace**.",
+									Validators: []validator.String{
+										stringvalidator.OneOf(tableschema.SpannerTableIndexColumnOrders...),
+									},
+								},
+								"key_position": schema.Int64Attribute{
+									Optional: true,
+									MarkdownDescription: "The 1-based position of the column within the primary key, for a key order that differs from the column order. " +
+										"At most the number of primary key columns.\n" +
+										"Primary key columns without a position fill the remaining positions in declaration order.\n" +
+										"This is only applicable to columns where `is_primary_key` is true.\n" +
+										"**Changing the resulting key order will cause a table replace**.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
 							},
 						},
 						MarkdownDescription: "The columns of the table.",
@@ -321,6 +353,17 @@ func (r *spannerTableResource) Schema(ctx context.Context, _ resource.SchemaRequ
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *spannerTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *spannerTableResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}")
+}
+
 // Create a new resource.
 func (r *spannerTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -398,6 +441,7 @@ func (r *spannerTableResource) Create(ctx context.Context, req resource.CreateRe
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -455,6 +499,7 @@ func (r *spannerTableResource) Read(ctx context.Context, req resource.ReadReques
 					return
 				}
 				tableschema.PreserveUnsetBooleans(priorColumns, table.Schema.Columns)
+				tableschema.PreserveUnsetKeyOrdering(priorColumns, table.Schema.Columns)
 			}
 
 			generatedList, d := tableColumnsToModel(ctx, table.Schema.Columns)
@@ -476,6 +521,7 @@ func (r *spannerTableResource) Read(ctx context.Context, req resource.ReadReques
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -611,20 +657,25 @@ func (r *spannerTableResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *spannerTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importName, err := names.ParseTable(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseTable(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}: "+err.Error(),
 		)
 		return
 	}
 
-	if !utils.Pattern(utils.SpannerGoogleSqlTableNameRegex).MatchString(req.ID) &&
-		!utils.Pattern(utils.SpannerPostgresSqlTableNameRegex).MatchString(req.ID) {
+	if !utils.Pattern(utils.SpannerGoogleSqlTableNameRegex).MatchString(id) &&
+		!utils.Pattern(utils.SpannerPostgresSqlTableNameRegex).MatchString(id) {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") contains an invalid project, instance, database or table ID. See https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#naming_conventions for table naming conventions.",
+			"Import ID ("+id+") contains an invalid project, instance, database or table ID. See https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#naming_conventions for table naming conventions.",
 		)
 		return
 	}
@@ -696,7 +747,43 @@ func (r *spannerTableResource) ValidateConfig(
 		return
 	}
 
+	// Positions left unset fill the gaps in the set ones, so distinct set
+	// positions no greater than the key size resolve to exactly 1..N. One
+	// beyond it would be created as given and read back at its real,
+	// smaller position, a diff no apply converges.
+	keySize, keySizeKnown := int64(0), true
+	for _, column := range columns {
+		if column.IsPrimaryKey.IsUnknown() {
+			keySizeKnown = false
+		}
+		if column.IsPrimaryKey.ValueBool() {
+			keySize++
+		}
+	}
+
+	keyPositions := make(map[int64]string)
 	for i, column := range columns {
+		if column.IsPrimaryKey.ValueBool() && !column.KeyPosition.IsNull() && !column.KeyPosition.IsUnknown() {
+			if keySizeKnown && column.KeyPosition.ValueInt64() > keySize {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("schema").AtName("columns").AtListIndex(i).AtName("key_position"),
+					"Key Position Out of Range",
+					fmt.Sprintf("Column %q has key_position %d, but the primary key has %d columns. "+
+						"Each key_position must be between 1 and the number of primary key columns.",
+						column.Name.ValueString(), column.KeyPosition.ValueInt64(), keySize),
+				)
+			}
+			if other, ok := keyPositions[column.KeyPosition.ValueInt64()]; ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("schema").AtName("columns").AtListIndex(i).AtName("key_position"),
+					"Duplicate Key Position",
+					fmt.Sprintf("Column %q has the same key_position as column %q. "+
+						"Each primary key column must have a distinct position.", column.Name.ValueString(), other),
+				)
+			}
+			keyPositions[column.KeyPosition.ValueInt64()] = column.Name.ValueString()
+		}
+
 		// If column type is PROTO, check if proto_package is provided. The
 		// proto bundle itself must already exist in the database; the provider
 		// does not manage bundles.
@@ -722,6 +809,26 @@ func (r *spannerTableResource) ValidateConfig(
 				)
 			}
 		}
+
+		// key_order and key_position only shape the PRIMARY KEY clause
+		if !column.IsPrimaryKey.ValueBool() {
+			for _, attribute := range []struct {
+				name  string
+				isSet bool
+			}{
+				{name: "key_order", isSet: !column.KeyOrder.IsNull()},
+				{name: "key_position", isSet: !column.KeyPosition.IsNull()},
+			} {
+				if attribute.isSet {
+					resp.Diagnostics.AddAttributeWarning(
+						path.Root("schema").AtName("columns").AtListIndex(i).AtName(attribute.name),
+						"Ignored Column Configuration",
+						"Expected "+attribute.name+" to be configured only on primary key columns. "+
+							"The value has no effect on this column.",
+					)
+				}
+			}
+		}
 	}
 }
 
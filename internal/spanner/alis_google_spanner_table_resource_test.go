@@ -0,0 +1,93 @@
+package spanner
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keyColumn is a primary-key column at position, or at no set position when
+// position is zero.
+func keyColumn(name string, position int64) spannerTableColumn {
+	column := minimalColumnModel()
+	column.Name = types.StringValue(name)
+	column.IsPrimaryKey = types.BoolValue(true)
+	if position != 0 {
+		column.KeyPosition = types.Int64Value(position)
+	}
+	return column
+}
+
+func TestSpannerTableValidateConfig_KeyPositions(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []spannerTableColumn
+		// wantError is a substring of the expected error summary; empty
+		// means no error.
+		wantError string
+	}{
+		{
+			name:    "positions fill around a pinned column",
+			columns: []spannerTableColumn{keyColumn("a", 0), keyColumn("b", 1), minimalColumnModel()},
+		},
+		{
+			name:    "every position set",
+			columns: []spannerTableColumn{keyColumn("a", 2), keyColumn("b", 1)},
+		},
+		{
+			name:      "duplicate position",
+			columns:   []spannerTableColumn{keyColumn("a", 1), keyColumn("b", 1)},
+			wantError: "Duplicate Key Position",
+		},
+		{
+			// Created at 5, read back at 2: a diff no apply converges.
+			name:      "position beyond the key",
+			columns:   []spannerTableColumn{keyColumn("a", 0), keyColumn("b", 5), minimalColumnModel()},
+			wantError: "Key Position Out of Range",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &spannerTableResource{}
+			s := resourceSchema(t, r)
+
+			schemaType, diags := s.TypeAtPath(ctx, path.Root("schema"))
+			if diags.HasError() {
+				t.Fatalf("schema type: %v", diags)
+			}
+			tableSchema, diags := types.ObjectValue(
+				schemaType.(types.ObjectType).AttrTypes,
+				map[string]attr.Value{"columns": columnList(t, tt.columns)},
+			)
+			if diags.HasError() {
+				t.Fatalf("schema value: %v", diags)
+			}
+
+			config := objectOf(t, s, map[string]attr.Value{
+				"name":   types.StringValue("albums"),
+				"schema": tableSchema,
+			})
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: config}}, resp)
+
+			var summaries []string
+			for _, d := range resp.Diagnostics.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			got := strings.Join(summaries, "; ")
+			if tt.wantError == "" && got != "" {
+				t.Errorf("ValidateConfig() errors = %s, want none", got)
+			}
+			if tt.wantError != "" && !strings.Contains(got, tt.wantError) {
+				t.Errorf("ValidateConfig() errors = %q, want %q", got, tt.wantError)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ var (
 	_ resource.Resource                = &spannerTableTtlPolicyResource{}
 	_ resource.ResourceWithConfigure   = &spannerTableTtlPolicyResource{}
 	_ resource.ResourceWithImportState = &spannerTableTtlPolicyResource{}
+	_ resource.ResourceWithIdentity    = &spannerTableTtlPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &spannerTableTtlPolicyResource{}
 )
 
 // NewTableTtlPolicyResource is a helper function to simplify the provider implementation.
@@ -50,6 +52,12 @@ type spannerTableTtlModel struct {
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
+// resourceName returns the fully qualified name of the table the policy belongs to; a table has at most one, which is
+// the resource identity and import ID.
+func (m spannerTableTtlModel) resourceName() string {
+	return names.TableName{Project: m.Project.ValueString(), Instance: m.Instance.ValueString(), Database: m.Database.ValueString(), Table: m.Table.ValueString()}.String()
+}
+
 // Metadata returns the resource type name.
 func (r *spannerTableTtlPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_google_spanner_table_ttl_policy"
@@ -68,25 +76,22 @@ func (r *spannerTableTtlPolicyResource) Schema(ctx context.Context, _ resource.S
 		},
 		Attributes: map[string]schema.Attribute{
 			"project": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The Google Cloud project ID in which the table belongs.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID in which the table belongs.\n" +
+					"Defaults to the provider's `project`.",
 			},
 			"instance": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the Spanner instance.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the Spanner instance.\n" +
+					"Defaults to the provider's `instance`.",
 			},
 			"database": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the parent database.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the parent database.\n" +
+					"Defaults to the provider's `database`.",
 			},
 			"table": schema.StringAttribute{
 				Required: true,
@@ -126,6 +131,17 @@ func (r *spannerTableTtlPolicyResource) Schema(ctx context.Context, _ resource.S
 	}
 }
 
+// ModifyPlan applies the provider's defaults for project, instance and
+// database.
+func (r *spannerTableTtlPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyProviderDefaults(ctx, r.config, req, resp, "project", "instance", "database")
+}
+
+// IdentitySchema defines the identity of the resource.
+func (r *spannerTableTtlPolicyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nameIdentitySchema("projects/{project}/instances/{instance}/databases/{database}/tables/{table}")
+}
+
 // Create a new resource.
 func (r *spannerTableTtlPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -171,6 +187,7 @@ func (r *spannerTableTtlPolicyResource) Create(ctx context.Context, req resource
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, plan.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,6 +236,7 @@ func (r *spannerTableTtlPolicyResource) Read(ctx context.Context, req resource.R
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setNameIdentity(ctx, resp.Identity, state.resourceName())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -315,20 +333,25 @@ func (r *spannerTableTtlPolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	importName, err := names.ParseTable(req.ID)
+	id := importStateName(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importName, err := names.ParseTable(id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}: "+err.Error(),
+			"Import ID ("+id+") must be in the format projects/{project}/instances/{instance}/databases/{database}/tables/{table}: "+err.Error(),
 		)
 		return
 	}
 
-	if !utils.Pattern(utils.SpannerGoogleSqlTableNameRegex).MatchString(req.ID) &&
-		!utils.Pattern(utils.SpannerPostgresSqlTableNameRegex).MatchString(req.ID) {
+	if !utils.Pattern(utils.SpannerGoogleSqlTableNameRegex).MatchString(id) &&
+		!utils.Pattern(utils.SpannerPostgresSqlTableNameRegex).MatchString(id) {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			"Import ID ("+req.ID+") contains an invalid project, instance, database or table ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}.",
+			"Import ID ("+id+") contains an invalid project, instance, database or table ID. Expected format: projects/{project}/instances/{instance}/databases/{database}/tables/{table}.",
 		)
 		return
 	}
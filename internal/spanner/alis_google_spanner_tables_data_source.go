@@ -0,0 +1,181 @@
+package spanner
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &tablesDataSource{}
+	_ datasource.DataSourceWithConfigure = &tablesDataSource{}
+)
+
+// NewTablesDataSource is a helper function to simplify the provider implementation.
+func NewTablesDataSource() datasource.DataSource {
+	return &tablesDataSource{}
+}
+
+type tablesDataSource struct {
+	config *internal.ProviderConfig
+}
+
+type tablesDataSourceModel struct {
+	Project     types.String             `tfsdk:"project"`
+	Instance    types.String             `tfsdk:"instance"`
+	Database    types.String             `tfsdk:"database"`
+	NameRegex   types.String             `tfsdk:"name_regex"`
+	ParentTable types.String             `tfsdk:"parent_table"`
+	Names       []types.String           `tfsdk:"names"`
+	Tables      []*tablesDataSourceTable `tfsdk:"tables"`
+}
+
+type tablesDataSourceTable struct {
+	Name       types.String            `tfsdk:"name"`
+	Interleave *spannerTableInterleave `tfsdk:"interleave"`
+}
+
+// Metadata returns the data source type name.
+func (d *tablesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_google_spanner_tables"
+}
+
+// Schema defines the schema for the data source.
+func (d *tablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Google Cloud project ID containing the Spanner instance and database.\n" +
+					"Defaults to the provider's `project`.",
+			},
+			"instance": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner instance ID that contains the database.\n" +
+					"Defaults to the provider's `instance`.",
+			},
+			"database": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Spanner database ID whose tables are listed.\n" +
+					"Defaults to the provider's `database`.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "A [RE2](https://github.com/google/re2/wiki/Syntax) regular expression table names must match to be listed.\n" +
+					"The expression is not anchored: use `^` and `$` to match whole names.",
+			},
+			"parent_table": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Lists only the tables interleaved directly in this table.",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the listed tables, in name order.",
+			},
+			"tables": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the table.",
+						},
+						"interleave": tableInterleaveDataSourceAttribute(),
+					},
+				},
+				MarkdownDescription: "The listed tables, in name order. Use the `alis_google_spanner_table` data source to read the columns of one.",
+			},
+		},
+		MarkdownDescription: "Lists the tables of a Spanner database, optionally filtered by name or parent table.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *tablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state tablesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Project = withProviderDefault(d.config, "project", state.Project, &resp.Diagnostics)
+	state.Instance = withProviderDefault(d.config, "instance", state.Instance, &resp.Diagnostics)
+	state.Database = withProviderDefault(d.config, "database", state.Database, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		re, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"Could not compile name_regex ("+state.NameRegex.ValueString()+"): "+err.Error(),
+			)
+			return
+		}
+		nameRegex = re
+	}
+
+	databaseName := names.DatabaseName{
+		Project:  state.Project.ValueString(),
+		Instance: state.Instance.ValueString(),
+		Database: state.Database.ValueString(),
+	}.String()
+
+	tables, err := d.config.SpannerService.ListSpannerTables(ctx, databaseName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tables",
+			"Could not list tables for Database ("+databaseName+"): "+utils.ErrDetail(err),
+		)
+		return
+	}
+
+	state.Names = make([]types.String, 0)
+	state.Tables = make([]*tablesDataSourceTable, 0)
+	for _, table := range tables {
+		tableId := table.GetTableId()
+		if nameRegex != nil && !nameRegex.MatchString(tableId) {
+			continue
+		}
+		if !state.ParentTable.IsNull() && table.GetInterleave().GetParentTable() != state.ParentTable.ValueString() {
+			continue
+		}
+
+		state.Names = append(state.Names, types.StringValue(tableId))
+		state.Tables = append(state.Tables, &tablesDataSourceTable{
+			Name:       types.StringValue(tableId),
+			Interleave: tableInterleaveToModel(table.GetInterleave()),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *tablesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.config = config
+}
@@ -7,10 +7,15 @@ package conn
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	googleoauth "golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // Dialect identifies a database's SQL dialect. It is owned by this module so
@@ -23,6 +28,99 @@ const (
 	DialectPostgreSQL
 )
 
+// DatabaseSpec is the creation-time shape of a database. Everything here is
+// fixed once the database exists; drop protection is the one admin setting
+// that changes later, through SetDatabaseDropProtection.
+type DatabaseSpec struct {
+	Dialect Dialect
+	// ExtraStatements run in the same request as CREATE DATABASE, so the
+	// database never exists without them. Spanner accepts them for
+	// GoogleSQL only; a PostgreSQL-dialect database takes its statements
+	// through ExecuteDDLWithDescriptors once created.
+	ExtraStatements []string
+	// ProtoDescriptors is a serialized FileDescriptorSet for any CREATE PROTO
+	// BUNDLE among ExtraStatements.
+	ProtoDescriptors []byte
+	// KmsKeyName is the customer-managed key for a regional instance;
+	// KmsKeyNames the per-region keys for a multi-region one. Both empty
+	// means Google default encryption.
+	KmsKeyName  string
+	KmsKeyNames []string
+}
+
+// validate rejects a spec Spanner would refuse before anything is created.
+func (s DatabaseSpec) validate() error {
+	if s.Dialect == DialectPostgreSQL && (len(s.ExtraStatements) > 0 || len(s.ProtoDescriptors) > 0) {
+		return status.Error(codes.InvalidArgument, "extra statements cannot be applied in the same request that creates a PostgreSQL-dialect database")
+	}
+	return nil
+}
+
+// DatabaseInfo is the admin metadata of an existing database.
+type DatabaseInfo struct {
+	Name    string
+	Dialect Dialect
+	// State is the databasepb.Database_State name, e.g. "READY".
+	State                string
+	CreateTime           time.Time
+	KmsKeyName           string
+	KmsKeyNames          []string
+	EnableDropProtection bool
+}
+
+// BackupSchedule is a database's automated backup schedule.
+type BackupSchedule struct {
+	// Name is "projects/{p}/instances/{i}/databases/{d}/backupSchedules/{s}".
+	Name string
+	// CronText is the crontab the schedule fires on, always in UTC.
+	CronText string
+	// Incremental selects incremental backups; false means full backups.
+	// Fixed once the schedule exists.
+	Incremental bool
+	// RetentionDuration is how long each backup is kept after it is taken.
+	RetentionDuration time.Duration
+	// EncryptionType is the CreateBackupEncryptionConfig_EncryptionType name,
+	// e.g. "USE_DATABASE_ENCRYPTION". KmsKeyName and KmsKeyNames only apply
+	// to "CUSTOMER_MANAGED_ENCRYPTION".
+	EncryptionType string
+	KmsKeyName     string
+	KmsKeyNames    []string
+	// UpdateTime is output only.
+	UpdateTime time.Time
+}
+
+// Backup schedule fields UpdateBackupSchedule can change.
+const (
+	BackupScheduleFieldCronText          = "spec.cron_spec.text"
+	BackupScheduleFieldRetentionDuration = "retention_duration"
+	BackupScheduleFieldEncryptionConfig  = "encryption_config"
+)
+
+// IamPolicy is a database's IAM policy, always at policy version 3 so that
+// conditional bindings read and write intact.
+type IamPolicy struct {
+	// Etag is the version the policy was read at. SetDatabaseIamPolicy
+	// rejects a policy whose Etag is no longer current with codes.Aborted;
+	// an empty Etag overwrites unconditionally.
+	Etag     []byte
+	Bindings []IamBinding
+}
+
+// IamBinding grants Role to Members, only where Condition holds when set.
+type IamBinding struct {
+	Role      string
+	Members   []string
+	Condition *IamCondition
+}
+
+// IamCondition is a binding's CEL condition, e.g. the database roles a
+// roles/spanner.databaseRoleUser binding lets its members act as.
+type IamCondition struct {
+	Title       string
+	Description string
+	Expression  string
+}
+
 // Connection is everything a service method needs to talk to Spanner.
 //
 // Invariants (the whole contract — callers may rely on nothing else):
@@ -36,7 +134,9 @@ const (
 //   - Retry is NOT part of adapter implementations; wrap with WithRetry once at
 //     construction. Callers never add retry loops of their own — stacked
 //     policies multiply latency and replay DDL that may have partly applied.
-//     A gap in what counts as retryable belongs in DefaultRetryable.
+//     A gap in what counts as retryable belongs in DefaultRetryable. The one
+//     exception is an IAM policy etag conflict: replaying the same write can
+//     never succeed, so the caller re-reads and re-applies its change.
 type Connection interface {
 	// Dialect reports the database's SQL dialect, cached per database (a
 	// database's dialect is immutable). Doubles as an existence check:
@@ -72,9 +172,60 @@ type Connection interface {
 	// DatabaseRoles lists the database's role resource names (an admin
 	// metadata read — roles are not reliably visible via INFORMATION_SCHEMA
 	// to all principals). pageSize <= 0 lists all roles. Returns the page
-	// and the next page token ("" when exhausted).
+	// and the next page token ("" when exhausted). Backends without the admin
+	// API, such as the emulator, return codes.Unimplemented.
 	DatabaseRoles(ctx context.Context, database string, pageSize int32, pageToken string) ([]string, string, error)
 
+	// DatabaseDdl returns the database's schema as Spanner renders it
+	// (GetDatabaseDdl): the DDL statements in the order that recreates the
+	// schema, and the serialized FileDescriptorSet of its proto bundle (nil
+	// when there is none). codes.NotFound means the database does not exist.
+	DatabaseDdl(ctx context.Context, database string) ([]string, []byte, error)
+
+	// CreateDatabase creates database with spec (CreateDatabase + LRO wait,
+	// hidden) and returns only once it is ready for DDL. codes.AlreadyExists
+	// means the name is taken.
+	CreateDatabase(ctx context.Context, database string, spec DatabaseSpec) error
+
+	// GetDatabase reads a database's admin metadata; codes.NotFound means it
+	// does not exist.
+	GetDatabase(ctx context.Context, database string) (*DatabaseInfo, error)
+
+	// SetDatabaseDropProtection flips the database's enable_drop_protection
+	// setting, which makes DropDatabase (and deleting the instance) fail
+	// while it is on.
+	SetDatabaseDropProtection(ctx context.Context, database string, enabled bool) error
+
+	// DropDatabase drops the database and all of its data. Dropping a
+	// missing database is codes.NotFound.
+	DropDatabase(ctx context.Context, database string) error
+
+	// CreateBackupSchedule creates schedule under database with the given
+	// schedule ID; schedule.Name is ignored. codes.AlreadyExists means the ID
+	// is taken.
+	CreateBackupSchedule(ctx context.Context, database, scheduleID string, schedule BackupSchedule) (*BackupSchedule, error)
+
+	// GetBackupSchedule reads a backup schedule by its full name;
+	// codes.NotFound means it does not exist.
+	GetBackupSchedule(ctx context.Context, name string) (*BackupSchedule, error)
+
+	// UpdateBackupSchedule writes the BackupScheduleField* fields named in
+	// fields from schedule, located by schedule.Name.
+	UpdateBackupSchedule(ctx context.Context, schedule BackupSchedule, fields []string) (*BackupSchedule, error)
+
+	// DeleteBackupSchedule deletes a backup schedule. Backups it already took
+	// are kept. Deleting a missing schedule is codes.NotFound.
+	DeleteBackupSchedule(ctx context.Context, name string) error
+
+	// GetDatabaseIamPolicy reads the database's IAM policy. codes.NotFound
+	// means the database does not exist.
+	GetDatabaseIamPolicy(ctx context.Context, database string) (*IamPolicy, error)
+
+	// SetDatabaseIamPolicy replaces the database's IAM policy with policy and
+	// returns the policy as stored, with its new Etag. A stale policy.Etag is
+	// codes.Aborted and is not retried by WithRetry.
+	SetDatabaseIamPolicy(ctx context.Context, database string, policy IamPolicy) (*IamPolicy, error)
+
 	// Close releases all cached clients and pools. Called once at provider
 	// teardown; idempotent.
 	Close() error
@@ -85,10 +236,22 @@ type Connection interface {
 type Options struct {
 	// Credentials is threaded into every client the adapter creates (admin,
 	// data, and the go-sql-spanner connector under gorm). nil falls back to
-	// Application Default Credentials. Ignored when SPANNER_EMULATOR_HOST is
-	// set.
+	// Application Default Credentials. Ignored when talking to an emulator.
 	Credentials *googleoauth.Credentials
 
+	// Endpoint overrides the Spanner API endpoint, e.g. a regional endpoint
+	// or a Private Service Connect one. Empty means the default endpoint of
+	// UniverseDomain.
+	Endpoint string
+	// UniverseDomain is the universe the API lives in; empty means
+	// googleapis.com.
+	UniverseDomain string
+	// EmulatorHost is the host:port of a Spanner emulator to talk to instead
+	// of Spanner, over plain text and without credentials; it overrides
+	// Endpoint and UniverseDomain. Empty falls back to SPANNER_EMULATOR_HOST
+	// — see EmulatorHost.
+	EmulatorHost string
+
 	// Retry is the uniform policy applied by WithRetry at construction.
 	Retry RetryPolicy
 }
@@ -108,14 +271,37 @@ type RetryPolicy struct {
 	Retryable func(error) bool
 }
 
-// clientOptions is the single place credentials reach clients. With
-// credentials set it emits option.WithCredentials; nil emits nothing so
-// clients fall back to ADC; a non-empty emulator host suppresses credentials
-// entirely — the emulator ignores auth, and passing credentials alongside the
-// emulator's insecure channel causes dial conflicts.
-func clientOptions(creds *googleoauth.Credentials, emulatorHost string) []option.ClientOption {
-	if emulatorHost != "" || creds == nil {
-		return nil
+// clientOptions is the single place credentials and endpoints reach clients.
+// With credentials set it emits option.WithCredentials; nil emits nothing so
+// clients fall back to ADC. Endpoint and universe domain are emitted as given.
+//
+// A non-empty emulator host replaces all of that with a plain-text channel to
+// the emulator and no authentication — the emulator ignores auth, and passing
+// credentials alongside its insecure channel causes dial conflicts. These are
+// the options the client libraries add themselves for SPANNER_EMULATOR_HOST;
+// emitting them here lets one process reach an emulator without the variable.
+func clientOptions(opts Options, emulatorHost string) []option.ClientOption {
+	if emulatorHost != "" {
+		return []option.ClientOption{
+			option.WithEndpoint("passthrough:///" + emulatorScheme.ReplaceAllString(emulatorHost, "")),
+			option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+			option.WithoutAuthentication(),
+		}
 	}
-	return []option.ClientOption{option.WithCredentials(creds)}
+
+	var copts []option.ClientOption
+	if opts.Credentials != nil {
+		copts = append(copts, option.WithCredentials(opts.Credentials))
+	}
+	if opts.Endpoint != "" {
+		copts = append(copts, option.WithEndpoint(opts.Endpoint))
+	}
+	if opts.UniverseDomain != "" {
+		copts = append(copts, option.WithUniverseDomain(opts.UniverseDomain))
+	}
+	return copts
 }
+
+// emulatorScheme matches the prefixes an emulator address may carry that the
+// passthrough resolver does not take.
+var emulatorScheme = regexp.MustCompile("^(http://|https://|passthrough:///)")
@@ -15,7 +15,7 @@ func TestClientOptions(t *testing.T) {
 	creds := &googleoauth.Credentials{ProjectID: "test-project"}
 
 	t.Run("credentials set produces WithCredentials", func(t *testing.T) {
-		opts := clientOptions(creds, "")
+		opts := clientOptions(Options{Credentials: creds}, "")
 		if len(opts) != 1 {
 			t.Fatalf("got %d options, want exactly 1 (WithCredentials)", len(opts))
 		}
@@ -25,16 +25,54 @@ func TestClientOptions(t *testing.T) {
 	})
 
 	t.Run("nil credentials means ADC (no options)", func(t *testing.T) {
-		if opts := clientOptions(nil, ""); len(opts) != 0 {
+		if opts := clientOptions(Options{}, ""); len(opts) != 0 {
 			t.Errorf("got %d options, want 0 so clients fall back to ADC", len(opts))
 		}
 	})
 
-	t.Run("emulator host suppresses credentials", func(t *testing.T) {
+	t.Run("endpoint and universe domain follow credentials", func(t *testing.T) {
+		opts := clientOptions(Options{
+			Credentials:    creds,
+			Endpoint:       "us-central1-spanner.googleapis.com:443",
+			UniverseDomain: "example.com",
+		}, "")
+		want := []option.ClientOption{
+			option.WithCredentials(creds),
+			option.WithEndpoint("us-central1-spanner.googleapis.com:443"),
+			option.WithUniverseDomain("example.com"),
+		}
+		if !reflect.DeepEqual(opts, want) {
+			t.Errorf("options = %#v, want %#v", opts, want)
+		}
+	})
+
+	t.Run("emulator host replaces credentials and endpoint", func(t *testing.T) {
 		// Passing WithCredentials alongside the emulator's insecure channel
 		// causes dial conflicts; the emulator ignores auth entirely.
-		if opts := clientOptions(creds, "localhost:9010"); len(opts) != 0 {
-			t.Errorf("got %d options, want 0 when SPANNER_EMULATOR_HOST is set", len(opts))
+		for _, host := range []string{"localhost:9010", "http://localhost:9010", "passthrough:///localhost:9010"} {
+			opts := clientOptions(Options{Credentials: creds, Endpoint: "spanner.example.com:443"}, host)
+			if len(opts) != 3 {
+				t.Fatalf("%s: got %d options, want endpoint, insecure dial and no authentication", host, len(opts))
+			}
+			if want := option.WithEndpoint("passthrough:///localhost:9010"); !reflect.DeepEqual(opts[0], want) {
+				t.Errorf("%s: endpoint option = %#v, want %#v", host, opts[0], want)
+			}
+			if want := option.WithoutAuthentication(); !reflect.DeepEqual(opts[2], want) {
+				t.Errorf("%s: option = %#v, want WithoutAuthentication", host, opts[2])
+			}
 		}
 	})
 }
+
+// An emulator configured in Options wins over the environment, which only
+// fills in for an unset one.
+func TestNewGCPAdapter_EmulatorHost(t *testing.T) {
+	t.Setenv(emulatorHostEnv, "env-emulator:9010")
+
+	if got := newGCPAdapter(Options{EmulatorHost: "localhost:9020"}).emulatorHost; got != "localhost:9020" {
+		t.Errorf("configured emulator host = %q, want localhost:9020", got)
+	}
+	if got := newGCPAdapter(Options{}).emulatorHost; got != "env-emulator:9010" {
+		t.Errorf("fallback emulator host = %q, want env-emulator:9010", got)
+	}
+}
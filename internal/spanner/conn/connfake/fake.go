@@ -8,9 +8,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"terraform-provider-alis/internal/spanner/conn"
 
@@ -29,18 +31,49 @@ const (
 	OpExec          OpKind = "Exec"
 	OpQuery         OpKind = "Query"
 	OpDatabaseRoles OpKind = "DatabaseRoles"
+	OpDatabaseDdl   OpKind = "DatabaseDdl"
+	// OpCreateDatabase records the spec's ExtraStatements and
+	// ProtoDescriptors; Statements() leaves them out, as they are not a
+	// schema-change batch of their own.
+	OpCreateDatabase            OpKind = "CreateDatabase"
+	OpGetDatabase               OpKind = "GetDatabase"
+	OpSetDatabaseDropProtection OpKind = "SetDatabaseDropProtection"
+	OpDropDatabase              OpKind = "DropDatabase"
+	// The backup-schedule ops record the schedule's full name in Database.
+	OpCreateBackupSchedule OpKind = "CreateBackupSchedule"
+	OpGetBackupSchedule    OpKind = "GetBackupSchedule"
+	OpUpdateBackupSchedule OpKind = "UpdateBackupSchedule"
+	OpDeleteBackupSchedule OpKind = "DeleteBackupSchedule"
+	OpGetDatabaseIamPolicy OpKind = "GetDatabaseIamPolicy"
+	OpSetDatabaseIamPolicy OpKind = "SetDatabaseIamPolicy"
 )
 
 // Op is one recorded call, in arrival order.
 type Op struct {
 	Kind             OpKind
 	Database         string
-	Statements       []string // ExecuteDDL / ExecuteDDLWithDescriptors
-	ProtoDescriptors []byte   // ExecuteDDLWithDescriptors
+	Statements       []string // ExecuteDDL / ExecuteDDLWithDescriptors / CreateDatabase
+	ProtoDescriptors []byte   // ExecuteDDLWithDescriptors / CreateDatabase
 	SQL              string   // Exec / Query
 	Params           []any
 }
 
+type databaseDdl struct {
+	statements  []string
+	descriptors []byte
+}
+
+// iamPolicy is a stored policy; its etag is the decimal version, bumped on
+// every write.
+type iamPolicy struct {
+	version  int
+	bindings []conn.IamBinding
+}
+
+func (p iamPolicy) etag() []byte {
+	return fmt.Appendf(nil, "%d", p.version)
+}
+
 type queryStub struct {
 	pred func(Op) bool
 	fill func(dest any) error
@@ -54,12 +87,16 @@ type failure struct {
 // Fake implements conn.Connection entirely in memory. Construct with New;
 // safe for concurrent use.
 type Fake struct {
-	mu       sync.Mutex
-	ops      []Op
-	dialects map[string]conn.Dialect
-	roles    map[string][]string
-	stubs    []queryStub // matched most-recently-registered first
-	failures map[OpKind]*failure
+	mu        sync.Mutex
+	ops       []Op
+	dialects  map[string]conn.Dialect
+	roles     map[string][]string
+	databases map[string]*conn.DatabaseInfo
+	schedules map[string]*conn.BackupSchedule
+	ddl       map[string]databaseDdl
+	policies  map[string]iamPolicy
+	stubs     []queryStub // matched most-recently-registered first
+	failures  map[OpKind]*failure
 }
 
 var _ conn.Connection = (*Fake)(nil)
@@ -67,9 +104,13 @@ var _ conn.Connection = (*Fake)(nil)
 // New returns an empty Fake ready for seeding; the zero value is not usable.
 func New() *Fake {
 	return &Fake{
-		dialects: map[string]conn.Dialect{},
-		roles:    map[string][]string{},
-		failures: map[OpKind]*failure{},
+		dialects:  map[string]conn.Dialect{},
+		roles:     map[string][]string{},
+		databases: map[string]*conn.DatabaseInfo{},
+		schedules: map[string]*conn.BackupSchedule{},
+		ddl:       map[string]databaseDdl{},
+		policies:  map[string]iamPolicy{},
+		failures:  map[OpKind]*failure{},
 	}
 }
 
@@ -82,6 +123,48 @@ func (f *Fake) SetDatabaseRoles(database string, names []string) {
 
 // --- Seeding ---
 
+// SetDatabase seeds a database's admin metadata, as if CreateDatabase had
+// run. Name defaults to database and Dialect to DialectGoogleSQL.
+func (f *Fake) SetDatabase(database string, info conn.DatabaseInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if info.Name == "" {
+		info.Name = database
+	}
+	if info.Dialect == conn.DialectUnknown {
+		info.Dialect = conn.DialectGoogleSQL
+	}
+	f.databases[database] = &info
+	f.dialects[database] = info.Dialect
+}
+
+// SetBackupSchedule seeds, or overwrites, a backup schedule under its
+// Name, as if it had been created or changed outside Terraform.
+func (f *Fake) SetBackupSchedule(schedule conn.BackupSchedule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	schedule.KmsKeyNames = slices.Clone(schedule.KmsKeyNames)
+	f.schedules[schedule.Name] = &schedule
+}
+
+// SetDatabaseIamBindings seeds, or overwrites, a database's IAM policy
+// bindings as if they had been changed outside Terraform: the policy's etag
+// moves on, so a read-modify-write that read it earlier is rejected.
+func (f *Fake) SetDatabaseIamBindings(database string, bindings []conn.IamBinding) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.policies[database] = iamPolicy{version: f.policies[database].version + 1, bindings: cloneIamBindings(bindings)}
+}
+
+// SetDatabaseDdl seeds the schema DatabaseDdl reports for database. A
+// database created through CreateDatabase reports its extra statements and
+// proto descriptors until seeded otherwise.
+func (f *Fake) SetDatabaseDdl(database string, statements []string, protoDescriptors []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ddl[database] = databaseDdl{statements: slices.Clone(statements), descriptors: slices.Clone(protoDescriptors)}
+}
+
 // SetDialect seeds the dialect reported for database; unseeded databases
 // report DialectGoogleSQL.
 func (f *Fake) SetDialect(database string, d conn.Dialect) {
@@ -248,6 +331,250 @@ func (f *Fake) DatabaseRoles(_ context.Context, database string, pageSize int32,
 	return names, "", nil
 }
 
+// DatabaseDdl returns the seeded schema, or codes.NotFound for a database
+// neither seeded nor created.
+func (f *Fake) DatabaseDdl(_ context.Context, database string) ([]string, []byte, error) {
+	if err := f.record(Op{Kind: OpDatabaseDdl, Database: database}); err != nil {
+		return nil, nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ddl, ok := f.ddl[database]
+	if !ok {
+		return nil, nil, status.Errorf(codes.NotFound, "connfake: database %s not found", database)
+	}
+	return slices.Clone(ddl.statements), slices.Clone(ddl.descriptors), nil
+}
+
+// CreateDatabase stores the database as READY. A name already taken is
+// codes.AlreadyExists, and extra statements on a PostgreSQL-dialect create
+// codes.InvalidArgument, as on Spanner.
+func (f *Fake) CreateDatabase(_ context.Context, database string, spec conn.DatabaseSpec) error {
+	if err := f.record(Op{
+		Kind:             OpCreateDatabase,
+		Database:         database,
+		Statements:       spec.ExtraStatements,
+		ProtoDescriptors: spec.ProtoDescriptors,
+	}); err != nil {
+		return err
+	}
+	if spec.Dialect == conn.DialectPostgreSQL && (len(spec.ExtraStatements) > 0 || len(spec.ProtoDescriptors) > 0) {
+		return status.Errorf(codes.InvalidArgument, "connfake: extra statements on PostgreSQL-dialect database %s", database)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.databases[database]; ok {
+		return status.Errorf(codes.AlreadyExists, "connfake: database %s already exists", database)
+	}
+	dialect := spec.Dialect
+	if dialect == conn.DialectUnknown {
+		dialect = conn.DialectGoogleSQL
+	}
+	f.databases[database] = &conn.DatabaseInfo{
+		Name:        database,
+		Dialect:     dialect,
+		State:       "READY",
+		CreateTime:  time.Now().UTC(),
+		KmsKeyName:  spec.KmsKeyName,
+		KmsKeyNames: spec.KmsKeyNames,
+	}
+	f.dialects[database] = dialect
+	f.ddl[database] = databaseDdl{
+		statements:  slices.Clone(spec.ExtraStatements),
+		descriptors: slices.Clone(spec.ProtoDescriptors),
+	}
+	return nil
+}
+
+// GetDatabase returns a copy of the stored database, or codes.NotFound for
+// one neither created nor seeded.
+func (f *Fake) GetDatabase(_ context.Context, database string) (*conn.DatabaseInfo, error) {
+	if err := f.record(Op{Kind: OpGetDatabase, Database: database}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, ok := f.databases[database]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "connfake: database %s not found", database)
+	}
+	out := *info
+	return &out, nil
+}
+
+func (f *Fake) SetDatabaseDropProtection(_ context.Context, database string, enabled bool) error {
+	if err := f.record(Op{Kind: OpSetDatabaseDropProtection, Database: database, Params: []any{enabled}}); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, ok := f.databases[database]
+	if !ok {
+		return status.Errorf(codes.NotFound, "connfake: database %s not found", database)
+	}
+	info.EnableDropProtection = enabled
+	return nil
+}
+
+// DropDatabase enforces drop protection with codes.FailedPrecondition, as
+// Spanner does.
+func (f *Fake) DropDatabase(_ context.Context, database string) error {
+	if err := f.record(Op{Kind: OpDropDatabase, Database: database}); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, ok := f.databases[database]
+	if !ok {
+		return status.Errorf(codes.NotFound, "connfake: database %s not found", database)
+	}
+	if info.EnableDropProtection {
+		return status.Errorf(codes.FailedPrecondition, "connfake: database %s has drop protection enabled", database)
+	}
+	delete(f.databases, database)
+	delete(f.dialects, database)
+	delete(f.ddl, database)
+	for name := range f.schedules {
+		if strings.HasPrefix(name, database+"/backupSchedules/") {
+			delete(f.schedules, name)
+		}
+	}
+	return nil
+}
+
+// CreateBackupSchedule stores the schedule under database. An unset
+// EncryptionType reads back as USE_DATABASE_ENCRYPTION, Spanner's default.
+func (f *Fake) CreateBackupSchedule(_ context.Context, database, scheduleID string, schedule conn.BackupSchedule) (*conn.BackupSchedule, error) {
+	name := database + "/backupSchedules/" + scheduleID
+	if err := f.record(Op{Kind: OpCreateBackupSchedule, Database: name}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.schedules[name]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "connfake: backup schedule %s already exists", name)
+	}
+	schedule.Name = name
+	schedule.KmsKeyNames = slices.Clone(schedule.KmsKeyNames)
+	if schedule.EncryptionType == "" {
+		schedule.EncryptionType = "USE_DATABASE_ENCRYPTION"
+	}
+	schedule.UpdateTime = time.Now().UTC()
+	f.schedules[name] = &schedule
+	out := schedule
+	return &out, nil
+}
+
+// GetBackupSchedule returns a copy of the stored schedule, or
+// codes.NotFound.
+func (f *Fake) GetBackupSchedule(_ context.Context, name string) (*conn.BackupSchedule, error) {
+	if err := f.record(Op{Kind: OpGetBackupSchedule, Database: name}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored, ok := f.schedules[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "connfake: backup schedule %s not found", name)
+	}
+	out := *stored
+	return &out, nil
+}
+
+// UpdateBackupSchedule copies only the masked fields, the way the admin API
+// applies an update mask; an unknown field is codes.InvalidArgument. The
+// recorded Op carries fields in Params.
+func (f *Fake) UpdateBackupSchedule(_ context.Context, schedule conn.BackupSchedule, fields []string) (*conn.BackupSchedule, error) {
+	params := make([]any, 0, len(fields))
+	for _, field := range fields {
+		params = append(params, field)
+	}
+	if err := f.record(Op{Kind: OpUpdateBackupSchedule, Database: schedule.Name, Params: params}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored, ok := f.schedules[schedule.Name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "connfake: backup schedule %s not found", schedule.Name)
+	}
+	updated := *stored
+	for _, field := range fields {
+		switch field {
+		case conn.BackupScheduleFieldCronText:
+			updated.CronText = schedule.CronText
+		case conn.BackupScheduleFieldRetentionDuration:
+			updated.RetentionDuration = schedule.RetentionDuration
+		case conn.BackupScheduleFieldEncryptionConfig:
+			updated.EncryptionType = schedule.EncryptionType
+			updated.KmsKeyName = schedule.KmsKeyName
+			updated.KmsKeyNames = slices.Clone(schedule.KmsKeyNames)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "connfake: backup schedule field %q cannot be updated", field)
+		}
+	}
+	updated.UpdateTime = time.Now().UTC()
+	f.schedules[schedule.Name] = &updated
+	out := updated
+	return &out, nil
+}
+
+func (f *Fake) DeleteBackupSchedule(_ context.Context, name string) error {
+	if err := f.record(Op{Kind: OpDeleteBackupSchedule, Database: name}); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.schedules[name]; !ok {
+		return status.Errorf(codes.NotFound, "connfake: backup schedule %s not found", name)
+	}
+	delete(f.schedules, name)
+	return nil
+}
+
+// GetDatabaseIamPolicy returns a copy of the stored policy. A database whose
+// policy was never written has an empty one.
+func (f *Fake) GetDatabaseIamPolicy(_ context.Context, database string) (*conn.IamPolicy, error) {
+	if err := f.record(Op{Kind: OpGetDatabaseIamPolicy, Database: database}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored := f.policies[database]
+	return &conn.IamPolicy{Etag: stored.etag(), Bindings: cloneIamBindings(stored.bindings)}, nil
+}
+
+// SetDatabaseIamPolicy stores policy, rejecting a stale non-empty Etag with
+// codes.Aborted the way the IAM API does.
+func (f *Fake) SetDatabaseIamPolicy(_ context.Context, database string, policy conn.IamPolicy) (*conn.IamPolicy, error) {
+	if err := f.record(Op{Kind: OpSetDatabaseIamPolicy, Database: database}); err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored := f.policies[database]
+	if len(policy.Etag) > 0 && string(policy.Etag) != string(stored.etag()) {
+		return nil, status.Errorf(codes.Aborted, "connfake: IAM policy of %s changed since etag %s was read", database, policy.Etag)
+	}
+	stored = iamPolicy{version: stored.version + 1, bindings: cloneIamBindings(policy.Bindings)}
+	f.policies[database] = stored
+	return &conn.IamPolicy{Etag: stored.etag(), Bindings: cloneIamBindings(stored.bindings)}, nil
+}
+
+// cloneIamBindings deep-copies bindings so callers never alias the store.
+func cloneIamBindings(bindings []conn.IamBinding) []conn.IamBinding {
+	out := make([]conn.IamBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		binding.Members = slices.Clone(binding.Members)
+		if binding.Condition != nil {
+			condition := *binding.Condition
+			binding.Condition = &condition
+		}
+		out = append(out, binding)
+	}
+	return out
+}
+
 func (f *Fake) Close() error { return nil }
 
 // fillDest implements the port's scan contract: dest *[]T gets all rows
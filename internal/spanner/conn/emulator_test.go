@@ -103,6 +103,52 @@ func TestEmulator_PortEndToEnd(t *testing.T) {
 	})
 }
 
+// TestEmulator_DatabaseLifecycle drives the database admin verbs: create with
+// initial DDL, read back, and drop. The fresh database from Setup only
+// supplies the instance to create a sibling in.
+func TestEmulator_DatabaseLifecycle(t *testing.T) {
+	cn, db := conntest.Setup(t, databasepb.DatabaseDialect_GOOGLE_STANDARD_SQL)
+	ctx := context.Background()
+	sibling := db + "-db"
+
+	err := cn.CreateDatabase(ctx, sibling, conn.DatabaseSpec{
+		Dialect:         conn.DialectGoogleSQL,
+		ExtraStatements: []string{"CREATE TABLE probes (id INT64) PRIMARY KEY (id)"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDatabase: %v", err)
+	}
+	t.Cleanup(func() { _ = cn.DropDatabase(context.Background(), sibling) })
+
+	info, err := cn.GetDatabase(ctx, sibling)
+	if err != nil {
+		t.Fatalf("GetDatabase: %v", err)
+	}
+	if info.Name != sibling || info.Dialect != conn.DialectGoogleSQL || info.State != "READY" {
+		t.Errorf("GetDatabase() = %+v, want READY GoogleSQL %s", info, sibling)
+	}
+
+	type tableRow struct {
+		TableName string `gorm:"column:TABLE_NAME"`
+	}
+	var rows []tableRow
+	if err := cn.Query(ctx, sibling, &rows,
+		"SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_NAME = ?", "probes"); err != nil || len(rows) != 1 {
+		t.Errorf("extra statement not applied: rows=%+v err=%v", rows, err)
+	}
+
+	if err := cn.CreateDatabase(ctx, sibling, conn.DatabaseSpec{}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("second CreateDatabase err = %v, want AlreadyExists", err)
+	}
+
+	if err := cn.DropDatabase(ctx, sibling); err != nil {
+		t.Fatalf("DropDatabase: %v", err)
+	}
+	if _, err := cn.GetDatabase(ctx, sibling); status.Code(err) != codes.NotFound {
+		t.Errorf("GetDatabase after drop err = %v, want NotFound", err)
+	}
+}
+
 // TestEmulator_SupportMatrix probes the features whose emulator support was
 // uncertain. Unsupported features skip with the emulator's error recorded, so
 // the matrix stays visible in test output as emulator versions change.
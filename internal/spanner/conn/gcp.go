@@ -12,6 +12,7 @@ import (
 
 	customloggers "terraform-provider-alis/internal/spanner/logger"
 
+	"cloud.google.com/go/iam/apiv1/iampb"
 	"cloud.google.com/go/spanner"
 	spannerAdmin "cloud.google.com/go/spanner/admin/database/apiv1"
 	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
@@ -19,13 +20,17 @@ import (
 	spannerdriver "github.com/googleapis/go-sql-spanner"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
+	"google.golang.org/genproto/googleapis/type/expr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"gorm.io/gorm"
 	gormlogger "gorm.io/gorm/logger"
 )
 
-// emulatorHostEnv names the emulator address adapters read at construction.
+// emulatorHostEnv names the emulator address adapters fall back to at
+// construction when Options.EmulatorHost is empty.
 const emulatorHostEnv = "SPANNER_EMULATOR_HOST"
 
 // New builds the production Connection: the GCP adapter wrapped in the
@@ -33,16 +38,22 @@ const emulatorHostEnv = "SPANNER_EMULATOR_HOST"
 // first use and cached per database thereafter. Callers may therefore build
 // one while holding a lock.
 //
-// The result depends on more than opts — see EmulatorHost.
+// Without Options.EmulatorHost the result depends on more than opts — see
+// EmulatorHost.
 func New(opts Options) Connection {
 	return WithRetry(newGCPAdapter(opts), opts.Retry)
 }
 
-// EmulatorHost reports the emulator address the next New will capture, empty
-// when talking to real Spanner. It is the one input to New that does not
-// arrive through Options, so anything caching Connections must include it
-// alongside the Options in its cache key: two adapters built from identical
-// Options but different hosts talk to different backends.
+// EmulatorHost reports the emulator address the next New without
+// Options.EmulatorHost will capture, empty when talking to real Spanner. It is
+// the one input to New that need not arrive through Options, so anything
+// caching Connections must resolve it into the Options it keys on: two
+// adapters built from identical Options but different hosts talk to different
+// backends.
+//
+// The client libraries also read SPANNER_EMULATOR_HOST themselves, and their
+// emulator settings cannot be undone by options passed after them: while it
+// is set, every adapter talks to the emulator whatever its Options say.
 func EmulatorHost() string {
 	return os.Getenv(emulatorHostEnv)
 }
@@ -66,8 +77,8 @@ func defaultGormLogger() gormlogger.Interface {
 // Google clients — the database admin client for DDL and metadata, and gorm
 // (over go-sql-spanner) for DML and queries. The admin client is created once
 // per adapter; gorm sessions and dialect lookups are cached per database.
-// SPANNER_EMULATOR_HOST is captured at construction time, so the adapter must
-// be built after the variable is set.
+// The emulator host is resolved at construction time, so an adapter relying
+// on SPANNER_EMULATOR_HOST must be built after the variable is set.
 type gcpConn struct {
 	opts         Options
 	emulatorHost string
@@ -85,9 +96,14 @@ type gcpConn struct {
 var _ Connection = (*gcpConn)(nil)
 
 func newGCPAdapter(opts Options) *gcpConn {
+	emulatorHost := opts.EmulatorHost
+	if emulatorHost == "" {
+		emulatorHost = EmulatorHost()
+	}
+
 	return &gcpConn{
 		opts:         opts,
-		emulatorHost: EmulatorHost(),
+		emulatorHost: emulatorHost,
 		logger:       defaultGormLogger(),
 		sessions:     map[string]*gorm.DB{},
 		dialects:     map[string]Dialect{},
@@ -111,7 +127,7 @@ func splitDatabase(database string) (project, instance, db string, err error) {
 func (g *gcpConn) adminClient(ctx context.Context) (*spannerAdmin.DatabaseAdminClient, error) {
 	g.adminOnce.Do(func() {
 		g.admin, g.adminErr = spannerAdmin.NewDatabaseAdminClient(ctx,
-			clientOptions(g.opts.Credentials, g.emulatorHost)...)
+			clientOptions(g.opts, g.emulatorHost)...)
 	})
 	return g.admin, g.adminErr
 }
@@ -138,7 +154,7 @@ func (g *gcpConn) session(ctx context.Context, database string) (*gorm.DB, error
 		Instance: instance,
 		Database: dbID,
 		Configurator: func(_ *spanner.ClientConfig, copts *[]option.ClientOption) {
-			*copts = append(*copts, clientOptions(g.opts.Credentials, g.emulatorHost)...)
+			*copts = append(*copts, clientOptions(g.opts, g.emulatorHost)...)
 		},
 	})
 	if err != nil {
@@ -284,6 +300,302 @@ func (g *gcpConn) DatabaseRoles(ctx context.Context, database string, pageSize i
 	return names, nextPageToken, nil
 }
 
+func (g *gcpConn) DatabaseDdl(ctx context.Context, database string) ([]string, []byte, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := admin.GetDatabaseDdl(ctx, &databasepb.GetDatabaseDdlRequest{Database: database})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.GetStatements(), resp.GetProtoDescriptors(), nil
+}
+
+func (g *gcpConn) CreateDatabase(ctx context.Context, database string, spec DatabaseSpec) error {
+	if err := spec.validate(); err != nil {
+		return err
+	}
+	project, instance, dbID, err := splitDatabase(database)
+	if err != nil {
+		return err
+	}
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The database ID is quoted in the dialect's own identifier syntax.
+	dialect := databasepb.DatabaseDialect_GOOGLE_STANDARD_SQL
+	createStatement := "CREATE DATABASE `" + dbID + "`"
+	if spec.Dialect == DialectPostgreSQL {
+		dialect = databasepb.DatabaseDialect_POSTGRESQL
+		createStatement = `CREATE DATABASE "` + dbID + `"`
+	}
+
+	var encryption *databasepb.EncryptionConfig
+	if spec.KmsKeyName != "" || len(spec.KmsKeyNames) > 0 {
+		encryption = &databasepb.EncryptionConfig{
+			KmsKeyName:  spec.KmsKeyName,
+			KmsKeyNames: spec.KmsKeyNames,
+		}
+	}
+
+	op, err := admin.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
+		Parent:           "projects/" + project + "/instances/" + instance,
+		CreateStatement:  createStatement,
+		ExtraStatements:  spec.ExtraStatements,
+		ProtoDescriptors: spec.ProtoDescriptors,
+		EncryptionConfig: encryption,
+		DatabaseDialect:  dialect,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = op.Wait(ctx)
+	return err
+}
+
+func (g *gcpConn) GetDatabase(ctx context.Context, database string) (*DatabaseInfo, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err := admin.GetDatabase(ctx, &databasepb.GetDatabaseRequest{Name: database})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &DatabaseInfo{
+		Name:                 db.GetName(),
+		Dialect:              DialectGoogleSQL,
+		State:                db.GetState().String(),
+		KmsKeyName:           db.GetEncryptionConfig().GetKmsKeyName(),
+		KmsKeyNames:          db.GetEncryptionConfig().GetKmsKeyNames(),
+		EnableDropProtection: db.GetEnableDropProtection(),
+	}
+	if db.GetDatabaseDialect() == databasepb.DatabaseDialect_POSTGRESQL {
+		info.Dialect = DialectPostgreSQL
+	}
+	if db.GetCreateTime() != nil {
+		info.CreateTime = db.GetCreateTime().AsTime()
+	}
+
+	return info, nil
+}
+
+func (g *gcpConn) SetDatabaseDropProtection(ctx context.Context, database string, enabled bool) error {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return err
+	}
+	op, err := admin.UpdateDatabase(ctx, &databasepb.UpdateDatabaseRequest{
+		Database:   &databasepb.Database{Name: database, EnableDropProtection: enabled},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"enable_drop_protection"}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = op.Wait(ctx)
+	return err
+}
+
+func (g *gcpConn) DropDatabase(ctx context.Context, database string) error {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return err
+	}
+	if err := admin.DropDatabase(ctx, &databasepb.DropDatabaseRequest{Database: database}); err != nil {
+		return err
+	}
+
+	// A database recreated under the same name may differ in dialect, and
+	// its old session pool points at nothing.
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if db, ok := g.sessions[database]; ok {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+		delete(g.sessions, database)
+	}
+	delete(g.dialects, database)
+	return nil
+}
+
+func (g *gcpConn) CreateBackupSchedule(ctx context.Context, database, scheduleID string, schedule BackupSchedule) (*BackupSchedule, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	created, err := admin.CreateBackupSchedule(ctx, &databasepb.CreateBackupScheduleRequest{
+		Parent:           database,
+		BackupScheduleId: scheduleID,
+		BackupSchedule:   backupScheduleToProto(schedule),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return backupScheduleFromProto(created), nil
+}
+
+func (g *gcpConn) GetBackupSchedule(ctx context.Context, name string) (*BackupSchedule, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := admin.GetBackupSchedule(ctx, &databasepb.GetBackupScheduleRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return backupScheduleFromProto(schedule), nil
+}
+
+func (g *gcpConn) UpdateBackupSchedule(ctx context.Context, schedule BackupSchedule, fields []string) (*BackupSchedule, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := admin.UpdateBackupSchedule(ctx, &databasepb.UpdateBackupScheduleRequest{
+		BackupSchedule: backupScheduleToProto(schedule),
+		UpdateMask:     &fieldmaskpb.FieldMask{Paths: fields},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return backupScheduleFromProto(updated), nil
+}
+
+func (g *gcpConn) DeleteBackupSchedule(ctx context.Context, name string) error {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return err
+	}
+	return admin.DeleteBackupSchedule(ctx, &databasepb.DeleteBackupScheduleRequest{Name: name})
+}
+
+// backupScheduleToProto maps schedule onto the admin API message.
+func backupScheduleToProto(schedule BackupSchedule) *databasepb.BackupSchedule {
+	pb := &databasepb.BackupSchedule{
+		Name: schedule.Name,
+		Spec: &databasepb.BackupScheduleSpec{
+			ScheduleSpec: &databasepb.BackupScheduleSpec_CronSpec{
+				CronSpec: &databasepb.CrontabSpec{Text: schedule.CronText},
+			},
+		},
+		RetentionDuration: durationpb.New(schedule.RetentionDuration),
+		BackupTypeSpec:    &databasepb.BackupSchedule_FullBackupSpec{FullBackupSpec: &databasepb.FullBackupSpec{}},
+	}
+	if schedule.Incremental {
+		pb.BackupTypeSpec = &databasepb.BackupSchedule_IncrementalBackupSpec{IncrementalBackupSpec: &databasepb.IncrementalBackupSpec{}}
+	}
+	if schedule.EncryptionType != "" {
+		pb.EncryptionConfig = &databasepb.CreateBackupEncryptionConfig{
+			EncryptionType: databasepb.CreateBackupEncryptionConfig_EncryptionType(
+				databasepb.CreateBackupEncryptionConfig_EncryptionType_value[schedule.EncryptionType],
+			),
+			KmsKeyName:  schedule.KmsKeyName,
+			KmsKeyNames: schedule.KmsKeyNames,
+		}
+	}
+	return pb
+}
+
+// backupScheduleFromProto maps the admin API message onto BackupSchedule.
+func backupScheduleFromProto(pb *databasepb.BackupSchedule) *BackupSchedule {
+	schedule := &BackupSchedule{
+		Name:              pb.GetName(),
+		CronText:          pb.GetSpec().GetCronSpec().GetText(),
+		Incremental:       pb.GetIncrementalBackupSpec() != nil,
+		RetentionDuration: pb.GetRetentionDuration().AsDuration(),
+		KmsKeyName:        pb.GetEncryptionConfig().GetKmsKeyName(),
+		KmsKeyNames:       pb.GetEncryptionConfig().GetKmsKeyNames(),
+	}
+	if pb.GetEncryptionConfig() != nil {
+		schedule.EncryptionType = pb.GetEncryptionConfig().GetEncryptionType().String()
+	}
+	if pb.GetUpdateTime() != nil {
+		schedule.UpdateTime = pb.GetUpdateTime().AsTime()
+	}
+	return schedule
+}
+
+// iamPolicyVersion is the policy version read and written: the first that
+// carries conditional bindings. Reading at a lower version strips conditions,
+// and writing the result back would drop them.
+const iamPolicyVersion = 3
+
+func (g *gcpConn) GetDatabaseIamPolicy(ctx context.Context, database string) (*IamPolicy, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := admin.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
+		Resource: database,
+		Options:  &iampb.GetPolicyOptions{RequestedPolicyVersion: iamPolicyVersion},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iamPolicyFromProto(policy), nil
+}
+
+func (g *gcpConn) SetDatabaseIamPolicy(ctx context.Context, database string, policy IamPolicy) (*IamPolicy, error) {
+	admin, err := g.adminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := admin.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+		Resource: database,
+		Policy:   iamPolicyToProto(policy),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return iamPolicyFromProto(stored), nil
+}
+
+// iamPolicyToProto maps policy onto the IAM API message.
+func iamPolicyToProto(policy IamPolicy) *iampb.Policy {
+	pb := &iampb.Policy{
+		Version:  iamPolicyVersion,
+		Etag:     policy.Etag,
+		Bindings: make([]*iampb.Binding, 0, len(policy.Bindings)),
+	}
+	for _, binding := range policy.Bindings {
+		b := &iampb.Binding{Role: binding.Role, Members: binding.Members}
+		if binding.Condition != nil {
+			b.Condition = &expr.Expr{
+				Title:       binding.Condition.Title,
+				Description: binding.Condition.Description,
+				Expression:  binding.Condition.Expression,
+			}
+		}
+		pb.Bindings = append(pb.Bindings, b)
+	}
+	return pb
+}
+
+// iamPolicyFromProto maps the IAM API message onto IamPolicy.
+func iamPolicyFromProto(pb *iampb.Policy) *IamPolicy {
+	policy := &IamPolicy{
+		Etag:     pb.GetEtag(),
+		Bindings: make([]IamBinding, 0, len(pb.GetBindings())),
+	}
+	for _, b := range pb.GetBindings() {
+		binding := IamBinding{Role: b.GetRole(), Members: b.GetMembers()}
+		if c := b.GetCondition(); c != nil {
+			binding.Condition = &IamCondition{
+				Title:       c.GetTitle(),
+				Description: c.GetDescription(),
+				Expression:  c.GetExpression(),
+			}
+		}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+	return policy
+}
+
 func (g *gcpConn) Close() error {
 	g.mu.Lock()
 	defer g.mu.Unlock()
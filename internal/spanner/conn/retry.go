@@ -61,11 +61,16 @@ type retryConn struct {
 // jitter added. When ctx is cancelled mid-backoff, do returns the last
 // operation error (not ctx.Err()) so callers still see a meaningful gRPC code.
 func (r *retryConn) do(ctx context.Context, op func() error) error {
+	return r.doWith(ctx, r.policy.Retryable, op)
+}
+
+// doWith is do with retryable in place of the policy's classifier.
+func (r *retryConn) doWith(ctx context.Context, retryable func(error) bool, op func() error) error {
 	backoff := r.policy.InitialBackoff
 	var err error
 	for attempt := 1; ; attempt++ {
 		err = op()
-		if err == nil || attempt >= r.policy.Attempts || !r.policy.Retryable(err) {
+		if err == nil || attempt >= r.policy.Attempts || !retryable(err) {
 			return err
 		}
 		jitter := time.Duration(rand.Int63n(int64(backoff)/2 + 1))
@@ -117,4 +122,96 @@ func (r *retryConn) DatabaseRoles(ctx context.Context, database string, pageSize
 	return names, next, err
 }
 
+func (r *retryConn) DatabaseDdl(ctx context.Context, database string) ([]string, []byte, error) {
+	var statements []string
+	var descriptors []byte
+	err := r.do(ctx, func() error {
+		var err error
+		statements, descriptors, err = r.inner.DatabaseDdl(ctx, database)
+		return err
+	})
+	return statements, descriptors, err
+}
+
+func (r *retryConn) CreateDatabase(ctx context.Context, database string, spec DatabaseSpec) error {
+	return r.do(ctx, func() error { return r.inner.CreateDatabase(ctx, database, spec) })
+}
+
+func (r *retryConn) GetDatabase(ctx context.Context, database string) (*DatabaseInfo, error) {
+	var info *DatabaseInfo
+	err := r.do(ctx, func() error {
+		var err error
+		info, err = r.inner.GetDatabase(ctx, database)
+		return err
+	})
+	return info, err
+}
+
+func (r *retryConn) SetDatabaseDropProtection(ctx context.Context, database string, enabled bool) error {
+	return r.do(ctx, func() error { return r.inner.SetDatabaseDropProtection(ctx, database, enabled) })
+}
+
+func (r *retryConn) DropDatabase(ctx context.Context, database string) error {
+	return r.do(ctx, func() error { return r.inner.DropDatabase(ctx, database) })
+}
+
+func (r *retryConn) CreateBackupSchedule(ctx context.Context, database, scheduleID string, schedule BackupSchedule) (*BackupSchedule, error) {
+	var created *BackupSchedule
+	err := r.do(ctx, func() error {
+		var err error
+		created, err = r.inner.CreateBackupSchedule(ctx, database, scheduleID, schedule)
+		return err
+	})
+	return created, err
+}
+
+func (r *retryConn) GetBackupSchedule(ctx context.Context, name string) (*BackupSchedule, error) {
+	var schedule *BackupSchedule
+	err := r.do(ctx, func() error {
+		var err error
+		schedule, err = r.inner.GetBackupSchedule(ctx, name)
+		return err
+	})
+	return schedule, err
+}
+
+func (r *retryConn) UpdateBackupSchedule(ctx context.Context, schedule BackupSchedule, fields []string) (*BackupSchedule, error) {
+	var updated *BackupSchedule
+	err := r.do(ctx, func() error {
+		var err error
+		updated, err = r.inner.UpdateBackupSchedule(ctx, schedule, fields)
+		return err
+	})
+	return updated, err
+}
+
+func (r *retryConn) DeleteBackupSchedule(ctx context.Context, name string) error {
+	return r.do(ctx, func() error { return r.inner.DeleteBackupSchedule(ctx, name) })
+}
+
+func (r *retryConn) GetDatabaseIamPolicy(ctx context.Context, database string) (*IamPolicy, error) {
+	var policy *IamPolicy
+	err := r.do(ctx, func() error {
+		var err error
+		policy, err = r.inner.GetDatabaseIamPolicy(ctx, database)
+		return err
+	})
+	return policy, err
+}
+
+// SetDatabaseIamPolicy does not retry codes.Aborted: it is how a stale etag
+// is reported, and the same policy would be rejected on every attempt.
+func (r *retryConn) SetDatabaseIamPolicy(ctx context.Context, database string, policy IamPolicy) (*IamPolicy, error) {
+	var stored *IamPolicy
+	retryable := func(err error) bool {
+		return status.Code(err) != codes.Aborted && r.policy.Retryable(err)
+	}
+	err := r.doWith(ctx, retryable, func() error {
+		var err error
+		stored, err = r.inner.SetDatabaseIamPolicy(ctx, database, policy)
+		return err
+	})
+	return stored, err
+}
+
 func (r *retryConn) Close() error { return r.inner.Close() }
@@ -118,4 +118,31 @@ func TestWithRetry(t *testing.T) {
 			t.Errorf("attempts = %d, want 1 (NotFound is not retryable)", got)
 		}
 	})
+
+	t.Run("IAM policy etag conflict is not replayed", func(t *testing.T) {
+		fake := connfake.New()
+		fake.FailNext(connfake.OpSetDatabaseIamPolicy, 99, status.Error(codes.Aborted, "stale etag"))
+		c := conn.WithRetry(fake, fastPolicy(5))
+
+		_, err := c.SetDatabaseIamPolicy(ctx, db, conn.IamPolicy{Etag: []byte("1")})
+		if status.Code(err) != codes.Aborted {
+			t.Fatalf("err = %v, want Aborted passthrough", err)
+		}
+		if got := len(fake.OpsOf(connfake.OpSetDatabaseIamPolicy)); got != 1 {
+			t.Errorf("attempts = %d, want 1 (a stale etag fails identically on replay)", got)
+		}
+	})
+
+	t.Run("IAM policy write retried on Unavailable", func(t *testing.T) {
+		fake := connfake.New()
+		fake.FailNext(connfake.OpSetDatabaseIamPolicy, 1, status.Error(codes.Unavailable, "blip"))
+		c := conn.WithRetry(fake, fastPolicy(3))
+
+		if _, err := c.SetDatabaseIamPolicy(ctx, db, conn.IamPolicy{}); err != nil {
+			t.Fatalf("SetDatabaseIamPolicy: %v", err)
+		}
+		if got := len(fake.OpsOf(connfake.OpSetDatabaseIamPolicy)); got != 2 {
+			t.Errorf("attempts = %d, want 2", got)
+		}
+	})
 }
@@ -0,0 +1,50 @@
+package spanner
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameIdentitySchema is the resource identity shared by every schema object
+// resource: the fully qualified resource name, which is also the import ID
+// its ImportState accepts.
+func nameIdentitySchema(format string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The fully qualified resource name, in the format " + format + ".",
+			},
+		},
+	}
+}
+
+// setNameIdentity records name as the resource identity. identity is nil when
+// Terraform does not support identities, in which case there is nothing to
+// set.
+func setNameIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, name string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.SetAttribute(ctx, path.Root("name"), name)
+}
+
+// importStateName returns the name an import refers to: the import ID from an
+// import command or block, or the identity name from an identity import block.
+func importStateName(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var name types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	return name.ValueString()
+}
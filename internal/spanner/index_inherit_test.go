@@ -25,10 +25,10 @@ func TestSpannerTableIndexSchema_InheritedAttributes(t *testing.T) {
 	if !unique.Optional || !unique.Computed {
 		t.Errorf("unique Optional=%t Computed=%t, want both true", unique.Optional, unique.Computed)
 	}
-	// UseStateForUnknown must run before RequiresReplace so the replace check
-	// compares the inherited value, not unknown.
-	if len(unique.PlanModifiers) < 2 {
-		t.Errorf("unique has %d plan modifiers, want UseStateForUnknown before RequiresReplace", len(unique.PlanModifiers))
+	// Update compares the inherited value against state to decide whether to
+	// rebuild the index; an unknown value would always read as a change.
+	if len(unique.PlanModifiers) == 0 {
+		t.Error("unique has no plan modifiers, want UseStateForUnknown")
 	}
 
 	order := resp.Schema.Attributes["columns"].(rschema.ListNestedAttribute).
@@ -0,0 +1,311 @@
+package spanner
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &schemaObjectListResource{}
+	_ list.ListResourceWithConfigure = &schemaObjectListResource{}
+)
+
+// schemaObjectListResource lists the objects of one managed resource type in
+// a database, for terraform query. Each object is reported by the name its
+// resource's ImportState accepts, and — when the full resource is requested —
+// populated by running that ImportState and Read, exactly as an import would.
+type schemaObjectListResource struct {
+	config *internal.ProviderConfig
+
+	// managed builds the resource type being listed.
+	managed func() resource.Resource
+	// kind names the listed objects in descriptions and diagnostics, e.g.
+	// "table indexes".
+	kind string
+	// list returns the fully qualified names of the objects in database.
+	list func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error)
+}
+
+type schemaObjectListModel struct {
+	Project  types.String `tfsdk:"project"`
+	Instance types.String `tfsdk:"instance"`
+	Database types.String `tfsdk:"database"`
+}
+
+// Metadata returns the type name of the listed resource.
+func (l *schemaObjectListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	l.managed().Metadata(ctx, req, resp)
+}
+
+// ListResourceConfigSchema defines the schema of the list block.
+func (l *schemaObjectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"project": listschema.StringAttribute{
+				Optional:    true,
+				Description: "The Google Cloud project ID. Defaults to the provider's project.",
+			},
+			"instance": listschema.StringAttribute{
+				Optional:    true,
+				Description: "The Spanner instance ID. Defaults to the provider's instance.",
+			},
+			"database": listschema.StringAttribute{
+				Optional:    true,
+				Description: "The Spanner database ID. Defaults to the provider's database.",
+			},
+		},
+		MarkdownDescription: "Lists the " + l.kind + " of a Spanner database.",
+	}
+}
+
+// Configure adds the provider configured client to the list resource.
+func (l *schemaObjectListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	config, ok := configureProviderConfig(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	l.config = config
+}
+
+// List streams the objects of the configured database, at most req.Limit of
+// them.
+func (l *schemaObjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model schemaObjectListModel
+	diags := req.Config.Get(ctx, &model)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if l.config == nil {
+		diags.AddError(
+			"Unconfigured Provider",
+			"Could not list "+l.kind+": the provider has not been configured.",
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	model.Project = withProviderDefault(l.config, "project", model.Project, &diags)
+	model.Instance = withProviderDefault(l.config, "instance", model.Instance, &diags)
+	model.Database = withProviderDefault(l.config, "database", model.Database, &diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	database := names.DatabaseName{
+		Project:  model.Project.ValueString(),
+		Instance: model.Instance.ValueString(),
+		Database: model.Database.ValueString(),
+	}.String()
+
+	objects, err := l.list(ctx, l.config, database)
+	if err != nil {
+		diags.AddError(
+			"Error Listing Resources",
+			"Could not list "+l.kind+" in Database ("+database+"): "+utils.ErrDetail(err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var listed int64
+		for _, name := range objects {
+			if req.Limit > 0 && listed >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = strings.TrimPrefix(name, database+"/")
+			result.Diagnostics.Append(setNameIdentity(ctx, result.Identity, name)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				// An object dropped since it was listed is skipped rather
+				// than reported: it no longer exists to be imported.
+				if !l.read(ctx, req, name, &result) {
+					continue
+				}
+			}
+
+			listed++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// read populates result.Resource by importing name into an empty state and
+// refreshing it through the managed resource. It reports false when the
+// object no longer exists.
+func (l *schemaObjectListResource) read(ctx context.Context, req list.ListRequest, name string, result *list.ListResult) bool {
+	r := l.managed()
+	if withConfigure, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := resource.ConfigureResponse{}
+		withConfigure.Configure(ctx, resource.ConfigureRequest{ProviderData: l.config}, &configureResp)
+		result.Diagnostics.Append(configureResp.Diagnostics...)
+		if result.Diagnostics.HasError() {
+			return true
+		}
+	}
+	withImportState, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		result.Diagnostics.Append(diag.NewErrorDiagnostic(
+			"Unsupported List Resource",
+			"Could not read "+name+": the resource does not support import. Please report this issue to the provider developers.",
+		))
+		return true
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: req.ResourceSchema,
+			Raw:    tftypes.NewValue(req.ResourceSchema.Type().TerraformType(ctx), nil),
+		},
+		Identity: result.Identity,
+	}
+	withImportState.ImportState(ctx, resource.ImportStateRequest{ID: name}, &importResp)
+	result.Diagnostics.Append(importResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return true
+	}
+
+	readResp := resource.ReadResponse{
+		State:    importResp.State,
+		Identity: result.Identity,
+	}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: result.Identity}, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	if result.Diagnostics.HasError() {
+		return true
+	}
+	if readResp.State.Raw.IsNull() {
+		return false
+	}
+
+	result.Resource = &tfsdk.Resource{
+		Schema: req.ResourceSchema,
+		Raw:    readResp.State.Raw,
+	}
+
+	return true
+}
+
+// NewSpannerTableListResource lists the tables of a database.
+func NewSpannerTableListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewSpannerTableResource,
+		kind:    "tables",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			tables, err := config.SpannerService.ListSpannerTables(ctx, database)
+			if err != nil {
+				return nil, err
+			}
+
+			res := make([]string, 0, len(tables))
+			for _, table := range tables {
+				res = append(res, table.GetName())
+			}
+
+			return res, nil
+		},
+	}
+}
+
+// NewSpannerTableIndexListResource lists the secondary indexes of a database.
+func NewSpannerTableIndexListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewSpannerTableIndexResource,
+		kind:    "table indexes",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			return config.SpannerService.ListSpannerTableIndexes(ctx, database)
+		},
+	}
+}
+
+// NewTableForeignKeyListResource lists the foreign key constraints of a
+// database.
+func NewTableForeignKeyListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewTableForeignKeyResource,
+		kind:    "table foreign keys",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			return config.SpannerService.ListSpannerTableForeignKeyConstraints(ctx, database)
+		},
+	}
+}
+
+// NewTableTtlPolicyListResource lists the row deletion policies of a database.
+func NewTableTtlPolicyListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewTableTtlPolicyResource,
+		kind:    "table TTL policies",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			return config.SpannerService.ListSpannerTableRowDeletionPolicies(ctx, database)
+		},
+	}
+}
+
+// NewDatabaseSequenceListResource lists the sequences of a database.
+func NewDatabaseSequenceListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewDatabaseSequenceResource,
+		kind:    "sequences",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			return config.SpannerService.ListSpannerSequences(ctx, database)
+		},
+	}
+}
+
+// NewDatabaseRoleListResource lists the custom roles of a database. The
+// system roles every database has cannot be managed, so they are left out.
+func NewDatabaseRoleListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewDatabaseRoleResource,
+		kind:    "database roles",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			roles, _, err := config.SpannerService.ListDatabaseRoles(ctx, database, 0, "")
+			if err != nil {
+				return nil, err
+			}
+
+			res := make([]string, 0, len(roles))
+			for _, role := range roles {
+				roleName, err := names.ParseDatabaseRole(role.GetName())
+				if err != nil || schema.IsSystemRole(roleName.Role) {
+					continue
+				}
+
+				res = append(res, role.GetName())
+			}
+
+			return res, nil
+		},
+	}
+}
+
+// NewTableIamBindingListResource lists one binding per role holding
+// privileges on a table of a database.
+func NewTableIamBindingListResource() list.ListResource {
+	return &schemaObjectListResource{
+		managed: NewTableIamBindingResource,
+		kind:    "table IAM bindings",
+		list: func(ctx context.Context, config *internal.ProviderConfig, database string) ([]string, error) {
+			return config.SpannerService.ListTableIamBindings(ctx, database)
+		},
+	}
+}
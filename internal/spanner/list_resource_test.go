@@ -0,0 +1,157 @@
+package spanner
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/services"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listTestDatabase = "projects/test-project/instances/test-instance/databases/test-db"
+
+// listRequest builds the request terraform query sends for l against the test
+// database.
+func listRequest(t *testing.T, l list.ListResource, managed resource.Resource, includeResource bool, limit int64) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	configResp := &list.ListResourceSchemaResponse{}
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configResp)
+	require.False(t, configResp.Diagnostics.HasError(), configResp.Diagnostics)
+
+	schemaResp := &resource.SchemaResponse{}
+	managed.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	identityResp := &resource.IdentitySchemaResponse{}
+	managed.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identityResp)
+
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configResp.Schema,
+			Raw: tftypes.NewValue(configResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"project":  tftypes.NewValue(tftypes.String, "test-project"),
+				"instance": tftypes.NewValue(tftypes.String, "test-instance"),
+				"database": tftypes.NewValue(tftypes.String, "test-db"),
+			}),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+}
+
+// configuredListResource returns l configured against fake.
+func configuredListResource(t *testing.T, l list.ListResource, fake *connfake.Fake) list.ListResource {
+	t.Helper()
+
+	resp := &resource.ConfigureResponse{}
+	l.(list.ListResourceWithConfigure).Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &internal.ProviderConfig{SpannerService: services.NewSpannerService(fake)},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	return l
+}
+
+// collect drains the stream.
+func collect(t *testing.T, l list.ListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+
+	stream := &list.ListResultsStream{}
+	l.List(context.Background(), req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// Every list resource must share its managed resource's type name, or the
+// framework rejects the provider at startup.
+func TestListResources_MatchManagedTypeNames(t *testing.T) {
+	for _, pair := range []struct {
+		list    func() list.ListResource
+		managed func() resource.Resource
+	}{
+		{NewSpannerTableListResource, NewSpannerTableResource},
+		{NewSpannerTableIndexListResource, NewSpannerTableIndexResource},
+		{NewTableForeignKeyListResource, NewTableForeignKeyResource},
+		{NewTableTtlPolicyListResource, NewTableTtlPolicyResource},
+		{NewDatabaseSequenceListResource, NewDatabaseSequenceResource},
+		{NewDatabaseRoleListResource, NewDatabaseRoleResource},
+		{NewTableIamBindingListResource, NewTableIamBindingResource},
+	} {
+		req := resource.MetadataRequest{ProviderTypeName: "alis"}
+		listResp, managedResp := &resource.MetadataResponse{}, &resource.MetadataResponse{}
+		pair.list().Metadata(context.Background(), req, listResp)
+		pair.managed().Metadata(context.Background(), req, managedResp)
+
+		assert.Equal(t, managedResp.TypeName, listResp.TypeName)
+		_, ok := pair.managed().(resource.ResourceWithIdentity)
+		assert.True(t, ok, "%s has no identity to list by", managedResp.TypeName)
+	}
+}
+
+// The identity of each result is the import ID, so terraform query can turn
+// it into an import block as-is; system roles are never offered.
+func TestDatabaseRoleListResource_ListsImportIds(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabaseRoles(listTestDatabase, []string{
+		listTestDatabase + "/databaseRoles/analyst",
+		listTestDatabase + "/databaseRoles/public",
+		listTestDatabase + "/databaseRoles/spanner_info_reader",
+		listTestDatabase + "/databaseRoles/writer",
+	})
+	l := configuredListResource(t, NewDatabaseRoleListResource(), fake)
+
+	results := collect(t, l, listRequest(t, l, NewDatabaseRoleResource(), false, 0))
+	require.Len(t, results, 2)
+
+	var names []string
+	for _, result := range results {
+		var name types.String
+		require.False(t, result.Identity.GetAttribute(context.Background(), path.Root("name"), &name).HasError())
+		names = append(names, name.ValueString())
+		assert.True(t, result.Resource.Raw.IsNull(), "the resource is only read when requested")
+	}
+	assert.Equal(t, []string{
+		listTestDatabase + "/databaseRoles/analyst",
+		listTestDatabase + "/databaseRoles/writer",
+	}, names)
+	assert.Equal(t, "databaseRoles/analyst", results[0].DisplayName)
+}
+
+// With include_resource the result carries the state an import would produce,
+// and the limit caps what is read.
+func TestDatabaseRoleListResource_IncludesResource(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabaseRoles(listTestDatabase, []string{
+		listTestDatabase + "/databaseRoles/analyst",
+		listTestDatabase + "/databaseRoles/writer",
+	})
+	l := configuredListResource(t, NewDatabaseRoleListResource(), fake)
+
+	results := collect(t, l, listRequest(t, l, NewDatabaseRoleResource(), true, 1))
+	require.Len(t, results, 1)
+
+	var model databaseRoleModel
+	require.False(t, results[0].Resource.Get(context.Background(), &model).HasError())
+	assert.Equal(t, "test-project", model.Project.ValueString())
+	assert.Equal(t, "test-instance", model.Instance.ValueString())
+	assert.Equal(t, "test-db", model.Database.ValueString())
+	assert.Equal(t, "analyst", model.Role.ValueString())
+}
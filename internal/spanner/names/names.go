@@ -117,6 +117,34 @@ func (n SequenceName) DatabaseName() DatabaseName {
 	return DatabaseName{Project: n.Project, Instance: n.Instance, Database: n.Database}
 }
 
+// BackupScheduleName is
+// projects/{p}/instances/{i}/databases/{d}/backupSchedules/{s}.
+type BackupScheduleName struct {
+	Project        string
+	Instance       string
+	Database       string
+	BackupSchedule string
+}
+
+// ParseBackupSchedule parses a BackupScheduleName; failures wrap
+// ErrInvalidName.
+func ParseBackupSchedule(name string) (BackupScheduleName, error) {
+	ids, err := parseSegments(name, "projects", "instances", "databases", "backupSchedules")
+	if err != nil {
+		return BackupScheduleName{}, err
+	}
+	return BackupScheduleName{Project: ids[0], Instance: ids[1], Database: ids[2], BackupSchedule: ids[3]}, nil
+}
+
+func (n BackupScheduleName) String() string {
+	return fmt.Sprintf("%s/backupSchedules/%s", n.DatabaseName().String(), n.BackupSchedule)
+}
+
+// DatabaseName returns the parent database's name.
+func (n BackupScheduleName) DatabaseName() DatabaseName {
+	return DatabaseName{Project: n.Project, Instance: n.Instance, Database: n.Database}
+}
+
 // DatabaseRoleName is projects/{p}/instances/{i}/databases/{d}/databaseRoles/{r}.
 type DatabaseRoleName struct {
 	Project  string
@@ -143,6 +171,98 @@ func (n DatabaseRoleName) DatabaseName() DatabaseName {
 	return DatabaseName{Project: n.Project, Instance: n.Instance, Database: n.Database}
 }
 
+// DatabaseRoleMemberName is
+// projects/{p}/instances/{i}/databases/{d}/databaseRoles/{r}/members/{m} — the
+// import-ID shape of a role membership: member is granted role.
+type DatabaseRoleMemberName struct {
+	Project  string
+	Instance string
+	Database string
+	Role     string
+	Member   string
+}
+
+// ParseDatabaseRoleMember parses a DatabaseRoleMemberName; failures wrap
+// ErrInvalidName.
+func ParseDatabaseRoleMember(name string) (DatabaseRoleMemberName, error) {
+	ids, err := parseSegments(name, "projects", "instances", "databases", "databaseRoles", "members")
+	if err != nil {
+		return DatabaseRoleMemberName{}, err
+	}
+	return DatabaseRoleMemberName{Project: ids[0], Instance: ids[1], Database: ids[2], Role: ids[3], Member: ids[4]}, nil
+}
+
+func (n DatabaseRoleMemberName) String() string {
+	return fmt.Sprintf("%s/members/%s", n.DatabaseRoleName().String(), n.Member)
+}
+
+// DatabaseRoleName returns the granted role's name.
+func (n DatabaseRoleMemberName) DatabaseRoleName() DatabaseRoleName {
+	return DatabaseRoleName{Project: n.Project, Instance: n.Instance, Database: n.Database, Role: n.Role}
+}
+
+// DatabaseName returns the parent database's name.
+func (n DatabaseRoleMemberName) DatabaseName() DatabaseName {
+	return DatabaseName{Project: n.Project, Instance: n.Instance, Database: n.Database}
+}
+
+// DatabaseIamBindingName is
+// projects/{p}/instances/{i}/databases/{d}/iamBindings/{role}[/conditions/{title}]
+// — the import-ID shape of a database IAM binding. Role is an IAM role such as
+// roles/spanner.databaseRoleUser, so it spans several segments; ConditionTitle
+// is empty for an unconditional binding.
+type DatabaseIamBindingName struct {
+	Project        string
+	Instance       string
+	Database       string
+	Role           string
+	ConditionTitle string
+}
+
+// ParseDatabaseIamBinding parses a DatabaseIamBindingName; failures wrap
+// ErrInvalidName.
+func ParseDatabaseIamBinding(name string) (DatabaseIamBindingName, error) {
+	const form = "projects/{project}/instances/{instance}/databases/{database}/iamBindings/{role}[/conditions/{title}]"
+	database, binding, ok := strings.Cut(name, "/iamBindings/")
+	if !ok {
+		return DatabaseIamBindingName{}, fmt.Errorf("%w: %q must have the form %s", ErrInvalidName, name, form)
+	}
+	databaseName, err := ParseDatabase(database)
+	if err != nil {
+		return DatabaseIamBindingName{}, err
+	}
+	role, title := binding, ""
+	if i := strings.LastIndex(binding, "/conditions/"); i >= 0 {
+		role, title = binding[:i], binding[i+len("/conditions/"):]
+		if title == "" {
+			return DatabaseIamBindingName{}, fmt.Errorf("%w: %q has an empty condition title", ErrInvalidName, name)
+		}
+	}
+	if role == "" {
+		return DatabaseIamBindingName{}, fmt.Errorf("%w: %q has an empty iamBinding role", ErrInvalidName, name)
+	}
+	return DatabaseIamBindingName{
+		Project:        databaseName.Project,
+		Instance:       databaseName.Instance,
+		Database:       databaseName.Database,
+		Role:           role,
+		ConditionTitle: title,
+	}, nil
+}
+
+func (n DatabaseIamBindingName) String() string {
+	name := fmt.Sprintf("%s/iamBindings/%s", n.DatabaseName().String(), n.Role)
+	if n.ConditionTitle != "" {
+		name += "/conditions/" + n.ConditionTitle
+	}
+	return name
+}
+
+// DatabaseName returns the parent database's name.
+func (n DatabaseIamBindingName) DatabaseName() DatabaseName {
+	return DatabaseName{Project: n.Project, Instance: n.Instance, Database: n.Database}
+}
+
 // IndexName is projects/{p}/instances/{i}/databases/{d}/tables/{t}/indexes/{x}.
 type IndexName struct {
 	Project  string
@@ -198,6 +318,43 @@ func (n TableRoleName) TableName() TableName {
 	return TableName{Project: n.Project, Instance: n.Instance, Database: n.Database, Table: n.Table}
 }
 
+// TablePermissionName is
+// projects/{p}/instances/{i}/databases/{d}/tables/{t}/tableRoles/{r}/permissions/{x}
+// — the import-ID shape of a table IAM member: role holds one permission on
+// the table.
+type TablePermissionName struct {
+	Project    string
+	Instance   string
+	Database   string
+	Table      string
+	Role       string
+	Permission string
+}
+
+// ParseTablePermission parses a TablePermissionName; failures wrap
+// ErrInvalidName.
+func ParseTablePermission(name string) (TablePermissionName, error) {
+	ids, err := parseSegments(name, "projects", "instances", "databases", "tables", "tableRoles", "permissions")
+	if err != nil {
+		return TablePermissionName{}, err
+	}
+	return TablePermissionName{Project: ids[0], Instance: ids[1], Database: ids[2], Table: ids[3], Role: ids[4], Permission: ids[5]}, nil
+}
+
+func (n TablePermissionName) String() string {
+	return fmt.Sprintf("%s/permissions/%s", n.TableRoleName().String(), n.Permission)
+}
+
+// TableRoleName returns the name of the role's binding on the table.
+func (n TablePermissionName) TableRoleName() TableRoleName {
+	return TableRoleName{Project: n.Project, Instance: n.Instance, Database: n.Database, Table: n.Table, Role: n.Role}
+}
+
+// TableName returns the parent table's name.
+func (n TablePermissionName) TableName() TableName {
+	return TableName{Project: n.Project, Instance: n.Instance, Database: n.Database, Table: n.Table}
+}
+
 // ForeignKeyName is projects/{p}/instances/{i}/databases/{d}/tables/{t}/constraints/{c}
 // — the import-ID shape of a table foreign-key constraint.
 type ForeignKeyName struct {
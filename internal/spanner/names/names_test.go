@@ -24,10 +24,35 @@ func TestParseAndFormatRoundTrip(t *testing.T) {
 			"sequence", func(s string) (interface{ String() string }, error) { n, err := ParseSequence(s); return n, err },
 			"projects/my-project/instances/my-instance/databases/my-db/sequences/my_sequence",
 		},
+		{
+			"backup schedule", func(s string) (interface{ String() string }, error) { n, err := ParseBackupSchedule(s); return n, err },
+			"projects/my-project/instances/my-instance/databases/my-db/backupSchedules/my-schedule",
+		},
 		{
 			"database role", func(s string) (interface{ String() string }, error) { n, err := ParseDatabaseRole(s); return n, err },
 			"projects/my-project/instances/my-instance/databases/my-db/databaseRoles/my_role",
 		},
+		{
+			"database role member", func(s string) (interface{ String() string }, error) {
+				n, err := ParseDatabaseRoleMember(s)
+				return n, err
+			},
+			"projects/my-project/instances/my-instance/databases/my-db/databaseRoles/my_role/members/my_member",
+		},
+		{
+			"database IAM binding", func(s string) (interface{ String() string }, error) {
+				n, err := ParseDatabaseIamBinding(s)
+				return n, err
+			},
+			"projects/my-project/instances/my-instance/databases/my-db/iamBindings/roles/spanner.fineGrainedAccessUser",
+		},
+		{
+			"conditional database IAM binding", func(s string) (interface{ String() string }, error) {
+				n, err := ParseDatabaseIamBinding(s)
+				return n, err
+			},
+			"projects/my-project/instances/my-instance/databases/my-db/iamBindings/roles/spanner.databaseRoleUser/conditions/analyst role",
+		},
 		{
 			"index", func(s string) (interface{ String() string }, error) { n, err := ParseIndex(s); return n, err },
 			"projects/my-project/instances/my-instance/databases/my-db/tables/my_table/indexes/my_idx",
@@ -36,6 +61,13 @@ func TestParseAndFormatRoundTrip(t *testing.T) {
 			"table role", func(s string) (interface{ String() string }, error) { n, err := ParseTableRole(s); return n, err },
 			"projects/my-project/instances/my-instance/databases/my-db/tables/my_table/tableRoles/my_role",
 		},
+		{
+			"table permission", func(s string) (interface{ String() string }, error) {
+				n, err := ParseTablePermission(s)
+				return n, err
+			},
+			"projects/my-project/instances/my-instance/databases/my-db/tables/my_table/tableRoles/my_role/permissions/SELECT",
+		},
 		{
 			"foreign key", func(s string) (interface{ String() string }, error) { n, err := ParseForeignKey(s); return n, err },
 			"projects/my-project/instances/my-instance/databases/my-db/tables/my_table/constraints/FK_my",
@@ -93,6 +125,17 @@ func TestParseRejectsMalformedNames(t *testing.T) {
 		t.Error("ParseSequence accepted a table name")
 	}
 
+	for _, input := range []string{
+		"projects/p/instances/i/databases/d",
+		"projects/p/instances/i/databases/d/iamBindings/",
+		"projects/p/instances/i/databases/d/iamBindings/roles/x/conditions/",
+		"projects/p/instances/i/iamBindings/roles/x",
+	} {
+		if _, err := ParseDatabaseIamBinding(input); !errors.Is(err, ErrInvalidName) {
+			t.Errorf("ParseDatabaseIamBinding(%q) = %v, want ErrInvalidName", input, err)
+		}
+	}
+
 	// Errors are matchable via the sentinel.
 	_, err := ParseTable("nope")
 	if !errors.Is(err, ErrInvalidName) {
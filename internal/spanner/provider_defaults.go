@@ -0,0 +1,107 @@
+package spanner
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerDefault returns the provider's default for attribute, one of
+// project, instance or database, and whether the provider sets one.
+func providerDefault(config *internal.ProviderConfig, attribute string) (string, bool) {
+	if config == nil {
+		return "", false
+	}
+
+	var value string
+	switch attribute {
+	case "project":
+		value = config.GoogleProjectId
+	case "instance":
+		value = config.SpannerInstance
+	case "database":
+		value = config.SpannerDatabase
+	}
+
+	return value, value != ""
+}
+
+// addMissingProviderDefault reports that attribute is set neither on the
+// resource or data source nor on the provider.
+func addMissingProviderDefault(diags *diag.Diagnostics, attribute string) {
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Missing "+attribute,
+		"The "+attribute+" attribute is not set and the provider has no default "+attribute+". "+
+			"Set "+attribute+" here or in the provider configuration.",
+	)
+}
+
+// applyProviderDefaults plans the provider's default for each of attributes
+// the configuration leaves null, and requires replacement whenever the
+// planned value differs from state — whether the attribute itself changed or
+// the provider default it follows did.
+//
+// This is the resource-level counterpart of an attribute plan modifier: the
+// framework builds each schema once, from an unconfigured resource, so only
+// ModifyPlan ever sees the provider configuration. The attributes therefore
+// carry no RequiresReplace of their own; one would fire on the unknown value
+// the framework plans for a null Computed attribute before this runs.
+func applyProviderDefaults(ctx context.Context, config *internal.ProviderConfig, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attributes ...string) {
+	// Nothing to default on destroy, nor before the provider is configured.
+	if req.Plan.Raw.IsNull() || config == nil {
+		return
+	}
+
+	for _, attribute := range attributes {
+		attributePath := path.Root(attribute)
+
+		var configured, planned types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attributePath, &configured)...)
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, attributePath, &planned)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if configured.IsNull() {
+			value, ok := providerDefault(config, attribute)
+			if !ok {
+				addMissingProviderDefault(&resp.Diagnostics, attribute)
+				continue
+			}
+			planned = types.StringValue(value)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attributePath, planned)...)
+		}
+
+		if req.State.Raw.IsNull() {
+			continue
+		}
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attributePath, &prior)...)
+		if !planned.Equal(prior) {
+			resp.RequiresReplace = append(resp.RequiresReplace, attributePath)
+		}
+	}
+}
+
+// withProviderDefault returns value, or the provider's default for attribute
+// when value is null. Data sources and list resources read their attributes
+// once, so they resolve defaults directly rather than through a plan.
+func withProviderDefault(config *internal.ProviderConfig, attribute string, value types.String, diags *diag.Diagnostics) types.String {
+	if !value.IsNull() {
+		return value
+	}
+
+	defaultValue, ok := providerDefault(config, attribute)
+	if !ok {
+		addMissingProviderDefault(diags, attribute)
+		return value
+	}
+
+	return types.StringValue(defaultValue)
+}
@@ -0,0 +1,235 @@
+package spanner
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// objectOf builds a raw value of s with the given attributes set and every
+// other attribute null; nil attributes build a null object.
+func objectOf(t *testing.T, s rschema.Schema, attributes map[string]attr.Value) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if attributes == nil {
+		return state.Raw
+	}
+	for name, value := range attributes {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	return state.Raw
+}
+
+func TestApplyProviderDefaults(t *testing.T) {
+	provider := &internal.ProviderConfig{GoogleProjectId: "p", SpannerInstance: "default-instance", SpannerDatabase: "db"}
+	role := func(instance types.String) map[string]attr.Value {
+		return map[string]attr.Value{
+			"project":  types.StringNull(),
+			"instance": instance,
+			"database": types.StringValue("db"),
+			"role":     types.StringValue("analyst"),
+		}
+	}
+
+	tests := []struct {
+		name string
+		// provider is the provider configuration; nil when unset.
+		provider *internal.ProviderConfig
+		// config, plan and state are the role's instance attribute; a nil
+		// state is a create.
+		config, plan types.String
+		state        *types.String
+		wantInstance types.String
+		wantReplace  bool
+		wantError    bool
+	}{
+		{
+			name:         "create takes the provider default",
+			provider:     provider,
+			config:       types.StringNull(),
+			plan:         types.StringUnknown(),
+			wantInstance: types.StringValue("default-instance"),
+		},
+		{
+			name:         "create keeps the configured override",
+			provider:     provider,
+			config:       types.StringValue("other"),
+			plan:         types.StringValue("other"),
+			wantInstance: types.StringValue("other"),
+		},
+		{
+			name:         "create without any instance",
+			provider:     &internal.ProviderConfig{GoogleProjectId: "p"},
+			config:       types.StringNull(),
+			plan:         types.StringUnknown(),
+			wantInstance: types.StringUnknown(),
+			wantError:    true,
+		},
+		{
+			name:         "unchanged default",
+			provider:     provider,
+			config:       types.StringNull(),
+			plan:         types.StringValue("default-instance"),
+			state:        new(types.StringValue("default-instance")),
+			wantInstance: types.StringValue("default-instance"),
+		},
+		{
+			name:         "changed default replaces",
+			provider:     provider,
+			config:       types.StringNull(),
+			plan:         types.StringValue("old-instance"),
+			state:        new(types.StringValue("old-instance")),
+			wantInstance: types.StringValue("default-instance"),
+			wantReplace:  true,
+		},
+		{
+			// The framework plans a null Computed attribute as unknown
+			// whenever anything else in the resource changes.
+			name:         "unknown plan of an unchanged default",
+			provider:     provider,
+			config:       types.StringNull(),
+			plan:         types.StringUnknown(),
+			state:        new(types.StringValue("default-instance")),
+			wantInstance: types.StringValue("default-instance"),
+		},
+		{
+			name:         "override dropped in favour of a different default replaces",
+			provider:     provider,
+			config:       types.StringNull(),
+			plan:         types.StringValue("other"),
+			state:        new(types.StringValue("other")),
+			wantInstance: types.StringValue("default-instance"),
+			wantReplace:  true,
+		},
+		{
+			name:         "changed override replaces",
+			provider:     provider,
+			config:       types.StringValue("other"),
+			plan:         types.StringValue("other"),
+			state:        new(types.StringValue("default-instance")),
+			wantInstance: types.StringValue("other"),
+			wantReplace:  true,
+		},
+		{
+			name:         "override known only after apply replaces",
+			provider:     provider,
+			config:       types.StringUnknown(),
+			plan:         types.StringUnknown(),
+			state:        new(types.StringValue("default-instance")),
+			wantInstance: types.StringUnknown(),
+			wantReplace:  true,
+		},
+		{
+			name:         "unconfigured provider leaves the plan alone",
+			config:       types.StringNull(),
+			plan:         types.StringUnknown(),
+			wantInstance: types.StringUnknown(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &databaseRoleResource{config: tt.provider}
+			s := resourceSchema(t, r)
+
+			planned := role(tt.plan)
+			planned["project"] = types.StringValue("p")
+			var prior map[string]attr.Value
+			if tt.state != nil {
+				prior = role(*tt.state)
+				prior["project"] = types.StringValue("p")
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: objectOf(t, s, role(tt.config))},
+				Plan:   tfsdk.Plan{Schema: s, Raw: objectOf(t, s, planned)},
+				State:  tfsdk.State{Schema: s, Raw: objectOf(t, s, prior)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("diagnostics = %v, want error %t", resp.Diagnostics, tt.wantError)
+			}
+			var instance, project types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("instance"), &instance)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("project"), &project)...)
+			if !instance.Equal(tt.wantInstance) {
+				t.Errorf("planned instance = %s, want %s", instance, tt.wantInstance)
+			}
+			if !project.Equal(types.StringValue("p")) {
+				t.Errorf("planned project = %s, want the provider's p", project)
+			}
+			if got := resp.RequiresReplace.Contains(path.Root("instance")); got != tt.wantReplace {
+				t.Errorf("instance requires replace = %t, want %t", got, tt.wantReplace)
+			}
+			for _, replaced := range resp.RequiresReplace {
+				if !replaced.Equal(path.Root("instance")) {
+					t.Errorf("unexpected replace of %s", replaced)
+				}
+			}
+		})
+	}
+}
+
+// Destroy plans have nothing to default, even without any provider default
+// to fall back to.
+func TestApplyProviderDefaults_Destroy(t *testing.T) {
+	r := &databaseRoleResource{config: &internal.ProviderConfig{}}
+	s := resourceSchema(t, r)
+	state := objectOf(t, s, map[string]attr.Value{
+		"project":  types.StringValue("p"),
+		"instance": types.StringValue("i"),
+		"database": types.StringValue("db"),
+		"role":     types.StringValue("analyst"),
+	})
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: objectOf(t, s, nil)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: objectOf(t, s, nil)},
+		State:  tfsdk.State{Schema: s, Raw: state},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() || len(resp.RequiresReplace) != 0 {
+		t.Errorf("got diagnostics %v and replaces %v, want neither", resp.Diagnostics, resp.RequiresReplace)
+	}
+}
+
+func TestWithProviderDefault(t *testing.T) {
+	provider := &internal.ProviderConfig{GoogleProjectId: "p", SpannerInstance: "i"}
+
+	var diags diag.Diagnostics
+	if got := withProviderDefault(provider, "instance", types.StringValue("other"), &diags); !got.Equal(types.StringValue("other")) {
+		t.Errorf("configured instance = %s, want other", got)
+	}
+	if got := withProviderDefault(provider, "instance", types.StringNull(), &diags); !got.Equal(types.StringValue("i")) {
+		t.Errorf("defaulted instance = %s, want i", got)
+	}
+	if got := withProviderDefault(provider, "project", types.StringNull(), &diags); !got.Equal(types.StringValue("p")) {
+		t.Errorf("defaulted project = %s, want p", got)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	withProviderDefault(provider, "database", types.StringNull(), &diags)
+	if !diags.HasError() {
+		t.Error("a database set neither on the data source nor on the provider must be an error")
+	}
+}
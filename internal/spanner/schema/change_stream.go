@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// SpannerChangeStreamTable is one table a change stream watches.
+type SpannerChangeStreamTable struct {
+	// The table id.
+	Table string
+	// The non-key columns watched. Nil watches every column; an empty,
+	// non-nil slice watches the primary key only, rendered as Table().
+	Columns []string
+}
+
+// SpannerChangeStreamOptions holds the OPTIONS clause settings for a change
+// stream. Unset options are left to the Spanner defaults.
+type SpannerChangeStreamOptions struct {
+	// How long change records are kept, e.g. 36h or 7d.
+	RetentionPeriod *wrapperspb.StringValue
+	// What a change record captures: OLD_AND_NEW_VALUES, NEW_VALUES,
+	// NEW_ROW, or NEW_ROW_AND_OLD_VALUES.
+	ValueCaptureType *wrapperspb.StringValue
+	// Whether TTL deletes are left out of the stream.
+	ExcludeTtlDeletes *wrapperspb.BoolValue
+	// Whether inserts are left out of the stream.
+	ExcludeInsert *wrapperspb.BoolValue
+	// Whether updates are left out of the stream.
+	ExcludeUpdate *wrapperspb.BoolValue
+	// Whether deletes are left out of the stream.
+	ExcludeDelete *wrapperspb.BoolValue
+	// Whether transactions may opt out of the stream.
+	AllowTxnExclusion *wrapperspb.BoolValue
+}
+
+// ddl renders the set options in declaration order.
+func (o *SpannerChangeStreamOptions) ddl() []string {
+	if o == nil {
+		return nil
+	}
+
+	var options []string
+	if o.RetentionPeriod != nil {
+		options = append(options, fmt.Sprintf("retention_period = '%s'", o.RetentionPeriod.GetValue()))
+	}
+	if o.ValueCaptureType != nil {
+		options = append(options, fmt.Sprintf("value_capture_type = '%s'", o.ValueCaptureType.GetValue()))
+	}
+	for _, option := range []struct {
+		name  string
+		value *wrapperspb.BoolValue
+	}{
+		{"exclude_ttl_deletes", o.ExcludeTtlDeletes},
+		{"exclude_insert", o.ExcludeInsert},
+		{"exclude_update", o.ExcludeUpdate},
+		{"exclude_delete", o.ExcludeDelete},
+		{"allow_txn_exclusion", o.AllowTxnExclusion},
+	} {
+		if option.value != nil {
+			options = append(options, option.name+" = "+strconv.FormatBool(option.value.GetValue()))
+		}
+	}
+
+	return options
+}
+
+// SpannerChangeStream represents a Spanner change stream. A stream with
+// neither All nor Tables set watches nothing until altered.
+type SpannerChangeStream struct {
+	// The name of the change stream.
+	Name string
+	// Whether the stream watches every table, FOR ALL. Exclusive with
+	// Tables.
+	All bool
+	// The tables the stream watches.
+	Tables []*SpannerChangeStreamTable
+	// The options for the change stream.
+	Options *SpannerChangeStreamOptions
+}
+
+// CreateDdl renders the CREATE CHANGE STREAM statement.
+func (c *SpannerChangeStream) CreateDdl() (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	if c.Name == "" {
+		return "", errors.New("change stream name is required")
+	}
+	if c.All && len(c.Tables) > 0 {
+		return "", fmt.Errorf("change stream %s cannot watch all tables and a table list", c.Name)
+	}
+
+	ddl := fmt.Sprintf("CREATE CHANGE STREAM `%s`", c.Name)
+	switch {
+	case c.All:
+		ddl += " FOR ALL"
+	case len(c.Tables) > 0:
+		tables := make([]string, 0, len(c.Tables))
+		for _, table := range c.Tables {
+			if table.Table == "" {
+				return "", fmt.Errorf("table is required for change stream %s", c.Name)
+			}
+
+			watched := fmt.Sprintf("`%s`", table.Table)
+			if table.Columns != nil {
+				columns := make([]string, 0, len(table.Columns))
+				for _, column := range table.Columns {
+					columns = append(columns, fmt.Sprintf("`%s`", column))
+				}
+				watched += "(" + strings.Join(columns, ", ") + ")"
+			}
+			tables = append(tables, watched)
+		}
+		ddl += " FOR " + strings.Join(tables, ", ")
+	}
+
+	if options := c.Options.ddl(); len(options) > 0 {
+		ddl += " OPTIONS (" + strings.Join(options, ", ") + ")"
+	}
+
+	return ddl, nil
+}
+
+// DropChangeStreamDdl renders the DROP CHANGE STREAM statement.
+func DropChangeStreamDdl(name string) string {
+	return fmt.Sprintf("DROP CHANGE STREAM `%s`", name)
+}
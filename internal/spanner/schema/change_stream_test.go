@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSpannerChangeStream_CreateDdl(t *testing.T) {
+	tests := []struct {
+		name    string
+		stream  *SpannerChangeStream
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "watchesNothing",
+			stream: &SpannerChangeStream{Name: "idle"},
+			want:   "CREATE CHANGE STREAM `idle`",
+		},
+		{
+			name: "forAllWithOptions",
+			stream: &SpannerChangeStream{Name: "all", All: true, Options: &SpannerChangeStreamOptions{
+				RetentionPeriod:   wrapperspb.String("7d"),
+				ExcludeTtlDeletes: wrapperspb.Bool(true),
+			}},
+			want: "CREATE CHANGE STREAM `all` FOR ALL OPTIONS (retention_period = '7d', exclude_ttl_deletes = true)",
+		},
+		{
+			name: "keyColumnsOnly",
+			stream: &SpannerChangeStream{Name: "keys", Tables: []*SpannerChangeStreamTable{
+				{Table: "orders", Columns: []string{}},
+				{Table: "items"},
+			}},
+			want: "CREATE CHANGE STREAM `keys` FOR `orders`(), `items`",
+		},
+		{
+			name:    "allAndTables",
+			stream:  &SpannerChangeStream{Name: "both", All: true, Tables: []*SpannerChangeStreamTable{{Table: "orders"}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.stream.CreateDdl()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateDdl() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CreateDdl() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := DropChangeStreamDdl("keys"); got != "DROP CHANGE STREAM `keys`" {
+		t.Errorf("DropChangeStreamDdl() = %q", got)
+	}
+}
@@ -40,6 +40,33 @@ func PreserveUnsetBooleans(prior, hydrated []*SpannerTableColumn) {
 	}
 }
 
+// PreserveUnsetKeyOrdering is the primary-key counterpart of
+// PreserveUnsetBooleans. INFORMATION_SCHEMA reports a position and an order
+// for every key column; where the prior state left them unset and the
+// hydrated value is what the unset form resolves to (ASC, and the position
+// the prior columns implied), they collapse back to unset. Any other
+// hydrated value is real drift and survives.
+func PreserveUnsetKeyOrdering(prior, hydrated []*SpannerTableColumn) {
+	priorByName := make(map[string]*SpannerTableColumn, len(prior))
+	for _, c := range prior {
+		priorByName[c.Name] = c
+	}
+	priorPositions := keyPositions(prior)
+
+	for _, h := range hydrated {
+		p, ok := priorByName[h.Name]
+		if !ok || !p.PrimaryKey() || !h.PrimaryKey() {
+			continue
+		}
+		if p.GetKeyPosition() == nil && h.GetKeyPosition().GetValue() == priorPositions[h.Name] {
+			h.KeyPosition = nil
+		}
+		if p.GetKeyOrder() == SpannerTableIndexColumnOrder_UNSPECIFIED && h.GetKeyOrder() == SpannerTableIndexColumnOrder_ASC {
+			h.KeyOrder = SpannerTableIndexColumnOrder_UNSPECIFIED
+		}
+	}
+}
+
 // SpannerTableColumn represents a Spanner table column.
 type SpannerTableColumn struct {
 	// The name of the column.
@@ -78,6 +105,15 @@ type SpannerTableColumn struct {
 	// Required for PROTO columns. The proto bundle carrying the message must
 	// already exist in the database; the provider does not manage bundles.
 	ProtoPackage *wrapperspb.StringValue
+	// The 1-based position of the column within the primary key.
+	//
+	// Only valid for primary key columns. Unset columns fill the positions
+	// no other column claims, in declaration order.
+	KeyPosition *wrapperspb.Int64Value
+	// The sort order of the column within the primary key.
+	//
+	// Only valid for primary key columns. UNSPECIFIED renders as ASC.
+	KeyOrder SpannerTableIndexColumnOrder
 }
 
 func (c *SpannerTableColumn) GetName() string {
@@ -168,6 +204,32 @@ func (c *SpannerTableColumn) GetProtoPackage() *wrapperspb.StringValue {
 	return c.ProtoPackage
 }
 
+func (c *SpannerTableColumn) GetKeyPosition() *wrapperspb.Int64Value {
+	if c == nil {
+		return nil
+	}
+
+	return c.KeyPosition
+}
+
+func (c *SpannerTableColumn) GetKeyOrder() SpannerTableIndexColumnOrder {
+	if c == nil {
+		return SpannerTableIndexColumnOrder_UNSPECIFIED
+	}
+
+	return c.KeyOrder
+}
+
+// effectiveKeyOrder resolves UNSPECIFIED to ASC, the order Spanner applies
+// when the PRIMARY KEY clause names none.
+func (c *SpannerTableColumn) effectiveKeyOrder() SpannerTableIndexColumnOrder {
+	if c.GetKeyOrder() == SpannerTableIndexColumnOrder_UNSPECIFIED {
+		return SpannerTableIndexColumnOrder_ASC
+	}
+
+	return c.GetKeyOrder()
+}
+
 // PrimaryKey returns true if the column is a primary key.
 func (c *SpannerTableColumn) PrimaryKey() bool {
 	return c.GetIsPrimaryKey() != nil && c.GetIsPrimaryKey().GetValue()
@@ -456,5 +518,9 @@ func (c *SpannerTableColumn) compare(other *SpannerTableColumn) bool {
 		return false
 	}
 
+	if c.PrimaryKey() && c.effectiveKeyOrder() != other.effectiveKeyOrder() {
+		return false
+	}
+
 	return true
 }
@@ -1,6 +1,10 @@
 package schema
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // ColumnChangeClass classifies how a planned column differs from its prior state.
 type ColumnChangeClass int
@@ -56,6 +60,11 @@ func ClassifyColumnChange(prior, planned *SpannerTableColumn) (ColumnChangeClass
 		return ColumnRequiresReplace, fmt.Sprintf("Column %q has a changed primary key status and requires a table replace", name)
 	}
 
+	// Unset and ASC render the same key, so only the effective order counts.
+	if planned.PrimaryKey() && prior.effectiveKeyOrder() != planned.effectiveKeyOrder() {
+		return ColumnRequiresReplace, fmt.Sprintf("Column %q has a changed key_order and requires a table replace", name)
+	}
+
 	// A changed computation on a computed column, or disabling is_computed, cannot
 	// be altered in place. Enabling is_computed on an existing column is
 	// deliberately classified as alterable, not a replace.
@@ -83,3 +92,37 @@ func ClassifyColumnChange(prior, planned *SpannerTableColumn) (ColumnChangeClass
 
 	return ColumnAlterable, ""
 }
+
+// ClassifyPrimaryKeyChange reports whether the key column sequence differs
+// between two column lists that hold the same primary-key columns. Set
+// changes (an added or removed key column) are ClassifyColumnChange's to
+// report per column; this catches the reorder that no single column shows,
+// whether it comes from key_position or from moving a declaration.
+func ClassifyPrimaryKeyChange(prior, planned []*SpannerTableColumn) (ColumnChangeClass, string) {
+	priorKey := (&SpannerTableSchema{Columns: prior}).PrimaryKey()
+	plannedKey := (&SpannerTableSchema{Columns: planned}).PrimaryKey()
+	if len(priorKey) != len(plannedKey) {
+		return ColumnUnchanged, ""
+	}
+
+	priorNames := make([]string, len(priorKey))
+	plannedNames := make([]string, len(plannedKey))
+	for i := range priorKey {
+		priorNames[i] = priorKey[i].GetName()
+		plannedNames[i] = plannedKey[i].GetName()
+	}
+
+	if slices.Equal(priorNames, plannedNames) {
+		return ColumnUnchanged, ""
+	}
+
+	sortedPrior, sortedPlanned := slices.Sorted(slices.Values(priorNames)), slices.Sorted(slices.Values(plannedNames))
+	if !slices.Equal(sortedPrior, sortedPlanned) {
+		return ColumnUnchanged, ""
+	}
+
+	return ColumnRequiresReplace, fmt.Sprintf(
+		"Primary key order changed from (%s) to (%s) and requires a table replace",
+		strings.Join(priorNames, ", "), strings.Join(plannedNames, ", "),
+	)
+}
@@ -68,6 +68,10 @@ func TestClassifyColumnChange(t *testing.T) {
 		c.DefaultValue = wrapperspb.String(v)
 		return c
 	}
+	withKeyOrder := func(c *SpannerTableColumn, order SpannerTableIndexColumnOrder) *SpannerTableColumn {
+		c.KeyOrder = order
+		return c
+	}
 
 	tests := []struct {
 		name       string
@@ -233,6 +237,22 @@ func TestClassifyColumnChange(t *testing.T) {
 			"",
 		},
 
+		// Primary key order
+		{
+			"key_order flip on a key column requires replace",
+			pkColumn(),
+			withKeyOrder(pkColumn(), SpannerTableIndexColumnOrder_DESC),
+			ColumnRequiresReplace,
+			`Column "user_id" has a changed key_order and requires a table replace`,
+		},
+		{
+			"key_order unset vs asc is unchanged",
+			pkColumn(),
+			withKeyOrder(pkColumn(), SpannerTableIndexColumnOrder_ASC),
+			ColumnUnchanged,
+			"",
+		},
+
 		// Multiple rules firing: class wins, reason is the first rule in closure order (type first)
 		{
 			"type and is_stored both changed requires replace",
@@ -283,3 +303,69 @@ func TestSpannerTableColumnCompare_IsStored(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyPrimaryKeyChange(t *testing.T) {
+	key := func(name string, position int64) *SpannerTableColumn {
+		c := &SpannerTableColumn{Name: name, Type: "STRING(36)", IsPrimaryKey: wrapperspb.Bool(true)}
+		if position > 0 {
+			c.KeyPosition = wrapperspb.Int64(position)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		prior      []*SpannerTableColumn
+		planned    []*SpannerTableColumn
+		wantClass  ColumnChangeClass
+		wantReason string
+	}{
+		{
+			"same declaration order",
+			[]*SpannerTableColumn{key("tenant_id", 0), key("create_time", 0), plainColumn()},
+			[]*SpannerTableColumn{key("tenant_id", 0), plainColumn(), key("create_time", 0)},
+			ColumnUnchanged,
+			"",
+		},
+		{
+			"explicit positions matching declaration order",
+			[]*SpannerTableColumn{key("tenant_id", 0), key("create_time", 0)},
+			[]*SpannerTableColumn{key("tenant_id", 1), key("create_time", 2)},
+			ColumnUnchanged,
+			"",
+		},
+		{
+			"key_position reorders the key",
+			[]*SpannerTableColumn{key("tenant_id", 0), key("create_time", 0)},
+			[]*SpannerTableColumn{key("tenant_id", 2), key("create_time", 1)},
+			ColumnRequiresReplace,
+			"Primary key order changed from (tenant_id, create_time) to (create_time, tenant_id) and requires a table replace",
+		},
+		{
+			"moved declaration reorders the key",
+			[]*SpannerTableColumn{key("tenant_id", 0), key("create_time", 0)},
+			[]*SpannerTableColumn{key("create_time", 0), key("tenant_id", 0)},
+			ColumnRequiresReplace,
+			"Primary key order changed from (tenant_id, create_time) to (create_time, tenant_id) and requires a table replace",
+		},
+		{
+			"changed key set is left to per-column classification",
+			[]*SpannerTableColumn{key("tenant_id", 0), key("create_time", 0)},
+			[]*SpannerTableColumn{key("tenant_id", 0), key("event_id", 0)},
+			ColumnUnchanged,
+			"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotClass, gotReason := ClassifyPrimaryKeyChange(tc.prior, tc.planned)
+			if gotClass != tc.wantClass {
+				t.Errorf("ClassifyPrimaryKeyChange() class = %v, want %v", gotClass, tc.wantClass)
+			}
+			if gotReason != tc.wantReason {
+				t.Errorf("ClassifyPrimaryKeyChange() reason = %q, want %q", gotReason, tc.wantReason)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package schema
 import (
 	"errors"
 	"fmt"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // SpannerTableForeignKeyConstraint represents a single-column foreign key:
@@ -18,6 +20,20 @@ type SpannerTableForeignKeyConstraint struct {
 	Column string
 	// Referential actions on delete
 	OnDelete SpannerTableConstraintAction
+	// Whether Spanner validates the constraint on writes. Unset means
+	// enforced; false renders NOT ENFORCED, leaving the key as an optimizer
+	// hint only.
+	Enforced *wrapperspb.BoolValue
+}
+
+// IsEnforced reports whether the constraint is enforced, treating an unset
+// value as the Spanner default.
+func (c *SpannerTableForeignKeyConstraint) IsEnforced() bool {
+	if c == nil || c.Enforced == nil {
+		return true
+	}
+
+	return c.Enforced.GetValue()
 }
 
 // SpannerTableConstraintAction is the referential action a constraint or
@@ -56,7 +72,8 @@ var SpannerTableConstraintActions = []string{
 }
 
 // CreateDdl renders the ADD CONSTRAINT ... FOREIGN KEY statement for the
-// constraint on the given table, with an ON DELETE clause when an action is set.
+// constraint on the given table, with an ON DELETE clause when an action is set
+// and a NOT ENFORCED clause when the constraint is informational.
 func (c *SpannerTableForeignKeyConstraint) CreateDdl(table string) (string, error) {
 	if c == nil {
 		return "", nil
@@ -82,6 +99,9 @@ func (c *SpannerTableForeignKeyConstraint) CreateDdl(table string) (string, erro
 	if c.OnDelete != SpannerTableConstraintActionUnspecified {
 		ddl += " ON DELETE " + c.OnDelete.String()
 	}
+	if !c.IsEnforced() {
+		ddl += " NOT ENFORCED"
+	}
 	return ddl, nil
 }
 
@@ -1,6 +1,10 @@
 package schema
 
-import "testing"
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
 
 func TestSpannerTableForeignKeyConstraintDdl(t *testing.T) {
 	t.Run("CreateDdl without action", func(t *testing.T) {
@@ -32,6 +36,37 @@ func TestSpannerTableForeignKeyConstraintDdl(t *testing.T) {
 		}
 	})
 
+	t.Run("CreateDdl NOT ENFORCED follows ON DELETE", func(t *testing.T) {
+		c := &SpannerTableForeignKeyConstraint{
+			Name:             "FK_orders_user",
+			Column:           "user_id",
+			ReferencedTable:  "users",
+			ReferencedColumn: "id",
+			OnDelete:         SpannerTableConstraintNoAction,
+			Enforced:         wrapperspb.Bool(false),
+		}
+		got, err := c.CreateDdl("orders")
+		want := "ALTER TABLE `orders` ADD CONSTRAINT `FK_orders_user` FOREIGN KEY (`user_id`) REFERENCES users(`id`) ON DELETE NO ACTION NOT ENFORCED"
+		if err != nil || got != want {
+			t.Errorf("CreateDdl() = (%q, %v), want %q", got, err, want)
+		}
+	})
+
+	t.Run("CreateDdl explicitly enforced renders no clause", func(t *testing.T) {
+		c := &SpannerTableForeignKeyConstraint{
+			Name:             "FK_orders_user",
+			Column:           "user_id",
+			ReferencedTable:  "users",
+			ReferencedColumn: "id",
+			Enforced:         wrapperspb.Bool(true),
+		}
+		got, err := c.CreateDdl("orders")
+		want := "ALTER TABLE `orders` ADD CONSTRAINT `FK_orders_user` FOREIGN KEY (`user_id`) REFERENCES users(`id`)"
+		if err != nil || got != want {
+			t.Errorf("CreateDdl() = (%q, %v), want %q", got, err, want)
+		}
+	})
+
 	t.Run("DropForeignKeyConstraintDdl", func(t *testing.T) {
 		if got := DropForeignKeyConstraintDdl("orders", "FK_orders_user"); got != "ALTER TABLE `orders` DROP CONSTRAINT `FK_orders_user`" {
 			t.Errorf("DropForeignKeyConstraintDdl() = %q", got)
@@ -0,0 +1,163 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Database option names as they appear in ALTER DATABASE and
+// INFORMATION_SCHEMA.DATABASE_OPTIONS.
+const (
+	DatabaseOptionVersionRetentionPeriod     = "version_retention_period"
+	DatabaseOptionOptimizerVersion           = "optimizer_version"
+	DatabaseOptionOptimizerStatisticsPackage = "optimizer_statistics_package"
+	DatabaseOptionDefaultLeader              = "default_leader"
+	DatabaseOptionDefaultSequenceKind        = "default_sequence_kind"
+	DatabaseOptionDefaultTimeZone            = "default_time_zone"
+)
+
+// DatabaseOptions lists every option SpannerDatabaseOptions manages, in the
+// order statements render them.
+var DatabaseOptions = []string{
+	DatabaseOptionVersionRetentionPeriod,
+	DatabaseOptionOptimizerVersion,
+	DatabaseOptionOptimizerStatisticsPackage,
+	DatabaseOptionDefaultLeader,
+	DatabaseOptionDefaultSequenceKind,
+	DatabaseOptionDefaultTimeZone,
+}
+
+// SpannerDatabaseOptions holds the database-level settings applied with
+// ALTER DATABASE. A nil field is an option this value says nothing about.
+type SpannerDatabaseOptions struct {
+	// How long Spanner retains old versions of data, e.g. "7d".
+	VersionRetentionPeriod *wrapperspb.StringValue
+	// The query optimizer version.
+	OptimizerVersion *wrapperspb.Int64Value
+	// The optimizer statistics package queries use.
+	OptimizerStatisticsPackage *wrapperspb.StringValue
+	// The leader region of a multi-region database.
+	DefaultLeader *wrapperspb.StringValue
+	// The sequence kind used when a sequence does not name one.
+	DefaultSequenceKind *wrapperspb.StringValue
+	// The time zone used by timestamp functions without an explicit zone.
+	DefaultTimeZone *wrapperspb.StringValue
+}
+
+func (o *SpannerDatabaseOptions) GetVersionRetentionPeriod() *wrapperspb.StringValue {
+	if o == nil {
+		return nil
+	}
+
+	return o.VersionRetentionPeriod
+}
+
+func (o *SpannerDatabaseOptions) GetOptimizerVersion() *wrapperspb.Int64Value {
+	if o == nil {
+		return nil
+	}
+
+	return o.OptimizerVersion
+}
+
+func (o *SpannerDatabaseOptions) GetOptimizerStatisticsPackage() *wrapperspb.StringValue {
+	if o == nil {
+		return nil
+	}
+
+	return o.OptimizerStatisticsPackage
+}
+
+func (o *SpannerDatabaseOptions) GetDefaultLeader() *wrapperspb.StringValue {
+	if o == nil {
+		return nil
+	}
+
+	return o.DefaultLeader
+}
+
+func (o *SpannerDatabaseOptions) GetDefaultSequenceKind() *wrapperspb.StringValue {
+	if o == nil {
+		return nil
+	}
+
+	return o.DefaultSequenceKind
+}
+
+func (o *SpannerDatabaseOptions) GetDefaultTimeZone() *wrapperspb.StringValue {
+	if o == nil {
+		return nil
+	}
+
+	return o.DefaultTimeZone
+}
+
+// literals renders each set option as a SQL literal, keyed by option name.
+// escapedQuote is how the dialect writes a quote inside a string literal.
+func (o *SpannerDatabaseOptions) literals(escapedQuote string) map[string]string {
+	literals := map[string]string{}
+	strOption := func(name string, v *wrapperspb.StringValue) {
+		if v != nil {
+			literals[name] = "'" + strings.ReplaceAll(v.GetValue(), "'", escapedQuote) + "'"
+		}
+	}
+
+	strOption(DatabaseOptionVersionRetentionPeriod, o.GetVersionRetentionPeriod())
+	if v := o.GetOptimizerVersion(); v != nil {
+		literals[DatabaseOptionOptimizerVersion] = fmt.Sprintf("%d", v.GetValue())
+	}
+	strOption(DatabaseOptionOptimizerStatisticsPackage, o.GetOptimizerStatisticsPackage())
+	strOption(DatabaseOptionDefaultLeader, o.GetDefaultLeader())
+	strOption(DatabaseOptionDefaultSequenceKind, o.GetDefaultSequenceKind())
+	strOption(DatabaseOptionDefaultTimeZone, o.GetDefaultTimeZone())
+
+	return literals
+}
+
+// AlterDdl renders the Google-standard-SQL ALTER DATABASE ... SET OPTIONS
+// statement that sets every non-nil option and resets each option named in
+// reset to its default. It returns no statements when there is nothing to
+// change.
+func (o *SpannerDatabaseOptions) AlterDdl(databaseId string, reset []string) []string {
+	literals := o.literals(`\'`)
+	for _, name := range reset {
+		if _, ok := literals[name]; !ok {
+			literals[name] = "NULL"
+		}
+	}
+
+	var options []string
+	for _, name := range DatabaseOptions {
+		if literal, ok := literals[name]; ok {
+			options = append(options, name+" = "+literal)
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("ALTER DATABASE `%s` SET OPTIONS (%s)", databaseId, strings.Join(options, ", "))}
+}
+
+// AlterPostgresDdl renders the PostgreSQL equivalent of AlterDdl. That
+// dialect sets one spanner.* parameter per statement and resets with RESET.
+func (o *SpannerDatabaseOptions) AlterPostgresDdl(databaseId string, reset []string) []string {
+	literals := o.literals("''")
+	resets := map[string]bool{}
+	for _, name := range reset {
+		resets[name] = true
+	}
+
+	var statements []string
+	for _, name := range DatabaseOptions {
+		if literal, ok := literals[name]; ok {
+			statements = append(statements, fmt.Sprintf("ALTER DATABASE %q SET spanner.%s = %s", databaseId, name, literal))
+		} else if resets[name] {
+			statements = append(statements, fmt.Sprintf("ALTER DATABASE %q RESET spanner.%s", databaseId, name))
+		}
+	}
+
+	return statements
+}
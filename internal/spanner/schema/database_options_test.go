@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func Test_SpannerDatabaseOptions_AlterDdl(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *SpannerDatabaseOptions
+		reset   []string
+		want    []string
+	}{
+		{
+			name: "setsInDeclarationOrder",
+			options: &SpannerDatabaseOptions{
+				DefaultTimeZone:        wrapperspb.String("America/New_York"),
+				OptimizerVersion:       wrapperspb.Int64(6),
+				VersionRetentionPeriod: wrapperspb.String("7d"),
+			},
+			want: []string{
+				"ALTER DATABASE `my-db` SET OPTIONS (version_retention_period = '7d', optimizer_version = 6, default_time_zone = 'America/New_York')",
+			},
+		},
+		{
+			name:    "resetsToNull",
+			options: &SpannerDatabaseOptions{DefaultLeader: wrapperspb.String("us-east1")},
+			reset:   []string{DatabaseOptionOptimizerVersion},
+			want: []string{
+				"ALTER DATABASE `my-db` SET OPTIONS (optimizer_version = NULL, default_leader = 'us-east1')",
+			},
+		},
+		{
+			name:    "setValueWinsOverReset",
+			options: &SpannerDatabaseOptions{OptimizerVersion: wrapperspb.Int64(5)},
+			reset:   []string{DatabaseOptionOptimizerVersion},
+			want: []string{
+				"ALTER DATABASE `my-db` SET OPTIONS (optimizer_version = 5)",
+			},
+		},
+		{
+			name:    "escapesQuotes",
+			options: &SpannerDatabaseOptions{OptimizerStatisticsPackage: wrapperspb.String("it's")},
+			want: []string{
+				"ALTER DATABASE `my-db` SET OPTIONS (optimizer_statistics_package = 'it\\'s')",
+			},
+		},
+		{
+			name: "nothingToChange",
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.AlterDdl("my-db", tt.reset); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AlterDdl() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_SpannerDatabaseOptions_AlterPostgresDdl(t *testing.T) {
+	options := &SpannerDatabaseOptions{
+		VersionRetentionPeriod: wrapperspb.String("3d"),
+		DefaultSequenceKind:    wrapperspb.String("bit_reversed_positive"),
+	}
+
+	got := options.AlterPostgresDdl("my-db", []string{DatabaseOptionDefaultLeader})
+	want := []string{
+		`ALTER DATABASE "my-db" SET spanner.version_retention_period = '3d'`,
+		`ALTER DATABASE "my-db" RESET spanner.default_leader`,
+		`ALTER DATABASE "my-db" SET spanner.default_sequence_kind = 'bit_reversed_positive'`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AlterPostgresDdl() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,214 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SpannerDatabaseSchema is the set of schema objects a list of DDL
+// statements declares, keyed the way Spanner resolves names: table, index
+// and constraint names compare case-insensitively.
+type SpannerDatabaseSchema struct {
+	// The tables, in declaration order. Names are fully qualified.
+	Tables []*SpannerTable
+	// The secondary indexes, in declaration order.
+	Indexes []*SpannerSchemaIndex
+	// The foreign keys, whether declared inline or with ALTER TABLE.
+	ForeignKeys []*SpannerSchemaForeignKey
+	// The row deletion policies, one per table at most.
+	RowDeletionPolicies []*SpannerSchemaRowDeletionPolicy
+	// The sequences, named by sequence id.
+	Sequences []*SpannerSequence
+	// The database role ids.
+	Roles []string
+	// The change streams.
+	ChangeStreams []*SpannerChangeStream
+	// The views.
+	Views []*SpannerView
+	// Statements of kinds the schema does not model (grants, proto bundles,
+	// search indexes and the like), verbatim. For a live schema read with
+	// ParseLiveDdl, also the statements using constructs it cannot represent.
+	Unmanaged []string
+	// For a live schema read with ParseLiveDdl, the ids of the tables whose
+	// CREATE TABLE is in Unmanaged.
+	UnmanagedTables []string
+}
+
+// SpannerSchemaIndex is a secondary index and the table it is defined on.
+type SpannerSchemaIndex struct {
+	// The table id the index is defined on.
+	Table string
+	// The index.
+	Index *SpannerTableIndex
+}
+
+// SpannerSchemaForeignKey is a foreign key and the table that declares it.
+type SpannerSchemaForeignKey struct {
+	// The referencing table id.
+	Table string
+	// The constraint.
+	Constraint *SpannerTableForeignKeyConstraint
+}
+
+// SpannerSchemaRowDeletionPolicy is a TTL policy and the table it applies to.
+type SpannerSchemaRowDeletionPolicy struct {
+	// The table id the policy applies to.
+	Table string
+	// The policy.
+	Policy *SpannerTableRowDeletionPolicy
+}
+
+// Table returns the table whose id matches tableId, or nil.
+func (s *SpannerDatabaseSchema) Table(tableId string) *SpannerTable {
+	if s == nil {
+		return nil
+	}
+
+	for _, table := range s.Tables {
+		if strings.EqualFold(table.GetTableId(), tableId) {
+			return table
+		}
+	}
+
+	return nil
+}
+
+// RowDeletionPolicy returns the policy on the table tableId, or nil.
+func (s *SpannerDatabaseSchema) RowDeletionPolicy(tableId string) *SpannerTableRowDeletionPolicy {
+	if s == nil {
+		return nil
+	}
+
+	for _, policy := range s.RowDeletionPolicies {
+		if strings.EqualFold(policy.Table, tableId) {
+			return policy.Policy
+		}
+	}
+
+	return nil
+}
+
+// append adds the objects of other to s, after its own.
+func (s *SpannerDatabaseSchema) append(other *SpannerDatabaseSchema) {
+	s.Tables = append(s.Tables, other.Tables...)
+	s.Indexes = append(s.Indexes, other.Indexes...)
+	s.ForeignKeys = append(s.ForeignKeys, other.ForeignKeys...)
+	s.RowDeletionPolicies = append(s.RowDeletionPolicies, other.RowDeletionPolicies...)
+	s.Sequences = append(s.Sequences, other.Sequences...)
+	s.Roles = append(s.Roles, other.Roles...)
+	s.ChangeStreams = append(s.ChangeStreams, other.ChangeStreams...)
+	s.Views = append(s.Views, other.Views...)
+	s.Unmanaged = append(s.Unmanaged, other.Unmanaged...)
+	s.UnmanagedTables = append(s.UnmanagedTables, other.UnmanagedTables...)
+}
+
+// IsUnmanagedTable reports whether tableId is one of UnmanagedTables.
+func (s *SpannerDatabaseSchema) IsUnmanagedTable(tableId string) bool {
+	if s == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(s.UnmanagedTables, func(unmanaged string) bool {
+		return strings.EqualFold(unmanaged, tableId)
+	})
+}
+
+// unmanageDependents moves the indexes, foreign keys and row deletion
+// policies on an unmanaged table to Unmanaged, rendered as DDL. A foreign
+// key referencing an unmanaged table moves too.
+func (s *SpannerDatabaseSchema) unmanageDependents() error {
+	if len(s.UnmanagedTables) == 0 {
+		return nil
+	}
+
+	indexes := s.Indexes[:0]
+	for _, index := range s.Indexes {
+		if !s.IsUnmanagedTable(index.Table) {
+			indexes = append(indexes, index)
+			continue
+		}
+		ddl, err := index.Index.CreateDdl(index.Table)
+		if err != nil {
+			return err
+		}
+		s.Unmanaged = append(s.Unmanaged, ddl)
+	}
+	s.Indexes = indexes
+
+	foreignKeys := s.ForeignKeys[:0]
+	for _, fk := range s.ForeignKeys {
+		if !s.IsUnmanagedTable(fk.Table) && !s.IsUnmanagedTable(fk.Constraint.ReferencedTable) {
+			foreignKeys = append(foreignKeys, fk)
+			continue
+		}
+		ddl, err := fk.Constraint.CreateDdl(fk.Table)
+		if err != nil {
+			return err
+		}
+		s.Unmanaged = append(s.Unmanaged, ddl)
+	}
+	s.ForeignKeys = foreignKeys
+
+	policies := s.RowDeletionPolicies[:0]
+	for _, policy := range s.RowDeletionPolicies {
+		if !s.IsUnmanagedTable(policy.Table) {
+			policies = append(policies, policy)
+			continue
+		}
+		ddl, err := policy.Policy.CreateDdl(policy.Table)
+		if err != nil {
+			return err
+		}
+		s.Unmanaged = append(s.Unmanaged, ddl)
+	}
+	s.RowDeletionPolicies = policies
+
+	return nil
+}
+
+// schemaKey is the case-insensitive lookup key for a schema object name.
+func schemaKey(parts ...string) string {
+	return strings.ToLower(strings.Join(parts, "."))
+}
+
+// UndiffedObjects describes the declared objects AlterDdl does not diff —
+// sequences, roles, change streams and views — followed by the unmanaged
+// statements, for callers that must reject rather than ignore them.
+func (s *SpannerDatabaseSchema) UndiffedObjects() []string {
+	if s == nil {
+		return nil
+	}
+
+	var objects []string
+	for _, sequence := range s.Sequences {
+		objects = append(objects, fmt.Sprintf("sequence %q", sequence.GetName()))
+	}
+	for _, role := range s.Roles {
+		objects = append(objects, fmt.Sprintf("role %q", role))
+	}
+	for _, changeStream := range s.ChangeStreams {
+		objects = append(objects, fmt.Sprintf("change stream %q", changeStream.Name))
+	}
+	for _, view := range s.Views {
+		objects = append(objects, fmt.Sprintf("view %q", view.Name))
+	}
+	for _, statement := range s.Unmanaged {
+		objects = append(objects, fmt.Sprintf("statement %q", statement))
+	}
+
+	return objects
+}
+
+// CreateDdl renders the statements that create the objects AlterDdl diffs
+// from nothing: tables parents first, then row deletion policies, indexes
+// and foreign keys. Sequences, roles, change streams, views and unmanaged
+// statements are not included.
+func (s *SpannerDatabaseSchema) CreateDdl() ([]string, error) {
+	migration, err := s.AlterDdl(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return migration.Statements, nil
+}
@@ -0,0 +1,368 @@
+package schema
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SchemaWarning is a user-facing note about a destructive migration step.
+type SchemaWarning struct {
+	Summary string
+	Detail  string
+}
+
+// SchemaMigration is the ordered DDL batch that moves one schema to another,
+// with a warning for every step that loses data.
+type SchemaMigration struct {
+	// The statements, in an order Spanner accepts as a single batch.
+	Statements []string
+	// Table replaces and dropped tables or columns.
+	Warnings []SchemaWarning
+}
+
+// AlterDdl diffs the schema against existing and renders the migration
+// that makes existing match it. The diff is object-aware: tables that exist
+// on both sides migrate with SpannerTable.AlterDdl, and a change
+// ClassifyColumnChange or ClassifyPrimaryKeyChange reports as needing a
+// replace drops and recreates the table, taking its interleaved children
+// with it. Indexes and foreign keys cannot be altered in place and are
+// dropped and recreated when they differ. Sequences, roles, change streams,
+// views and unmanaged statements play no part in the diff, nor does a table
+// existing lists in UnmanagedTables or any object on it.
+//
+// The batch runs in dependency order: foreign keys, indexes and row
+// deletion policies are dropped first, then tables (children before
+// parents), then tables are created (parents first) and altered, and
+// finally row deletion policies, indexes and foreign keys are added.
+func (s *SpannerDatabaseSchema) AlterDdl(existing *SpannerDatabaseSchema) (*SchemaMigration, error) {
+	migration := &SchemaMigration{}
+	if s == nil {
+		s = &SpannerDatabaseSchema{}
+	}
+	if existing == nil {
+		existing = &SpannerDatabaseSchema{}
+	}
+
+	// Decide the fate of every existing table. Together, dropped and
+	// replaced hold the tables whose current incarnation goes away.
+	dropped := map[string]bool{}
+	replaced := map[string]bool{}
+	for _, table := range existing.Tables {
+		key := schemaKey(table.GetTableId())
+		desired := s.Table(table.GetTableId())
+		if desired == nil {
+			dropped[key] = true
+			migration.Warnings = append(migration.Warnings, SchemaWarning{
+				Summary: fmt.Sprintf("Table %q will be dropped", table.GetTableId()),
+				Detail:  fmt.Sprintf("Table %q is not declared in the desired schema; dropping it permanently deletes its data", table.GetTableId()),
+			})
+			continue
+		}
+		for _, reason := range tableReplaceReasons(table, desired) {
+			replaced[key] = true
+			migration.Warnings = append(migration.Warnings, SchemaWarning{
+				Summary: fmt.Sprintf("Table %q requires a replace", table.GetTableId()),
+				Detail:  reason,
+			})
+		}
+	}
+
+	// Interleaved children cannot outlive their parent's current
+	// incarnation.
+	for changed := true; changed; {
+		changed = false
+		for _, table := range existing.Tables {
+			key := schemaKey(table.GetTableId())
+			parent := schemaKey(table.GetInterleave().GetParentTable())
+			if dropped[key] || replaced[key] || table.GetInterleave() == nil || !(dropped[parent] || replaced[parent]) {
+				continue
+			}
+			changed = true
+			if s.Table(table.GetTableId()) == nil {
+				dropped[key] = true
+				migration.Warnings = append(migration.Warnings, SchemaWarning{
+					Summary: fmt.Sprintf("Table %q will be dropped", table.GetTableId()),
+					Detail:  fmt.Sprintf("Table %q is not declared in the desired schema; dropping it permanently deletes its data", table.GetTableId()),
+				})
+			} else {
+				replaced[key] = true
+				migration.Warnings = append(migration.Warnings, SchemaWarning{
+					Summary: fmt.Sprintf("Table %q requires a replace", table.GetTableId()),
+					Detail: fmt.Sprintf("Table %q is interleaved in %q, which is being dropped or replaced, and requires a table replace",
+						table.GetTableId(), table.GetInterleave().GetParentTable()),
+				})
+			}
+		}
+	}
+	removed := func(table string) bool {
+		return dropped[schemaKey(table)] || replaced[schemaKey(table)]
+	}
+
+	// Foreign keys touching a removed table, or no longer declared as they
+	// are, are dropped.
+	desiredForeignKeys := map[string]*SpannerSchemaForeignKey{}
+	for _, fk := range s.ForeignKeys {
+		desiredForeignKeys[schemaKey(fk.Table, fk.Constraint.Name)] = fk
+	}
+	existingForeignKeys := map[string]*SpannerSchemaForeignKey{}
+	for _, fk := range existing.ForeignKeys {
+		key := schemaKey(fk.Table, fk.Constraint.Name)
+		desired, ok := desiredForeignKeys[key]
+		if ok && !removed(fk.Table) && !removed(fk.Constraint.ReferencedTable) && foreignKeysEqual(fk, desired) {
+			existingForeignKeys[key] = fk
+			continue
+		}
+		migration.Statements = append(migration.Statements, DropForeignKeyConstraintDdl(fk.Table, fk.Constraint.Name))
+	}
+
+	// Likewise indexes.
+	desiredIndexes := map[string]*SpannerSchemaIndex{}
+	for _, index := range s.Indexes {
+		desiredIndexes[schemaKey(index.Index.Name)] = index
+	}
+	existingIndexes := map[string]*SpannerSchemaIndex{}
+	for _, index := range existing.Indexes {
+		key := schemaKey(index.Index.Name)
+		desired, ok := desiredIndexes[key]
+		if ok && !removed(index.Table) && indexesEqual(index, desired) {
+			existingIndexes[key] = index
+			continue
+		}
+		migration.Statements = append(migration.Statements, DropIndexDdl(index.Index.Name))
+	}
+
+	// Row deletion policies on surviving tables that no longer declare one.
+	for _, policy := range existing.RowDeletionPolicies {
+		if !removed(policy.Table) && s.RowDeletionPolicy(policy.Table) == nil {
+			migration.Statements = append(migration.Statements, DropRowDeletionPolicyDdl(policy.Table))
+		}
+	}
+
+	// Drop tables, children first.
+	for _, table := range byInterleaveDepth(existing, true) {
+		if removed(table.GetTableId()) {
+			ddl, err := table.DeleteDdl()
+			if err != nil {
+				return nil, err
+			}
+			migration.Statements = append(migration.Statements, ddl)
+		}
+	}
+
+	// Create new and replaced tables, parents first, and alter the rest.
+	var alters []string
+	for _, table := range byInterleaveDepth(s, false) {
+		if existing.IsUnmanagedTable(table.GetTableId()) {
+			continue
+		}
+		current := existing.Table(table.GetTableId())
+		if current == nil || removed(table.GetTableId()) {
+			ddl, err := table.CreateDdl()
+			if err != nil {
+				return nil, err
+			}
+			migration.Statements = append(migration.Statements, ddl)
+			continue
+		}
+
+		statements, droppedColumns, err := table.AlterDdl(current)
+		if err != nil {
+			return nil, err
+		}
+		alters = append(alters, statements...)
+		for _, column := range droppedColumns {
+			migration.Warnings = append(migration.Warnings, SchemaWarning{
+				Summary: fmt.Sprintf("Column %q will be dropped", column.GetName()),
+				Detail: fmt.Sprintf("Column %q of table %q is not declared in the desired schema; dropping it permanently deletes its data",
+					column.GetName(), table.GetTableId()),
+			})
+		}
+	}
+	migration.Statements = append(migration.Statements, alters...)
+
+	// Add or replace row deletion policies.
+	for _, policy := range s.RowDeletionPolicies {
+		if existing.IsUnmanagedTable(policy.Table) {
+			continue
+		}
+		current := existing.RowDeletionPolicy(policy.Table)
+		var ddl string
+		var err error
+		switch {
+		case current == nil || removed(policy.Table):
+			ddl, err = policy.Policy.CreateDdl(policy.Table)
+		case !rowDeletionPoliciesEqual(current, policy.Policy):
+			ddl, err = policy.Policy.ReplaceDdl(policy.Table)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		migration.Statements = append(migration.Statements, ddl)
+	}
+
+	// Create indexes, then foreign keys, that do not already exist as
+	// declared.
+	for _, index := range s.Indexes {
+		if _, ok := existingIndexes[schemaKey(index.Index.Name)]; ok || existing.IsUnmanagedTable(index.Table) {
+			continue
+		}
+		ddl, err := index.Index.CreateDdl(index.Table)
+		if err != nil {
+			return nil, err
+		}
+		migration.Statements = append(migration.Statements, ddl)
+	}
+	for _, fk := range s.ForeignKeys {
+		if _, ok := existingForeignKeys[schemaKey(fk.Table, fk.Constraint.Name)]; ok ||
+			existing.IsUnmanagedTable(fk.Table) || existing.IsUnmanagedTable(fk.Constraint.ReferencedTable) {
+			continue
+		}
+		ddl, err := fk.Constraint.CreateDdl(fk.Table)
+		if err != nil {
+			return nil, err
+		}
+		migration.Statements = append(migration.Statements, ddl)
+	}
+
+	return migration, nil
+}
+
+// tableReplaceReasons reports why desired cannot be reached from existing
+// with ALTER statements, one sentence per reason; nil means it can. Columns
+// pair by name and are judged by ClassifyColumnChange, the same rules the
+// table resource plans with.
+func tableReplaceReasons(existing, desired *SpannerTable) []string {
+	var reasons []string
+
+	existingColumns := existing.GetSchema().GetColumns()
+	desiredColumns := desired.GetSchema().GetColumns()
+	names := map[string]bool{}
+	for _, column := range existingColumns {
+		names[column.GetName()] = true
+	}
+	for _, column := range desiredColumns {
+		names[column.GetName()] = true
+	}
+	column := func(columns []*SpannerTableColumn, name string) *SpannerTableColumn {
+		for _, c := range columns {
+			if c.GetName() == name {
+				return c
+			}
+		}
+		return nil
+	}
+	for _, name := range slices.Sorted(maps.Keys(names)) {
+		if class, reason := ClassifyColumnChange(column(existingColumns, name), column(desiredColumns, name)); class == ColumnRequiresReplace {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if class, reason := ClassifyPrimaryKeyChange(existingColumns, desiredColumns); class == ColumnRequiresReplace {
+		reasons = append(reasons, reason)
+	}
+
+	if !interleavesEqual(existing.GetInterleave(), desired.GetInterleave()) {
+		reasons = append(reasons, fmt.Sprintf("Table %q has a changed interleave and requires a table replace", desired.GetTableId()))
+	}
+
+	return reasons
+}
+
+// byInterleaveDepth returns the schema's tables ordered by how deeply they
+// are interleaved, parents first or, when childrenFirst is set, children
+// first. Ties keep declaration order.
+func byInterleaveDepth(s *SpannerDatabaseSchema, childrenFirst bool) []*SpannerTable {
+	depth := func(table *SpannerTable) int {
+		d := 0
+		// The bound guards against interleave cycles in malformed input.
+		for parent := table.GetInterleave(); parent != nil && d <= len(s.Tables); d++ {
+			parent = s.Table(parent.GetParentTable()).GetInterleave()
+		}
+		return d
+	}
+
+	tables := slices.Clone(s.Tables)
+	sort.SliceStable(tables, func(i, j int) bool {
+		if childrenFirst {
+			return depth(tables[i]) > depth(tables[j])
+		}
+		return depth(tables[i]) < depth(tables[j])
+	})
+
+	return tables
+}
+
+// effectiveOnDelete resolves an unspecified action to NO ACTION, Spanner's
+// default for both interleaves and foreign keys.
+func effectiveOnDelete(action SpannerTableConstraintAction) SpannerTableConstraintAction {
+	if action == SpannerTableConstraintActionUnspecified {
+		return SpannerTableConstraintNoAction
+	}
+
+	return action
+}
+
+func interleavesEqual(a, b *SpannerTableInterleave) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return strings.EqualFold(a.GetParentTable(), b.GetParentTable()) &&
+		effectiveOnDelete(a.GetOnDelete()) == effectiveOnDelete(b.GetOnDelete())
+}
+
+func indexesEqual(a, b *SpannerSchemaIndex) bool {
+	ai, bi := a.Index, b.Index
+	if !strings.EqualFold(a.Table, b.Table) ||
+		ai.Unique.GetValue() != bi.Unique.GetValue() ||
+		ai.NullFiltered.GetValue() != bi.NullFiltered.GetValue() ||
+		!strings.EqualFold(ai.InterleaveIn, bi.InterleaveIn) ||
+		len(ai.Columns) != len(bi.Columns) {
+		return false
+	}
+
+	for i := range ai.Columns {
+		if !strings.EqualFold(ai.Columns[i].Name, bi.Columns[i].Name) ||
+			effectiveIndexOrder(ai.Columns[i].Order) != effectiveIndexOrder(bi.Columns[i].Order) {
+			return false
+		}
+	}
+
+	storing := func(columns []string) []string {
+		keys := make([]string, 0, len(columns))
+		for _, column := range columns {
+			keys = append(keys, schemaKey(column))
+		}
+		return slices.Sorted(slices.Values(keys))
+	}
+
+	return slices.Equal(storing(ai.Storing), storing(bi.Storing))
+}
+
+func effectiveIndexOrder(order SpannerTableIndexColumnOrder) SpannerTableIndexColumnOrder {
+	if order == SpannerTableIndexColumnOrder_UNSPECIFIED {
+		return SpannerTableIndexColumnOrder_ASC
+	}
+
+	return order
+}
+
+func foreignKeysEqual(a, b *SpannerSchemaForeignKey) bool {
+	ac, bc := a.Constraint, b.Constraint
+
+	return strings.EqualFold(a.Table, b.Table) &&
+		strings.EqualFold(ac.Column, bc.Column) &&
+		strings.EqualFold(ac.ReferencedTable, bc.ReferencedTable) &&
+		strings.EqualFold(ac.ReferencedColumn, bc.ReferencedColumn) &&
+		effectiveOnDelete(ac.OnDelete) == effectiveOnDelete(bc.OnDelete) &&
+		ac.IsEnforced() == bc.IsEnforced()
+}
+
+func rowDeletionPoliciesEqual(a, b *SpannerTableRowDeletionPolicy) bool {
+	return strings.EqualFold(a.Column, b.Column) && a.Duration.GetValue() == b.Duration.GetValue()
+}
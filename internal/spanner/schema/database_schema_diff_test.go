@@ -0,0 +1,214 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func mustParseDdl(t *testing.T, statements ...string) *SpannerDatabaseSchema {
+	t.Helper()
+
+	s, err := ParseDdl(testDatabase, statements...)
+	if err != nil {
+		t.Fatalf("ParseDdl() error = %v", err)
+	}
+
+	return s
+}
+
+func warningSummaries(migration *SchemaMigration) []string {
+	var summaries []string
+	for _, warning := range migration.Warnings {
+		summaries = append(summaries, warning.Summary)
+	}
+
+	return summaries
+}
+
+// The live schema comes back from GetDatabaseDdl in Spanner's own spelling;
+// a desired schema written differently but meaning the same must not diff.
+func Test_SpannerDatabaseSchema_AlterDdl_EquivalentSpellings(t *testing.T) {
+	live := mustParseDdl(t,
+		"CREATE TABLE Singers (\n  SingerId INT64 NOT NULL,\n  Name STRING(MAX),\n) PRIMARY KEY(SingerId)",
+		"CREATE TABLE Albums (\n  SingerId INT64 NOT NULL,\n  AlbumId INT64 NOT NULL,\n) PRIMARY KEY(SingerId, AlbumId),\n  INTERLEAVE IN PARENT Singers",
+		"CREATE INDEX SingersByName ON Singers(Name)",
+		"CREATE ROLE analyst",
+	)
+	desired := mustParseDdl(t, "create table `singers` (`SingerId` int64 not null, `Name` string(max)) primary key (`SingerId` asc);"+
+		"CREATE TABLE Albums (SingerId INT64 NOT NULL, AlbumId INT64 NOT NULL) PRIMARY KEY (SingerId, AlbumId), INTERLEAVE IN PARENT Singers ON DELETE NO ACTION;"+
+		"CREATE INDEX singersbyname ON singers (Name ASC)")
+
+	got, err := desired.AlterDdl(live)
+	if err != nil {
+		t.Fatalf("AlterDdl() error = %v", err)
+	}
+	if len(got.Statements) != 0 || len(got.Warnings) != 0 {
+		t.Errorf("AlterDdl() = %q, warnings %v; want no change", got.Statements, got.Warnings)
+	}
+}
+
+func Test_SpannerDatabaseSchema_AlterDdl_AltersInPlace(t *testing.T) {
+	live := mustParseDdl(t,
+		"CREATE TABLE users (id STRING(36) NOT NULL, name STRING(100), legacy BOOL) PRIMARY KEY (id)",
+		"CREATE INDEX users_by_name ON users (name)",
+	)
+	desired := mustParseDdl(t,
+		"CREATE TABLE users (id STRING(36) NOT NULL, name STRING(200), email STRING(MAX)) PRIMARY KEY (id)",
+		"CREATE INDEX users_by_name ON users (name DESC)",
+		"CREATE TABLE posts (id INT64 NOT NULL, author STRING(36)) PRIMARY KEY (id)",
+		"ALTER TABLE posts ADD CONSTRAINT posts_author FOREIGN KEY (author) REFERENCES users (id)",
+		"ALTER TABLE users ADD ROW DELETION POLICY (OLDER_THAN(id, INTERVAL 7 DAY))",
+	)
+
+	got, err := desired.AlterDdl(live)
+	if err != nil {
+		t.Fatalf("AlterDdl() error = %v", err)
+	}
+
+	want := []string{
+		"DROP INDEX users_by_name",
+		"CREATE TABLE `posts` (`id` INT64 NOT NULL, `author` STRING(36)) PRIMARY KEY (`id`)",
+		"ALTER TABLE `users` DROP COLUMN `legacy`",
+		"ALTER TABLE `users` ADD COLUMN `email` STRING(MAX)",
+		"ALTER TABLE `users` ALTER COLUMN `name` STRING(200)",
+		"ALTER TABLE users ADD ROW DELETION POLICY (OLDER_THAN(id, INTERVAL 7 DAY))",
+		"CREATE  INDEX users_by_name ON users (name DESC)",
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_author` FOREIGN KEY (`author`) REFERENCES users(`id`)",
+	}
+	if !reflect.DeepEqual(got.Statements, want) {
+		t.Errorf("AlterDdl() statements =\n%q\nwant\n%q", got.Statements, want)
+	}
+	if want := []string{`Column "legacy" will be dropped`}; !reflect.DeepEqual(warningSummaries(got), want) {
+		t.Errorf("AlterDdl() warnings = %q, want %q", warningSummaries(got), want)
+	}
+}
+
+// A change the table resource would plan as a replace drops and recreates
+// the table here too, taking interleaved children, indexes and foreign keys
+// that depend on it along.
+func Test_SpannerDatabaseSchema_AlterDdl_ReplacesTable(t *testing.T) {
+	live := mustParseDdl(t,
+		"CREATE TABLE parents (id INT64 NOT NULL, name STRING(MAX)) PRIMARY KEY (id)",
+		"CREATE TABLE children (id INT64 NOT NULL, child_id INT64 NOT NULL) PRIMARY KEY (id, child_id), INTERLEAVE IN PARENT parents ON DELETE CASCADE",
+		"CREATE INDEX parents_by_name ON parents (name)",
+		"CREATE TABLE refs (id INT64 NOT NULL, parent_id INT64, CONSTRAINT refs_parent FOREIGN KEY (parent_id) REFERENCES parents (id)) PRIMARY KEY (id)",
+		"CREATE TABLE unused (id INT64 NOT NULL) PRIMARY KEY (id)",
+	)
+	desired := mustParseDdl(t,
+		"CREATE TABLE parents (id INT64 NOT NULL, name BYTES(MAX)) PRIMARY KEY (id)",
+		"CREATE TABLE children (id INT64 NOT NULL, child_id INT64 NOT NULL) PRIMARY KEY (id, child_id), INTERLEAVE IN PARENT parents ON DELETE CASCADE",
+		"CREATE INDEX parents_by_name ON parents (name)",
+		"CREATE TABLE refs (id INT64 NOT NULL, parent_id INT64, CONSTRAINT refs_parent FOREIGN KEY (parent_id) REFERENCES parents (id)) PRIMARY KEY (id)",
+	)
+
+	got, err := desired.AlterDdl(live)
+	if err != nil {
+		t.Fatalf("AlterDdl() error = %v", err)
+	}
+
+	want := []string{
+		"ALTER TABLE `refs` DROP CONSTRAINT `refs_parent`",
+		"DROP INDEX parents_by_name",
+		"DROP TABLE `children`",
+		"DROP TABLE `parents`",
+		"DROP TABLE `unused`",
+		"CREATE TABLE `parents` (`id` INT64 NOT NULL, `name` BYTES(MAX)) PRIMARY KEY (`id`)",
+		"CREATE TABLE `children` (`id` INT64 NOT NULL, `child_id` INT64 NOT NULL) PRIMARY KEY (`id`, `child_id`), INTERLEAVE IN PARENT parents ON DELETE CASCADE",
+		"CREATE  INDEX parents_by_name ON parents (name ASC)",
+		"ALTER TABLE `refs` ADD CONSTRAINT `refs_parent` FOREIGN KEY (`parent_id`) REFERENCES parents(`id`)",
+	}
+	if !reflect.DeepEqual(got.Statements, want) {
+		t.Errorf("AlterDdl() statements =\n%q\nwant\n%q", got.Statements, want)
+	}
+
+	wantWarnings := []SchemaWarning{
+		{Summary: `Table "parents" requires a replace`, Detail: `Column "name" has a changed type and requires a table replace`},
+		{Summary: `Table "unused" will be dropped`, Detail: `Table "unused" is not declared in the desired schema; dropping it permanently deletes its data`},
+		{
+			Summary: `Table "children" requires a replace`,
+			Detail:  `Table "children" is interleaved in "parents", which is being dropped or replaced, and requires a table replace`,
+		},
+	}
+	if !reflect.DeepEqual(got.Warnings, wantWarnings) {
+		t.Errorf("AlterDdl() warnings = %v, want %v", got.Warnings, wantWarnings)
+	}
+}
+
+func Test_SpannerDatabaseSchema_AlterDdl_PrimaryKeyReorder(t *testing.T) {
+	live := mustParseDdl(t, "CREATE TABLE t (a INT64 NOT NULL, b INT64 NOT NULL) PRIMARY KEY (a, b)")
+	desired := mustParseDdl(t, "CREATE TABLE t (a INT64 NOT NULL, b INT64 NOT NULL) PRIMARY KEY (b, a)")
+
+	got, err := desired.AlterDdl(live)
+	if err != nil {
+		t.Fatalf("AlterDdl() error = %v", err)
+	}
+	if want := []string{"DROP TABLE `t`", "CREATE TABLE `t` (`a` INT64 NOT NULL, `b` INT64 NOT NULL) PRIMARY KEY (`b`, `a`)"}; !reflect.DeepEqual(got.Statements, want) {
+		t.Errorf("AlterDdl() statements = %q, want %q", got.Statements, want)
+	}
+	if want := []string{`Table "t" requires a replace`}; !reflect.DeepEqual(warningSummaries(got), want) {
+		t.Errorf("AlterDdl() warnings = %q, want %q", warningSummaries(got), want)
+	}
+}
+
+// A live table the schema cannot model is left alone, and so is everything
+// on it: dropping its index because the desired schema cannot declare the
+// table would be a silent, unwarned change.
+func Test_SpannerDatabaseSchema_AlterDdl_LeavesUnmanagedTablesAlone(t *testing.T) {
+	live, err := ParseLiveDdl(testDatabase,
+		"CREATE TABLE a (\n  id INT64 NOT NULL,\n  x INT64,\n  CONSTRAINT ck CHECK(x > 0),\n) PRIMARY KEY(id)",
+		"CREATE INDEX a_by_x ON a(x)",
+		"CREATE TABLE b (\n  id INT64 NOT NULL,\n) PRIMARY KEY(id)",
+		"ALTER TABLE b ADD CONSTRAINT b_a FOREIGN KEY(id) REFERENCES a(id)",
+	)
+	if err != nil {
+		t.Fatalf("ParseLiveDdl() error = %v", err)
+	}
+
+	for _, desired := range []*SpannerDatabaseSchema{
+		mustParseDdl(t, "CREATE TABLE b (id INT64 NOT NULL) PRIMARY KEY (id)"),
+		mustParseDdl(t,
+			"CREATE TABLE a (id INT64 NOT NULL, x INT64) PRIMARY KEY (id)",
+			"CREATE INDEX a_by_x ON a (x)",
+			"CREATE TABLE b (id INT64 NOT NULL) PRIMARY KEY (id)",
+			"ALTER TABLE b ADD CONSTRAINT b_a FOREIGN KEY (id) REFERENCES a (id)",
+		),
+	} {
+		got, err := desired.AlterDdl(live)
+		if err != nil {
+			t.Fatalf("AlterDdl() error = %v", err)
+		}
+		if len(got.Statements) != 0 || len(got.Warnings) != 0 {
+			t.Errorf("AlterDdl() = %q, warnings %v; want no change", got.Statements, got.Warnings)
+		}
+	}
+}
+
+// CreateDdl output parses back to a schema that does not diff against the
+// original, so an imported schema plans clean.
+func Test_SpannerDatabaseSchema_CreateDdl_RoundTrips(t *testing.T) {
+	original := mustParseDdl(t,
+		"CREATE TABLE Singers (\n  SingerId INT64 NOT NULL,\n  Name STRING(1024),\n) PRIMARY KEY(SingerId)",
+		"CREATE TABLE Albums (\n  SingerId INT64 NOT NULL,\n  AlbumId INT64 NOT NULL,\n  CreateTime TIMESTAMP OPTIONS (allow_commit_timestamp = true),\n"+
+			"  CONSTRAINT FK_Singer FOREIGN KEY(SingerId) REFERENCES Singers(SingerId) NOT ENFORCED,\n"+
+			") PRIMARY KEY(SingerId, AlbumId DESC),\n  INTERLEAVE IN PARENT Singers ON DELETE CASCADE,\n"+
+			"  ROW DELETION POLICY (OLDER_THAN(CreateTime, INTERVAL 30 DAY))",
+		"CREATE UNIQUE INDEX SingersByName ON Singers(Name) STORING (SingerId)",
+		"CREATE VIEW v SQL SECURITY INVOKER AS SELECT 1",
+	)
+
+	statements, err := original.CreateDdl()
+	if err != nil {
+		t.Fatalf("CreateDdl() error = %v", err)
+	}
+	if len(statements) != 5 {
+		t.Errorf("CreateDdl() = %q, want 5 statements", statements)
+	}
+
+	got, err := mustParseDdl(t, statements...).AlterDdl(original)
+	if err != nil {
+		t.Fatalf("AlterDdl() error = %v", err)
+	}
+	if len(got.Statements) != 0 {
+		t.Errorf("round trip diff = %q, want none", got.Statements)
+	}
+}
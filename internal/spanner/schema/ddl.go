@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// NormalizeDdl reduces a GoogleSQL DDL statement to a canonical spelling:
+// surrounding whitespace and trailing semicolons are dropped and every run
+// of whitespace outside a quoted string or identifier becomes a single
+// space, or vanishes inside parentheses and before a comma. Quoted text
+// is copied verbatim, so two statements normalize alike only when Spanner
+// would read them alike.
+func NormalizeDdl(statement string) string {
+	return normalizeDdl(statement, false)
+}
+
+// NormalizePostgresDdl is NormalizeDdl for the PostgreSQL dialect, where a
+// backslash in quoted text is an ordinary character except inside an E'...'
+// escape string.
+func NormalizePostgresDdl(statement string) string {
+	return normalizeDdl(statement, true)
+}
+
+// normalizeDdl implements NormalizeDdl and, when postgres is set,
+// NormalizePostgresDdl.
+func normalizeDdl(statement string, postgres bool) string {
+	var b strings.Builder
+	var quote, prev rune
+	pendingSpace, escapes, escaped := false, false, false
+	// word counts the identifier characters written since the last other
+	// rune, to tell an E'...' prefix from an identifier ending in E.
+	word := 0
+	for _, r := range strings.TrimRight(strings.TrimSpace(statement), "; \t\r\n") {
+		if quote != 0 {
+			b.WriteRune(r)
+			prev = r
+			switch {
+			case escaped:
+				// An escaped quote does not close the quoted text.
+				escaped = false
+			case r == '\\' && escapes:
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			pendingSpace = true
+			continue
+		case '\'', '"', '`':
+			quote = r
+			escapes = !postgres || (r == '\'' && !pendingSpace && word == 1 && (prev == 'E' || prev == 'e'))
+		}
+		if pendingSpace && prev != '(' && r != ')' && r != ',' {
+			b.WriteByte(' ')
+			word = 0
+		}
+		pendingSpace = false
+		b.WriteRune(r)
+		prev = r
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			word++
+		} else {
+			word = 0
+		}
+	}
+
+	return b.String()
+}
+
+// DdlFingerprint returns the hex SHA-256 of a GoogleSQL database schema: the
+// normalized statements in order, followed by the proto descriptors when
+// there are any. Statement order is part of the fingerprint because
+// GetDatabaseDdl reports it deterministically and it is the order the
+// schema must be replayed in.
+func DdlFingerprint(statements []string, protoDescriptors []byte) string {
+	return ddlFingerprint(NormalizeDdl, statements, protoDescriptors)
+}
+
+// PostgresDdlFingerprint is DdlFingerprint for a PostgreSQL database,
+// normalizing with NormalizePostgresDdl.
+func PostgresDdlFingerprint(statements []string, protoDescriptors []byte) string {
+	return ddlFingerprint(NormalizePostgresDdl, statements, protoDescriptors)
+}
+
+func ddlFingerprint(normalize func(string) string, statements []string, protoDescriptors []byte) string {
+	h := sha256.New()
+	for _, statement := range statements {
+		h.Write([]byte(normalize(statement)))
+		h.Write([]byte{';', '\n'})
+	}
+	if len(protoDescriptors) > 0 {
+		h.Write([]byte{0})
+		h.Write(protoDescriptors)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,90 @@
+package schema
+
+import "testing"
+
+func Test_NormalizeDdl(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      string
+	}{
+		{
+			name:      "collapsesWhitespace",
+			statement: "CREATE TABLE users (\n  id INT64 NOT NULL,\n\tname STRING(MAX)\n) PRIMARY KEY(id)",
+			want:      "CREATE TABLE users (id INT64 NOT NULL, name STRING(MAX)) PRIMARY KEY(id)",
+		},
+		{
+			name:      "dropsTrailingSemicolon",
+			statement: "  CREATE INDEX idx ON users(name);\n",
+			want:      "CREATE INDEX idx ON users(name)",
+		},
+		{
+			name:      "keepsQuotedWhitespace",
+			statement: "ALTER TABLE `my  table` ALTER COLUMN c SET DEFAULT ('a  b')",
+			want:      "ALTER TABLE `my  table` ALTER COLUMN c SET DEFAULT ('a  b')",
+		},
+		{
+			name:      "keepsEscapedQuotes",
+			statement: "ALTER TABLE t ALTER COLUMN c SET DEFAULT ('it\\'s  a \\\\' ||  \"say \\\"hi  there\\\"\")",
+			want:      "ALTER TABLE t ALTER COLUMN c SET DEFAULT ('it\\'s  a \\\\' || \"say \\\"hi  there\\\"\")",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeDdl(tt.statement); got != tt.want {
+				t.Errorf("NormalizeDdl() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_NormalizePostgresDdl(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      string
+	}{
+		{
+			name:      "keepsLiteralBackslash",
+			statement: "CREATE TABLE t (\n  p varchar DEFAULT 'C:\\',\n  q  bigint\n)",
+			want:      "CREATE TABLE t (p varchar DEFAULT 'C:\\', q bigint)",
+		},
+		{
+			name:      "doubledQuotes",
+			statement: "CREATE TABLE t (p varchar DEFAULT 'it''s  here',  q bigint)",
+			want:      "CREATE TABLE t (p varchar DEFAULT 'it''s  here', q bigint)",
+		},
+		{
+			name:      "escapeString",
+			statement: "CREATE TABLE t (p varchar DEFAULT E'it\\'s  here',  q bigint)",
+			want:      "CREATE TABLE t (p varchar DEFAULT E'it\\'s  here', q bigint)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizePostgresDdl(tt.statement); got != tt.want {
+				t.Errorf("NormalizePostgresDdl() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_DdlFingerprint(t *testing.T) {
+	base := DdlFingerprint([]string{"CREATE TABLE t (id INT64) PRIMARY KEY(id)", "CREATE INDEX i ON t(id)"}, nil)
+
+	if got := DdlFingerprint([]string{"CREATE TABLE t (\n  id INT64\n) PRIMARY KEY(id);", "CREATE INDEX i ON t(id)"}, nil); got != base {
+		t.Errorf("formatting changed the fingerprint: %s != %s", got, base)
+	}
+	if got := DdlFingerprint([]string{"CREATE INDEX i ON t(id)", "CREATE TABLE t (id INT64) PRIMARY KEY(id)"}, nil); got == base {
+		t.Error("reordering statements did not change the fingerprint")
+	}
+	if got := DdlFingerprint([]string{"CREATE TABLE t (id INT64) PRIMARY KEY(id)", "CREATE INDEX i ON t(id)"}, []byte{1, 2}); got == base {
+		t.Error("proto descriptors did not change the fingerprint")
+	}
+	if got := PostgresDdlFingerprint([]string{"CREATE TABLE t (\n  p varchar DEFAULT 'C:\\'\n)"}, nil); got != PostgresDdlFingerprint([]string{"CREATE TABLE t (p varchar DEFAULT 'C:\\')"}, nil) {
+		t.Error("formatting after a backslash changed the PostgreSQL fingerprint")
+	}
+	if len(base) != 64 {
+		t.Errorf("fingerprint %q is not a hex SHA-256", base)
+	}
+}
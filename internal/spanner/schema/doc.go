@@ -1,6 +1,7 @@
 // Package schema models Google Spanner schema objects — tables, columns,
 // indexes, foreign-key constraints, row deletion (TTL) policies, database
-// roles and grants, and sequences — and renders them as GoogleSQL DDL.
+// roles and grants, sequences, change streams and views — and renders them
+// as GoogleSQL DDL. ParseDdl reads such DDL back into the same model.
 //
 // The DDL builders (CreateDdl, AlterDdl, and the Drop*Ddl helpers) are pure:
 // struct in, DDL string out, no IO. SpannerTable is the exception on both
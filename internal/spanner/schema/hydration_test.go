@@ -61,8 +61,8 @@ func seedProbeTable(fake *connfake.Fake) {
 		{ColumnName: ns("proto_col"), SpannerType: ns("`tftest.Simple`"), IsNullable: ns("YES"), IsGenerated: ns("NEVER")},
 	})
 	fake.OnQuery("INFORMATION_SCHEMA.INDEX_COLUMNS", []*primaryKeyRow{
-		{ColumnName: ns("id")},
-		{ColumnName: ns("sub_id")},
+		{ColumnName: ns("id"), OrdinalPosition: sql.NullInt64{Int64: 1, Valid: true}, ColumnOrdering: ns("ASC")},
+		{ColumnName: ns("sub_id"), OrdinalPosition: sql.NullInt64{Int64: 2, Valid: true}, ColumnOrdering: ns("DESC")},
 	})
 	fake.OnQuery("INFORMATION_SCHEMA.COLUMN_OPTIONS", []*columnOptionRow{
 		{ColumnName: ns("update_time"), OptionName: ns("allow_commit_timestamp"), OptionValue: ns("TRUE")},
@@ -103,6 +103,8 @@ func TestSpannerTable_Get_HydratesFromInformationSchemaOnly(t *testing.T) {
 			Required:     wrapperspb.Bool(true),
 			IsComputed:   wrapperspb.Bool(false),
 			IsPrimaryKey: wrapperspb.Bool(true),
+			KeyPosition:  wrapperspb.Int64(1),
+			KeyOrder:     SpannerTableIndexColumnOrder_ASC,
 		},
 		"sub_id": {
 			Name:         "sub_id",
@@ -111,6 +113,8 @@ func TestSpannerTable_Get_HydratesFromInformationSchemaOnly(t *testing.T) {
 			Required:     wrapperspb.Bool(true),
 			IsComputed:   wrapperspb.Bool(false),
 			IsPrimaryKey: wrapperspb.Bool(true),
+			KeyPosition:  wrapperspb.Int64(2),
+			KeyOrder:     SpannerTableIndexColumnOrder_DESC,
 		},
 		"str_max": {Name: "str_max", Type: "STRING", Required: wrapperspb.Bool(false), IsComputed: wrapperspb.Bool(false)},
 		"flt": {
@@ -205,6 +209,10 @@ func assertColumnEqual(t *testing.T, got, want *SpannerTableColumn) {
 	assertStringWrapper(t, got.Name, "DefaultValue", got.DefaultValue, want.DefaultValue)
 	assertStringWrapper(t, got.Name, "ComputationDdl", got.ComputationDdl, want.ComputationDdl)
 	assertStringWrapper(t, got.Name, "ProtoPackage", got.ProtoPackage, want.ProtoPackage)
+	assertInt64Wrapper(t, got.Name, "KeyPosition", got.KeyPosition, want.KeyPosition)
+	if got.KeyOrder != want.KeyOrder {
+		t.Errorf("%s: KeyOrder = %v, want %v", got.Name, got.KeyOrder, want.KeyOrder)
+	}
 }
 
 func assertBoolWrapper(t *testing.T, col, field string, got, want *wrapperspb.BoolValue) {
@@ -309,3 +317,40 @@ func TestPreserveUnsetBooleans(t *testing.T) {
 		t.Errorf("drifted: hydrated true must survive, got %v", c.IsComputed)
 	}
 }
+
+func TestPreserveUnsetKeyOrdering(t *testing.T) {
+	prior := []*SpannerTableColumn{
+		{Name: "tenant_id", IsPrimaryKey: wrapperspb.Bool(true)},
+		{Name: "create_time", IsPrimaryKey: wrapperspb.Bool(true), KeyOrder: SpannerTableIndexColumnOrder_DESC},
+		{Name: "explicit", IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(3), KeyOrder: SpannerTableIndexColumnOrder_ASC},
+		{Name: "moved", IsPrimaryKey: wrapperspb.Bool(true)},
+	}
+	hydrated := []*SpannerTableColumn{
+		{Name: "tenant_id", IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(1), KeyOrder: SpannerTableIndexColumnOrder_ASC},
+		{Name: "create_time", IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(2), KeyOrder: SpannerTableIndexColumnOrder_DESC},
+		{Name: "payload"},
+		{Name: "explicit", IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(3), KeyOrder: SpannerTableIndexColumnOrder_ASC},
+		// moved was reordered outside Terraform: position 1 is not its rank.
+		{Name: "moved", IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(1), KeyOrder: SpannerTableIndexColumnOrder_DESC},
+	}
+
+	PreserveUnsetKeyOrdering(prior, hydrated)
+
+	byName := map[string]*SpannerTableColumn{}
+	for _, c := range hydrated {
+		byName[c.Name] = c
+	}
+
+	if c := byName["tenant_id"]; c.KeyPosition != nil || c.KeyOrder != SpannerTableIndexColumnOrder_UNSPECIFIED {
+		t.Errorf("tenant_id: default position and ASC should collapse to unset, got %v / %v", c.KeyPosition, c.KeyOrder)
+	}
+	if c := byName["create_time"]; c.KeyPosition != nil || c.KeyOrder != SpannerTableIndexColumnOrder_DESC {
+		t.Errorf("create_time: position should collapse and DESC survive, got %v / %v", c.KeyPosition, c.KeyOrder)
+	}
+	if c := byName["explicit"]; c.KeyPosition.GetValue() != 3 || c.KeyOrder != SpannerTableIndexColumnOrder_ASC {
+		t.Errorf("explicit: explicit prior values must survive, got %v / %v", c.KeyPosition, c.KeyOrder)
+	}
+	if c := byName["moved"]; c.KeyPosition.GetValue() != 1 || c.KeyOrder != SpannerTableIndexColumnOrder_DESC {
+		t.Errorf("moved: drifted position and order must survive, got %v / %v", c.KeyPosition, c.KeyOrder)
+	}
+}
@@ -48,10 +48,17 @@ type SpannerTableIndex struct {
 	Columns []*SpannerTableIndexColumn
 	// Whether the index is unique
 	Unique *wrapperspb.BoolValue
+	// Non-key columns whose values the index stores alongside its keys
+	Storing []string
+	// Whether rows with a NULL in any indexed column are left out
+	NullFiltered *wrapperspb.BoolValue
+	// The ancestor table the index is interleaved in, if any
+	InterleaveIn string
 }
 
 // CreateDdl renders the CREATE INDEX statement for the index on the given
-// table. An UNSPECIFIED column order renders as ASC; the input is not mutated.
+// table, with STORING and INTERLEAVE IN clauses when set. An UNSPECIFIED
+// column order renders as ASC; the input is not mutated.
 func (i *SpannerTableIndex) CreateDdl(table string) (string, error) {
 	if i == nil {
 		return "", nil
@@ -70,6 +77,9 @@ func (i *SpannerTableIndex) CreateDdl(table string) (string, error) {
 	if i.Unique != nil && i.Unique.GetValue() {
 		unique = "UNIQUE"
 	}
+	if i.NullFiltered != nil && i.NullFiltered.GetValue() {
+		unique = strings.TrimSpace(unique + " NULL_FILTERED")
+	}
 
 	columns := make([]string, 0, len(i.Columns))
 	for _, column := range i.Columns {
@@ -80,12 +90,20 @@ func (i *SpannerTableIndex) CreateDdl(table string) (string, error) {
 		columns = append(columns, fmt.Sprintf("%s %s", column.Name, strings.ToUpper(order.String())))
 	}
 
-	return fmt.Sprintf("CREATE %s INDEX %s ON %s (%s)",
+	ddl := fmt.Sprintf("CREATE %s INDEX %s ON %s (%s)",
 		unique,
 		i.Name,
 		table,
 		strings.Join(columns, ", "),
-	), nil
+	)
+	if len(i.Storing) > 0 {
+		ddl += fmt.Sprintf(" STORING (%s)", strings.Join(i.Storing, ", "))
+	}
+	if i.InterleaveIn != "" {
+		ddl += ", INTERLEAVE IN " + i.InterleaveIn
+	}
+
+	return ddl, nil
 }
 
 // DropIndexDdl renders the DROP INDEX statement.
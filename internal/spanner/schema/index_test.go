@@ -48,6 +48,18 @@ func TestSpannerTableIndexCreateDdl(t *testing.T) {
 			table: "t",
 			want:  "CREATE  INDEX idx ON t (c ASC)",
 		},
+		{
+			name: "null filtered storing interleaved",
+			index: &SpannerTableIndex{
+				Name:         "albums_by_title",
+				Columns:      []*SpannerTableIndexColumn{{Name: "singer_id"}, {Name: "title"}},
+				Storing:      []string{"release_date"},
+				NullFiltered: wrapperspb.Bool(true),
+				InterleaveIn: "singers",
+			},
+			table: "albums",
+			want:  "CREATE NULL_FILTERED INDEX albums_by_title ON albums (singer_id ASC, title ASC) STORING (release_date), INTERLEAVE IN singers",
+		},
 		{
 			name:    "missing table errors",
 			index:   &SpannerTableIndex{Name: "idx", Columns: []*SpannerTableIndexColumn{{Name: "c"}}},
@@ -0,0 +1,175 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind classifies a lexed DDL token.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// A bare identifier or keyword; keywords are not reserved here, the
+	// parser matches them case-insensitively where the grammar expects one.
+	tokenIdent
+	// A backtick-quoted identifier; text holds the unquoted name.
+	tokenQuotedIdent
+	tokenNumber
+	tokenString
+	// Any single punctuation character: ( ) , ; < > = . and the like.
+	tokenPunct
+)
+
+// token is one lexeme of a DDL statement. start and end are byte offsets
+// into the source, so the parser can lift expressions back out verbatim.
+type token struct {
+	kind  tokenKind
+	text  string
+	start int
+	end   int
+}
+
+// is reports whether the token is the keyword or punctuation s.
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokenIdent:
+		return strings.EqualFold(t.text, s)
+	case tokenPunct:
+		return t.text == s
+	default:
+		return false
+	}
+}
+
+// isIdent reports whether the token can name an object.
+func (t token) isIdent() bool {
+	return t.kind == tokenIdent || t.kind == tokenQuotedIdent
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of statement"
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+// lexDdl splits GoogleSQL DDL into tokens, dropping whitespace and comments
+// (--, # and /* */). String literals keep their quotes and prefixes in text;
+// only their extent matters to the parser.
+func lexDdl(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+
+		case c == '#' || (c == '-' && strings.HasPrefix(src[i:], "--")):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		case c == '/' && strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			i += end + 4
+
+		case c == '`':
+			end := strings.IndexByte(src[i+1:], '`')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted identifier at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenQuotedIdent, text: src[i+1 : i+1+end], start: i, end: i + end + 2})
+			i += end + 2
+
+		case c == '\'' || c == '"':
+			end, err := stringEnd(src, i, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: src[i:end], start: i, end: end})
+			i = end
+
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdentPart(src[i]) {
+				i++
+			}
+			// r'...', b"...", rb'...' and friends are string literals.
+			if i < len(src) && (src[i] == '\'' || src[i] == '"') && isStringPrefix(src[start:i]) {
+				end, err := stringEnd(src, start, i)
+				if err != nil {
+					return nil, err
+				}
+				tokens = append(tokens, token{kind: tokenString, text: src[start:end], start: start, end: end})
+				i = end
+				continue
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: src[start:i], start: start, end: i})
+
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && (isIdentPart(src[i]) || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[start:i], start: start, end: i})
+
+		default:
+			tokens = append(tokens, token{kind: tokenPunct, text: src[i : i+1], start: i, end: i + 1})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// stringEnd returns the offset just past the string literal whose prefix
+// starts at start and whose opening quote is at quote. Triple-quoted
+// literals run to the matching triple quote; raw literals ignore escapes.
+func stringEnd(src string, start, quote int) (int, error) {
+	raw := strings.ContainsAny(src[start:quote], "rR")
+	delimiter := src[quote : quote+1]
+	if strings.HasPrefix(src[quote:], strings.Repeat(delimiter, 3)) {
+		delimiter = strings.Repeat(delimiter, 3)
+	}
+
+	i := quote + len(delimiter)
+	for i < len(src) {
+		if src[i] == '\\' && !raw {
+			i += 2
+			continue
+		}
+		if strings.HasPrefix(src[i:], delimiter) {
+			return i + len(delimiter), nil
+		}
+		if src[i] == '\n' && len(delimiter) == 1 {
+			break
+		}
+		i++
+	}
+
+	return 0, fmt.Errorf("unterminated string literal at offset %d", start)
+}
+
+func isStringPrefix(s string) bool {
+	switch strings.ToLower(s) {
+	case "r", "b", "rb", "br":
+		return true
+	default:
+		return false
+	}
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c))
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,1161 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-alis/internal/spanner/names"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ParseDdl parses GoogleSQL DDL into the schema objects it declares. Each
+// argument may hold several statements separated by semicolons, so both the
+// GetDatabaseDdl statement list and a hand-written schema file parse alike.
+// database is the fully qualified database name the parsed tables are
+// named under.
+//
+// CREATE TABLE, CREATE INDEX, CREATE SEQUENCE, CREATE ROLE, CREATE CHANGE
+// STREAM, CREATE VIEW, and the ALTER TABLE forms that add a foreign key or
+// row deletion policy are modelled. Statements of any other kind are kept
+// verbatim in Unmanaged. A modelled statement that uses a construct the
+// model cannot represent — a CHECK constraint, a multi-column foreign key —
+// is an error rather than a silent approximation.
+func ParseDdl(database string, statements ...string) (*SpannerDatabaseSchema, error) {
+	return parseDdl(database, false, statements)
+}
+
+// ParseLiveDdl is ParseDdl for the GetDatabaseDdl output of an existing
+// database, which may use any construct Spanner supports. A statement the
+// model cannot represent is kept verbatim in Unmanaged, like a statement of
+// an unmodelled kind, instead of failing the whole read: the objects it
+// declares are left alone by the diff rather than approximated. A table
+// kept this way is listed in UnmanagedTables, and the indexes, foreign keys
+// and row deletion policies on it move to Unmanaged with it.
+func ParseLiveDdl(database string, statements ...string) (*SpannerDatabaseSchema, error) {
+	return parseDdl(database, true, statements)
+}
+
+// parseDdl implements ParseDdl and, when live is set, ParseLiveDdl.
+func parseDdl(database string, live bool, statements []string) (*SpannerDatabaseSchema, error) {
+	databaseName, err := names.ParseDatabase(database)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SpannerDatabaseSchema{}
+	for _, text := range statements {
+		tokens, err := lexDdl(text)
+		if err != nil {
+			if live {
+				s.Unmanaged = append(s.Unmanaged, strings.TrimSpace(text))
+				continue
+			}
+			return nil, err
+		}
+
+		for _, statement := range splitStatements(tokens) {
+			p := &ddlParser{src: text, tokens: statement}
+
+			// Each statement parses on its own, so one that fails part way
+			// leaves none of the objects it declares behind.
+			parsed := &SpannerDatabaseSchema{}
+			if err := p.parseStatement(databaseName, parsed); err != nil {
+				if live {
+					s.Unmanaged = append(s.Unmanaged, p.text())
+					if tableId, ok := p.createdTable(); ok {
+						s.UnmanagedTables = append(s.UnmanagedTables, tableId)
+					}
+					continue
+				}
+				return nil, fmt.Errorf("parsing %q: %w", p.text(), err)
+			}
+			s.append(parsed)
+		}
+	}
+
+	if err := s.unmanageDependents(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// splitStatements cuts a token stream at top-level semicolons, dropping
+// empty statements.
+func splitStatements(tokens []token) [][]token {
+	var statements [][]token
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch {
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+		case t.is(";") && depth == 0:
+			if i > start {
+				statements = append(statements, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(tokens) {
+		statements = append(statements, tokens[start:])
+	}
+
+	return statements
+}
+
+// ddlParser is a recursive-descent parser over the tokens of one statement.
+type ddlParser struct {
+	src    string
+	tokens []token
+	pos    int
+}
+
+// text returns the statement's source text.
+func (p *ddlParser) text() string {
+	if len(p.tokens) == 0 {
+		return ""
+	}
+
+	return p.src[p.tokens[0].start:p.tokens[len(p.tokens)-1].end]
+}
+
+func (p *ddlParser) peek() token {
+	return p.peekAt(0)
+}
+
+func (p *ddlParser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return token{kind: tokenEOF}
+	}
+
+	return p.tokens[p.pos+offset]
+}
+
+func (p *ddlParser) next() token {
+	t := p.peek()
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// accept consumes the keyword or punctuation sequence words when the next
+// tokens match it in full, and reports whether they did.
+func (p *ddlParser) accept(words ...string) bool {
+	for i, word := range words {
+		if !p.peekAt(i).is(word) {
+			return false
+		}
+	}
+	p.pos += len(words)
+
+	return true
+}
+
+// expect is accept for sequences the grammar requires.
+func (p *ddlParser) expect(words ...string) error {
+	for _, word := range words {
+		if t := p.next(); !t.is(word) {
+			return fmt.Errorf("expected %s, found %s", word, t)
+		}
+	}
+
+	return nil
+}
+
+// ident consumes an identifier, returning it unquoted. Qualified names
+// (named schemas) are not modelled.
+func (p *ddlParser) ident() (string, error) {
+	t := p.next()
+	if !t.isIdent() {
+		return "", fmt.Errorf("expected identifier, found %s", t)
+	}
+	if p.peek().is(".") {
+		return "", fmt.Errorf("qualified name %s.%s is not supported", t.text, p.peekAt(1).text)
+	}
+
+	return t.text, nil
+}
+
+// identList consumes a parenthesized, comma-separated identifier list.
+func (p *ddlParser) identList() ([]string, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var idents []string
+	for !p.accept(")") {
+		if len(idents) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		ident, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		idents = append(idents, ident)
+	}
+
+	return idents, nil
+}
+
+// expression consumes a parenthesized expression and returns its inner
+// text, normalized (see NormalizeDdl) so that equivalent spellings compare
+// equal.
+func (p *ddlParser) expression() (string, error) {
+	open := p.next()
+	if !open.is("(") {
+		return "", fmt.Errorf("expected (, found %s", open)
+	}
+
+	for depth := 1; ; {
+		t := p.next()
+		switch {
+		case t.kind == tokenEOF:
+			return "", fmt.Errorf("unbalanced parentheses")
+		case t.is("("):
+			depth++
+		case t.is(")"):
+			depth--
+			if depth == 0 {
+				return NormalizeDdl(p.src[open.end:t.start]), nil
+			}
+		}
+	}
+}
+
+// integer consumes an integer literal.
+func (p *ddlParser) integer() (int64, error) {
+	t := p.next()
+	if t.kind != tokenNumber {
+		return 0, fmt.Errorf("expected integer, found %s", t)
+	}
+
+	return strconv.ParseInt(t.text, 10, 64)
+}
+
+// onDelete consumes the action of an ON DELETE clause.
+func (p *ddlParser) onDelete() (SpannerTableConstraintAction, error) {
+	switch {
+	case p.accept("CASCADE"):
+		return SpannerTableConstraintActionCascade, nil
+	case p.accept("NO", "ACTION"):
+		return SpannerTableConstraintNoAction, nil
+	default:
+		return SpannerTableConstraintActionUnspecified, fmt.Errorf("expected CASCADE or NO ACTION, found %s", p.peek())
+	}
+}
+
+// end fails unless every token of the statement has been consumed.
+func (p *ddlParser) end() error {
+	if t := p.peek(); t.kind != tokenEOF {
+		return fmt.Errorf("unexpected %s", t)
+	}
+
+	return nil
+}
+
+// createdTable returns the id of the table a CREATE TABLE statement
+// declares, whether or not the rest of it parses.
+func (p *ddlParser) createdTable() (string, bool) {
+	p.pos = 0
+	if !p.accept("CREATE", "TABLE") {
+		return "", false
+	}
+	p.accept("IF", "NOT", "EXISTS")
+	t := p.next()
+
+	return t.text, t.isIdent()
+}
+
+// parseStatement parses one statement into s.
+func (p *ddlParser) parseStatement(database names.DatabaseName, s *SpannerDatabaseSchema) error {
+	switch {
+	case p.accept("CREATE", "TABLE"):
+		return p.parseCreateTable(database, s)
+	case p.peek().is("CREATE") && p.isCreateIndex():
+		return p.parseCreateIndex(s)
+	case p.accept("ALTER", "TABLE"):
+		return p.parseAlterTable(s)
+	case p.accept("CREATE", "SEQUENCE"):
+		return p.parseCreateSequence(s)
+	case p.accept("CREATE", "ROLE"):
+		return p.parseCreateRole(s)
+	case p.accept("CREATE", "CHANGE", "STREAM"):
+		return p.parseCreateChangeStream(s)
+	case p.accept("CREATE", "OR", "REPLACE", "VIEW"), p.accept("CREATE", "VIEW"):
+		return p.parseCreateView(s)
+	default:
+		s.Unmanaged = append(s.Unmanaged, p.text())
+		return nil
+	}
+}
+
+// isCreateIndex reports whether the statement is a plain secondary index, as
+// opposed to a search or vector index.
+func (p *ddlParser) isCreateIndex() bool {
+	for i := 1; ; i++ {
+		t := p.peekAt(i)
+		switch {
+		case t.is("UNIQUE"), t.is("NULL_FILTERED"):
+			continue
+		case t.is("INDEX"):
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// parseCreateTable parses the rest of a CREATE TABLE statement. Inline
+// foreign keys and the ROW DELETION POLICY clause land in s alongside the
+// table.
+func (p *ddlParser) parseCreateTable(database names.DatabaseName, s *SpannerDatabaseSchema) error {
+	p.accept("IF", "NOT", "EXISTS")
+	tableId, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	table := &SpannerTable{
+		Name: names.TableName{
+			Project:  database.Project,
+			Instance: database.Instance,
+			Database: database.Database,
+			Table:    tableId,
+		}.String(),
+		Schema: &SpannerTableSchema{},
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.accept(")") {
+		switch {
+		case p.accept("CONSTRAINT"):
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if !p.accept("FOREIGN", "KEY") {
+				return fmt.Errorf("constraint %s: only FOREIGN KEY constraints are supported", name)
+			}
+			constraint, err := p.parseForeignKey(name)
+			if err != nil {
+				return err
+			}
+			s.ForeignKeys = append(s.ForeignKeys, &SpannerSchemaForeignKey{Table: tableId, Constraint: constraint})
+		case p.peek().is("FOREIGN"):
+			return fmt.Errorf("foreign keys must be named with CONSTRAINT")
+		case p.peek().is("CHECK"):
+			return fmt.Errorf("CHECK constraints are not supported")
+		default:
+			column, err := p.parseColumn()
+			if err != nil {
+				return err
+			}
+			table.Schema.Columns = append(table.Schema.Columns, column)
+		}
+
+		// Spanner renders a trailing comma after the last column.
+		if !p.accept(",") && !p.peek().is(")") {
+			return fmt.Errorf("expected , or ), found %s", p.peek())
+		}
+	}
+
+	if p.accept("PRIMARY", "KEY") {
+		if err := p.parsePrimaryKey(table); err != nil {
+			return err
+		}
+	}
+
+	for p.accept(",") {
+		switch {
+		case p.accept("INTERLEAVE", "IN"):
+			interleave := &SpannerTableInterleave{}
+			if p.accept("PARENT") {
+				interleave.OnDelete = SpannerTableConstraintNoAction
+			}
+			if interleave.ParentTable, err = p.ident(); err != nil {
+				return err
+			}
+			if p.accept("ON", "DELETE") {
+				if interleave.OnDelete, err = p.onDelete(); err != nil {
+					return err
+				}
+			}
+			table.Interleave = interleave
+		case p.accept("ROW", "DELETION", "POLICY"):
+			policy, err := p.parseRowDeletionPolicy()
+			if err != nil {
+				return err
+			}
+			s.RowDeletionPolicies = append(s.RowDeletionPolicies, &SpannerSchemaRowDeletionPolicy{Table: tableId, Policy: policy})
+		default:
+			return fmt.Errorf("expected INTERLEAVE IN or ROW DELETION POLICY, found %s", p.peek())
+		}
+	}
+	if err := p.end(); err != nil {
+		return err
+	}
+
+	s.Tables = append(s.Tables, table)
+	return nil
+}
+
+// parseColumn parses one column definition.
+func (p *ddlParser) parseColumn() (*SpannerTableColumn, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+
+	column := &SpannerTableColumn{Name: name}
+	if err := p.parseColumnType(column); err != nil {
+		return nil, fmt.Errorf("column %s: %w", name, err)
+	}
+
+	for {
+		switch {
+		case p.accept("NOT", "NULL"):
+			column.Required = wrapperspb.Bool(true)
+		case p.accept("AS"):
+			expression, err := p.expression()
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", name, err)
+			}
+			column.IsComputed = wrapperspb.Bool(true)
+			column.ComputationDdl = wrapperspb.String(expression)
+			if p.accept("STORED") {
+				column.IsStored = wrapperspb.Bool(true)
+			}
+		case p.accept("DEFAULT"):
+			expression, err := p.expression()
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", name, err)
+			}
+			column.DefaultValue = wrapperspb.String(expression)
+		case p.accept("OPTIONS"):
+			if err := p.parseColumnOptions(column); err != nil {
+				return nil, fmt.Errorf("column %s: %w", name, err)
+			}
+		case p.peek().is(",") || p.peek().is(")"):
+			return column, nil
+		default:
+			return nil, fmt.Errorf("column %s: unsupported %s", name, p.peek())
+		}
+	}
+}
+
+// parseColumnType parses a column's type into Type, Size and ProtoPackage,
+// in the shape INFORMATION_SCHEMA hydration produces.
+func (p *ddlParser) parseColumnType(column *SpannerTableColumn) error {
+	t := p.next()
+	if !t.isIdent() {
+		return fmt.Errorf("expected type, found %s", t)
+	}
+
+	// Proto and enum columns name their fully qualified type, backticked or
+	// as a dotted path.
+	if t.kind == tokenQuotedIdent || p.peek().is(".") {
+		protoPackage := t.text
+		for p.accept(".") {
+			part := p.next()
+			if !part.isIdent() {
+				return fmt.Errorf("expected identifier, found %s", part)
+			}
+			protoPackage += "." + part.text
+		}
+		column.Type = SpannerTableDataTypeProto.String()
+		column.ProtoPackage = wrapperspb.String(protoPackage)
+		return nil
+	}
+
+	typeName := strings.ToUpper(t.text)
+	switch typeName {
+	case "STRING", "BYTES":
+		column.Type = typeName
+		size, err := p.parseSize()
+		if err != nil {
+			return err
+		}
+		column.Size = size
+	case "ARRAY":
+		if err := p.expect("<"); err != nil {
+			return err
+		}
+		start := p.peek()
+		element := p.next()
+		elementType := strings.ToUpper(element.text)
+		switch {
+		case element.kind == tokenIdent && elementType == "STRING":
+			column.Type = SpannerTableDataTypeStringArray.String()
+			size, err := p.parseSize()
+			if err != nil {
+				return err
+			}
+			column.Size = size
+		case element.kind == tokenIdent && (elementType == "INT64" || elementType == "FLOAT32" || elementType == "FLOAT64"):
+			column.Type = "ARRAY<" + elementType + ">"
+		default:
+			// Any other element type is carried verbatim; it renders back
+			// exactly as written.
+			for depth := 1; depth > 0; {
+				switch next := p.peek(); {
+				case next.kind == tokenEOF:
+					return fmt.Errorf("unterminated ARRAY type")
+				case next.is("<"):
+					depth++
+				case next.is(">"):
+					depth--
+					if depth == 0 {
+						continue
+					}
+				}
+				p.next()
+			}
+			column.Type = "ARRAY<" + strings.ReplaceAll(NormalizeDdl(p.src[start.start:p.peek().start]), " ", "") + ">"
+		}
+		if err := p.expect(">"); err != nil {
+			return err
+		}
+	default:
+		column.Type = typeName
+	}
+
+	return nil
+}
+
+// parseSize parses the (n) or (MAX) length of a STRING or BYTES type. MAX
+// is the unset size.
+func (p *ddlParser) parseSize() (*wrapperspb.Int64Value, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var size *wrapperspb.Int64Value
+	if !p.accept("MAX") {
+		n, err := p.integer()
+		if err != nil {
+			return nil, err
+		}
+		size = wrapperspb.Int64(n)
+	}
+
+	return size, p.expect(")")
+}
+
+// parseColumnOptions parses a column OPTIONS list. allow_commit_timestamp is
+// the only column option the model carries.
+func (p *ddlParser) parseColumnOptions(column *SpannerTableColumn) error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	for !p.accept(")") {
+		if column.AutoUpdateTime != nil {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+		if !p.accept("allow_commit_timestamp") {
+			return fmt.Errorf("unsupported option %s", p.peek())
+		}
+		if err := p.expect("="); err != nil {
+			return err
+		}
+		switch value := p.next(); {
+		case value.is("true"):
+			column.AutoUpdateTime = wrapperspb.Bool(true)
+		case value.is("false"), value.is("null"):
+			column.AutoUpdateTime = wrapperspb.Bool(false)
+		default:
+			return fmt.Errorf("expected true, false or null, found %s", value)
+		}
+	}
+
+	return nil
+}
+
+// parsePrimaryKey parses the PRIMARY KEY column list, marking each key
+// column with its position and order.
+func (p *ddlParser) parsePrimaryKey(table *SpannerTable) error {
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	for position := int64(1); !p.accept(")"); position++ {
+		if position > 1 {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+
+		var column *SpannerTableColumn
+		for _, c := range table.GetSchema().GetColumns() {
+			if strings.EqualFold(c.GetName(), name) {
+				column = c
+			}
+		}
+		if column == nil {
+			return fmt.Errorf("primary key column %s is not defined", name)
+		}
+
+		column.IsPrimaryKey = wrapperspb.Bool(true)
+		column.KeyPosition = wrapperspb.Int64(position)
+		column.KeyOrder = SpannerTableIndexColumnOrder_ASC
+		if p.accept("DESC") {
+			column.KeyOrder = SpannerTableIndexColumnOrder_DESC
+		} else {
+			p.accept("ASC")
+		}
+	}
+
+	return nil
+}
+
+// parseForeignKey parses a foreign key from its column list on; the
+// FOREIGN KEY keywords are already consumed.
+func (p *ddlParser) parseForeignKey(name string) (*SpannerTableForeignKeyConstraint, error) {
+	columns, err := p.identList()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("REFERENCES"); err != nil {
+		return nil, err
+	}
+	referencedTable, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	referencedColumns, err := p.identList()
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) != 1 || len(referencedColumns) != 1 {
+		return nil, fmt.Errorf("foreign key %s: multi-column foreign keys are not supported", name)
+	}
+
+	constraint := &SpannerTableForeignKeyConstraint{
+		Name:             name,
+		Column:           columns[0],
+		ReferencedTable:  referencedTable,
+		ReferencedColumn: referencedColumns[0],
+	}
+	if p.accept("ON", "DELETE") {
+		if constraint.OnDelete, err = p.onDelete(); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("NOT", "ENFORCED") {
+		constraint.Enforced = wrapperspb.Bool(false)
+	} else {
+		p.accept("ENFORCED")
+	}
+
+	return constraint, nil
+}
+
+// parseRowDeletionPolicy parses (OLDER_THAN(column, INTERVAL n DAY)).
+func (p *ddlParser) parseRowDeletionPolicy() (*SpannerTableRowDeletionPolicy, error) {
+	if err := p.expect("(", "OLDER_THAN", "("); err != nil {
+		return nil, err
+	}
+	column, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(",", "INTERVAL"); err != nil {
+		return nil, err
+	}
+	days, err := p.integer()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("DAY", ")", ")"); err != nil {
+		return nil, err
+	}
+
+	return &SpannerTableRowDeletionPolicy{Column: column, Duration: wrapperspb.Int64(days)}, nil
+}
+
+// parseCreateIndex parses a CREATE INDEX statement.
+func (p *ddlParser) parseCreateIndex(s *SpannerDatabaseSchema) error {
+	index := &SpannerTableIndex{}
+	p.next() // CREATE
+	if p.accept("UNIQUE") {
+		index.Unique = wrapperspb.Bool(true)
+	}
+	if p.accept("NULL_FILTERED") {
+		index.NullFiltered = wrapperspb.Bool(true)
+	}
+	if err := p.expect("INDEX"); err != nil {
+		return err
+	}
+	p.accept("IF", "NOT", "EXISTS")
+
+	var err error
+	if index.Name, err = p.ident(); err != nil {
+		return err
+	}
+	if err := p.expect("ON"); err != nil {
+		return err
+	}
+	table, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.accept(")") {
+		if len(index.Columns) > 0 {
+			if err := p.expect(","); err != nil {
+				return err
+			}
+		}
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		column := &SpannerTableIndexColumn{Name: name, Order: SpannerTableIndexColumnOrder_ASC}
+		if p.accept("DESC") {
+			column.Order = SpannerTableIndexColumnOrder_DESC
+		} else {
+			p.accept("ASC")
+		}
+		index.Columns = append(index.Columns, column)
+	}
+
+	if p.accept("STORING") {
+		if index.Storing, err = p.identList(); err != nil {
+			return err
+		}
+	}
+	if p.accept(",", "INTERLEAVE", "IN") {
+		if index.InterleaveIn, err = p.ident(); err != nil {
+			return err
+		}
+	}
+	if err := p.end(); err != nil {
+		return err
+	}
+
+	s.Indexes = append(s.Indexes, &SpannerSchemaIndex{Table: table, Index: index})
+	return nil
+}
+
+// parseAlterTable parses the ALTER TABLE forms that declare schema objects:
+// ADD CONSTRAINT ... FOREIGN KEY and ADD ROW DELETION POLICY. Other forms
+// change an existing table rather than declare one and are rejected.
+func (p *ddlParser) parseAlterTable(s *SpannerDatabaseSchema) error {
+	table, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case p.accept("ADD", "CONSTRAINT"):
+		name, err := p.ident()
+		if err != nil {
+			return err
+		}
+		if err := p.expect("FOREIGN", "KEY"); err != nil {
+			return err
+		}
+		constraint, err := p.parseForeignKey(name)
+		if err != nil {
+			return err
+		}
+		s.ForeignKeys = append(s.ForeignKeys, &SpannerSchemaForeignKey{Table: table, Constraint: constraint})
+	case p.accept("ADD", "ROW", "DELETION", "POLICY"):
+		policy, err := p.parseRowDeletionPolicy()
+		if err != nil {
+			return err
+		}
+		s.RowDeletionPolicies = append(s.RowDeletionPolicies, &SpannerSchemaRowDeletionPolicy{Table: table, Policy: policy})
+	default:
+		return fmt.Errorf("only ALTER TABLE ... ADD CONSTRAINT ... FOREIGN KEY and ADD ROW DELETION POLICY declare schema objects")
+	}
+
+	return p.end()
+}
+
+// ddlOption is one name = value pair of an OPTIONS list. A negative number
+// is folded into a single number token.
+type ddlOption struct {
+	name  string
+	value token
+}
+
+// options parses a parenthesized OPTIONS list; the OPTIONS keyword is
+// already consumed.
+func (p *ddlParser) options() ([]ddlOption, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	var options []ddlOption
+	for !p.accept(")") {
+		if len(options) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		name := p.next()
+		if name.kind != tokenIdent {
+			return nil, fmt.Errorf("expected option name, found %s", name)
+		}
+		if err := p.expect("="); err != nil {
+			return nil, err
+		}
+
+		value := p.next()
+		if value.is("-") {
+			number := p.next()
+			if number.kind != tokenNumber {
+				return nil, fmt.Errorf("expected number, found %s", number)
+			}
+			value = token{kind: tokenNumber, text: "-" + number.text, start: value.start, end: number.end}
+		}
+		switch {
+		case value.kind == tokenString, value.kind == tokenNumber:
+		case value.is("true"), value.is("false"), value.is("null"):
+		default:
+			return nil, fmt.Errorf("option %s: unsupported value %s", name.text, value)
+		}
+
+		options = append(options, ddlOption{name: strings.ToLower(name.text), value: value})
+	}
+
+	return options, nil
+}
+
+// isNull reports whether the option is set to NULL, i.e. reset.
+func (o ddlOption) isNull() bool {
+	return o.value.is("null")
+}
+
+func (o ddlOption) int64Value() (*wrapperspb.Int64Value, error) {
+	if o.isNull() {
+		return nil, nil
+	}
+	if o.value.kind != tokenNumber {
+		return nil, fmt.Errorf("option %s: expected integer, found %s", o.name, o.value)
+	}
+
+	n, err := strconv.ParseInt(o.value.text, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("option %s: %w", o.name, err)
+	}
+
+	return wrapperspb.Int64(n), nil
+}
+
+func (o ddlOption) stringValue() (*wrapperspb.StringValue, error) {
+	if o.isNull() {
+		return nil, nil
+	}
+	if o.value.kind != tokenString {
+		return nil, fmt.Errorf("option %s: expected string, found %s", o.name, o.value)
+	}
+
+	value, err := unquoteString(o.value.text)
+	if err != nil {
+		return nil, fmt.Errorf("option %s: %w", o.name, err)
+	}
+
+	return wrapperspb.String(value), nil
+}
+
+func (o ddlOption) boolValue() (*wrapperspb.BoolValue, error) {
+	switch {
+	case o.isNull():
+		return nil, nil
+	case o.value.is("true"):
+		return wrapperspb.Bool(true), nil
+	case o.value.is("false"):
+		return wrapperspb.Bool(false), nil
+	default:
+		return nil, fmt.Errorf("option %s: expected true or false, found %s", o.name, o.value)
+	}
+}
+
+// unquoteString returns the value of a plain single- or double-quoted
+// string literal. Raw, bytes and triple-quoted literals never appear in
+// option values and are rejected.
+func unquoteString(text string) (string, error) {
+	if len(text) < 2 || (text[0] != '\'' && text[0] != '"') || strings.HasPrefix(text, strings.Repeat(text[:1], 3)) {
+		return "", fmt.Errorf("unsupported string literal %s", text)
+	}
+
+	var b strings.Builder
+	body := text[1 : len(text)-1]
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		if c == '\\' && i+1 < len(body) {
+			i++
+			switch body[i] {
+			case 'n':
+				c = '\n'
+			case 't':
+				c = '\t'
+			default:
+				c = body[i]
+			}
+		}
+		b.WriteByte(c)
+	}
+
+	return b.String(), nil
+}
+
+// parseCreateSequence parses the rest of a CREATE SEQUENCE statement, in
+// either the OPTIONS form GetDatabaseDdl renders or the clause form
+// (BIT_REVERSED_POSITIVE SKIP RANGE ... START COUNTER WITH ...).
+func (p *ddlParser) parseCreateSequence(s *SpannerDatabaseSchema) error {
+	p.accept("IF", "NOT", "EXISTS")
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	sequence := &SpannerSequence{Name: name}
+	options := &SpannerSequenceOptions{}
+	set := false
+
+	if p.accept("BIT_REVERSED_POSITIVE") {
+		options.SequenceKind, set = SpannerSequenceKindBitReversedPositive, true
+	}
+	if p.accept("SKIP", "RANGE") {
+		min, err := p.signedInteger()
+		if err != nil {
+			return err
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+		max, err := p.signedInteger()
+		if err != nil {
+			return err
+		}
+		options.SkipRange, set = &SpannerSequenceSkipRange{Min: wrapperspb.Int64(min), Max: wrapperspb.Int64(max)}, true
+	}
+	if p.accept("START", "COUNTER") {
+		p.accept("WITH")
+		counter, err := p.signedInteger()
+		if err != nil {
+			return err
+		}
+		options.StartWithCounter, set = wrapperspb.Int64(counter), true
+	}
+
+	if p.accept("OPTIONS") {
+		list, err := p.options()
+		if err != nil {
+			return err
+		}
+		for _, option := range list {
+			switch option.name {
+			case "sequence_kind":
+				kind, err := option.stringValue()
+				if err != nil {
+					return err
+				}
+				if kind != nil {
+					if kind.GetValue() != SpannerSequenceKindBitReversedPositive.String() {
+						return fmt.Errorf("unsupported sequence kind %q", kind.GetValue())
+					}
+					options.SequenceKind = SpannerSequenceKindBitReversedPositive
+				}
+			case "skip_range_min", "skip_range_max":
+				value, err := option.int64Value()
+				if err != nil {
+					return err
+				}
+				if value == nil {
+					continue
+				}
+				if options.SkipRange == nil {
+					options.SkipRange = &SpannerSequenceSkipRange{}
+				}
+				if option.name == "skip_range_min" {
+					options.SkipRange.Min = value
+				} else {
+					options.SkipRange.Max = value
+				}
+			case "start_with_counter":
+				if options.StartWithCounter, err = option.int64Value(); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unsupported option %s", option.name)
+			}
+		}
+		set = set || options.SequenceKind != SpannerSequenceKindUnspecified || options.SkipRange != nil || options.StartWithCounter != nil
+	}
+	if err := p.end(); err != nil {
+		return err
+	}
+
+	if set {
+		sequence.Options = options
+	}
+	s.Sequences = append(s.Sequences, sequence)
+	return nil
+}
+
+// signedInteger consumes an integer literal with an optional minus sign.
+func (p *ddlParser) signedInteger() (int64, error) {
+	if p.accept("-") {
+		n, err := p.integer()
+		return -n, err
+	}
+
+	return p.integer()
+}
+
+// parseCreateRole parses the rest of a CREATE ROLE statement.
+func (p *ddlParser) parseCreateRole(s *SpannerDatabaseSchema) error {
+	role, err := p.ident()
+	if err != nil {
+		return err
+	}
+	if err := p.end(); err != nil {
+		return err
+	}
+
+	s.Roles = append(s.Roles, role)
+	return nil
+}
+
+// parseCreateChangeStream parses the rest of a CREATE CHANGE STREAM
+// statement.
+func (p *ddlParser) parseCreateChangeStream(s *SpannerDatabaseSchema) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	changeStream := &SpannerChangeStream{Name: name}
+	if p.accept("FOR") {
+		if p.accept("ALL") {
+			changeStream.All = true
+		} else {
+			for {
+				table, err := p.ident()
+				if err != nil {
+					return err
+				}
+
+				watched := &SpannerChangeStreamTable{Table: table}
+				if p.accept("(") {
+					// Table() watches the key columns only, so the list is
+					// non-nil even when empty.
+					watched.Columns = []string{}
+					for !p.accept(")") {
+						if len(watched.Columns) > 0 {
+							if err := p.expect(","); err != nil {
+								return err
+							}
+						}
+						column, err := p.ident()
+						if err != nil {
+							return err
+						}
+						watched.Columns = append(watched.Columns, column)
+					}
+				}
+				changeStream.Tables = append(changeStream.Tables, watched)
+
+				if !p.accept(",") {
+					break
+				}
+			}
+		}
+	}
+
+	if p.accept("OPTIONS") {
+		list, err := p.options()
+		if err != nil {
+			return err
+		}
+
+		options := &SpannerChangeStreamOptions{}
+		for _, option := range list {
+			switch option.name {
+			case "retention_period":
+				options.RetentionPeriod, err = option.stringValue()
+			case "value_capture_type":
+				options.ValueCaptureType, err = option.stringValue()
+			case "exclude_ttl_deletes":
+				options.ExcludeTtlDeletes, err = option.boolValue()
+			case "exclude_insert":
+				options.ExcludeInsert, err = option.boolValue()
+			case "exclude_update":
+				options.ExcludeUpdate, err = option.boolValue()
+			case "exclude_delete":
+				options.ExcludeDelete, err = option.boolValue()
+			case "allow_txn_exclusion":
+				options.AllowTxnExclusion, err = option.boolValue()
+			default:
+				err = fmt.Errorf("unsupported option %s", option.name)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		changeStream.Options = options
+	}
+	if err := p.end(); err != nil {
+		return err
+	}
+
+	s.ChangeStreams = append(s.ChangeStreams, changeStream)
+	return nil
+}
+
+// parseCreateView parses the rest of a CREATE [OR REPLACE] VIEW statement.
+// The query is everything after AS, normalized.
+func (p *ddlParser) parseCreateView(s *SpannerDatabaseSchema) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+
+	view := &SpannerView{Name: name}
+	if err := p.expect("SQL", "SECURITY"); err != nil {
+		return err
+	}
+	switch {
+	case p.accept("INVOKER"):
+		view.SqlSecurity = SpannerViewSqlSecurityInvoker
+	case p.accept("DEFINER"):
+		view.SqlSecurity = SpannerViewSqlSecurityDefiner
+	default:
+		return fmt.Errorf("expected INVOKER or DEFINER, found %s", p.peek())
+	}
+	if err := p.expect("AS"); err != nil {
+		return err
+	}
+
+	if p.peek().kind == tokenEOF {
+		return fmt.Errorf("view %s: query is required", name)
+	}
+	view.Query = NormalizeDdl(p.src[p.peek().start:p.tokens[len(p.tokens)-1].end])
+	p.pos = len(p.tokens)
+
+	s.Views = append(s.Views, view)
+	return nil
+}
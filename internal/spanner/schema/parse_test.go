@@ -0,0 +1,484 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testDatabase = "projects/test-project/instances/test-instance/databases/test-db"
+
+// GetDatabaseDdl renders tables with one column per line, a trailing comma
+// after the last column, and inline foreign keys and row deletion policies.
+func Test_ParseDdl_GetDatabaseDdlOutput(t *testing.T) {
+	got, err := ParseDdl(testDatabase,
+		"CREATE TABLE Singers (\n"+
+			"  SingerId INT64 NOT NULL,\n"+
+			"  Name STRING(1024),\n"+
+			"  Info `examples.SingerInfo`,\n"+
+			"  Tags ARRAY<STRING(MAX)>,\n"+
+			"  Scores ARRAY<FLOAT64>,\n"+
+			"  Flags ARRAY<BOOL>,\n"+
+			"  UpdateTime TIMESTAMP OPTIONS (\n    allow_commit_timestamp = true\n  ),\n"+
+			") PRIMARY KEY(SingerId)",
+		"CREATE TABLE Albums (\n"+
+			"  SingerId INT64 NOT NULL,\n"+
+			"  AlbumId INT64 NOT NULL,\n"+
+			"  Title STRING(MAX) DEFAULT ('untitled'),\n"+
+			"  TitleUpper STRING(MAX) AS (UPPER(Title)) STORED,\n"+
+			"  LabelId INT64,\n"+
+			"  CreateTime TIMESTAMP,\n"+
+			"  CONSTRAINT FK_Label FOREIGN KEY(LabelId) REFERENCES Labels(LabelId) ON DELETE CASCADE,\n"+
+			") PRIMARY KEY(SingerId, AlbumId DESC),\n"+
+			"  INTERLEAVE IN PARENT Singers ON DELETE CASCADE,\n"+
+			"  ROW DELETION POLICY (OLDER_THAN(CreateTime, INTERVAL 30 DAY))",
+		"CREATE NULL_FILTERED INDEX AlbumsByTitle ON Albums(Title DESC) STORING (LabelId), INTERLEAVE IN Singers",
+		"CREATE ROLE analyst",
+		"GRANT SELECT ON TABLE Singers TO ROLE analyst",
+	)
+	if err != nil {
+		t.Fatalf("ParseDdl() error = %v", err)
+	}
+
+	singers := got.Table("singers")
+	if singers.GetName() != testDatabase+"/tables/Singers" {
+		t.Errorf("table name = %q", singers.GetName())
+	}
+	wantSingers := []*SpannerTableColumn{
+		{
+			Name: "SingerId", Type: "INT64", Required: wrapperspb.Bool(true),
+			IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(1), KeyOrder: SpannerTableIndexColumnOrder_ASC,
+		},
+		{Name: "Name", Type: "STRING", Size: wrapperspb.Int64(1024)},
+		{Name: "Info", Type: "PROTO", ProtoPackage: wrapperspb.String("examples.SingerInfo")},
+		{Name: "Tags", Type: "ARRAY<STRING>"},
+		{Name: "Scores", Type: "ARRAY<FLOAT64>"},
+		{Name: "Flags", Type: "ARRAY<BOOL>"},
+		{Name: "UpdateTime", Type: "TIMESTAMP", AutoUpdateTime: wrapperspb.Bool(true)},
+	}
+	if !reflect.DeepEqual(singers.GetSchema().GetColumns(), wantSingers) {
+		t.Errorf("Singers columns = %v, want %v", singers.GetSchema().GetColumns(), wantSingers)
+	}
+
+	albums := got.Table("Albums")
+	columns := albums.GetSchema().GetColumns()
+	if got := columns[1]; got.GetKeyPosition().GetValue() != 2 || got.GetKeyOrder() != SpannerTableIndexColumnOrder_DESC {
+		t.Errorf("AlbumId key = %v %v, want 2 DESC", got.GetKeyPosition(), got.GetKeyOrder())
+	}
+	if got := columns[2].GetDefaultValue().GetValue(); got != "'untitled'" {
+		t.Errorf("Title default = %q", got)
+	}
+	if got := columns[3]; got.GetComputationDdl().GetValue() != "UPPER(Title)" || !got.GetIsStored().GetValue() {
+		t.Errorf("TitleUpper = %v", got)
+	}
+	if !reflect.DeepEqual(albums.GetInterleave(), &SpannerTableInterleave{ParentTable: "Singers", OnDelete: SpannerTableConstraintActionCascade}) {
+		t.Errorf("interleave = %v", albums.GetInterleave())
+	}
+
+	wantForeignKeys := []*SpannerSchemaForeignKey{{
+		Table: "Albums",
+		Constraint: &SpannerTableForeignKeyConstraint{
+			Name: "FK_Label", Column: "LabelId", ReferencedTable: "Labels", ReferencedColumn: "LabelId",
+			OnDelete: SpannerTableConstraintActionCascade,
+		},
+	}}
+	if !reflect.DeepEqual(got.ForeignKeys, wantForeignKeys) {
+		t.Errorf("ForeignKeys = %v, want %v", got.ForeignKeys, wantForeignKeys)
+	}
+	if policy := got.RowDeletionPolicy("albums"); policy.Column != "CreateTime" || policy.Duration.GetValue() != 30 {
+		t.Errorf("row deletion policy = %v", policy)
+	}
+
+	wantIndexes := []*SpannerSchemaIndex{{
+		Table: "Albums",
+		Index: &SpannerTableIndex{
+			Name:         "AlbumsByTitle",
+			Columns:      []*SpannerTableIndexColumn{{Name: "Title", Order: SpannerTableIndexColumnOrder_DESC}},
+			Storing:      []string{"LabelId"},
+			NullFiltered: wrapperspb.Bool(true),
+			InterleaveIn: "Singers",
+		},
+	}}
+	if !reflect.DeepEqual(got.Indexes, wantIndexes) {
+		t.Errorf("Indexes = %v, want %v", got.Indexes, wantIndexes)
+	}
+
+	if !reflect.DeepEqual(got.Roles, []string{"analyst"}) {
+		t.Errorf("Roles = %q", got.Roles)
+	}
+	if !reflect.DeepEqual(got.Unmanaged, []string{"GRANT SELECT ON TABLE Singers TO ROLE analyst"}) {
+		t.Errorf("Unmanaged = %q", got.Unmanaged)
+	}
+}
+
+func Test_ParseDdl_SplitsStatementsAndSkipsComments(t *testing.T) {
+	got, err := ParseDdl(testDatabase, `
+-- Users of the app; one row per account.
+CREATE TABLE users (
+  id STRING(36) NOT NULL, # the account id
+  bio STRING(MAX) DEFAULT ('a;b'),
+) PRIMARY KEY (id);
+
+/* Lookup by bio. */
+CREATE UNIQUE INDEX users_by_bio ON users (bio);
+ALTER TABLE users ADD ROW DELETION POLICY (OLDER_THAN(id, INTERVAL 1 DAY));
+`)
+	if err != nil {
+		t.Fatalf("ParseDdl() error = %v", err)
+	}
+
+	if len(got.Tables) != 1 || len(got.Indexes) != 1 || len(got.RowDeletionPolicies) != 1 || len(got.Unmanaged) != 0 {
+		t.Fatalf("ParseDdl() = %d tables, %d indexes, %d policies, %d unmanaged",
+			len(got.Tables), len(got.Indexes), len(got.RowDeletionPolicies), len(got.Unmanaged))
+	}
+	if got := got.Tables[0].GetSchema().GetColumns()[1].GetDefaultValue().GetValue(); got != "'a;b'" {
+		t.Errorf("default = %q", got)
+	}
+	if !got.Indexes[0].Index.Unique.GetValue() {
+		t.Error("index is not unique")
+	}
+}
+
+func Test_ParseDdl_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		ddl  string
+		want string
+	}{
+		{
+			name: "checkConstraint",
+			ddl:  "CREATE TABLE t (id INT64, CONSTRAINT ck CHECK (id > 0)) PRIMARY KEY (id)",
+			want: "only FOREIGN KEY constraints are supported",
+		},
+		{
+			name: "unnamedForeignKey",
+			ddl:  "CREATE TABLE t (id INT64, FOREIGN KEY (id) REFERENCES u (id)) PRIMARY KEY (id)",
+			want: "must be named",
+		},
+		{
+			name: "multiColumnForeignKey",
+			ddl:  "ALTER TABLE t ADD CONSTRAINT fk FOREIGN KEY (a, b) REFERENCES u (a, b)",
+			want: "multi-column foreign keys are not supported",
+		},
+		{
+			name: "alterColumn",
+			ddl:  "ALTER TABLE t ADD COLUMN c INT64",
+			want: "declare schema objects",
+		},
+		{
+			name: "undefinedKeyColumn",
+			ddl:  "CREATE TABLE t (id INT64) PRIMARY KEY (missing)",
+			want: "primary key column missing is not defined",
+		},
+		{
+			name: "hiddenColumn",
+			ddl:  "CREATE TABLE t (id INT64, c STRING(MAX) HIDDEN) PRIMARY KEY (id)",
+			want: `unsupported "HIDDEN"`,
+		},
+		{
+			name: "sequenceKind",
+			ddl:  "CREATE SEQUENCE s OPTIONS (sequence_kind = 'positive')",
+			want: `unsupported sequence kind "positive"`,
+		},
+		{
+			name: "changeStreamOption",
+			ddl:  "CREATE CHANGE STREAM cs FOR ALL OPTIONS (partition_mode = 'x')",
+			want: "unsupported option partition_mode",
+		},
+		{
+			name: "viewWithoutSqlSecurity",
+			ddl:  "CREATE VIEW v AS SELECT 1",
+			want: "expected SQL",
+		},
+		{
+			name: "unterminatedString",
+			ddl:  "CREATE TABLE t (id INT64 DEFAULT ('x)) PRIMARY KEY (id)",
+			want: "unterminated string literal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDdl(testDatabase, tt.ddl)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseDdl() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// A live database may use any construct Spanner supports. ParseLiveDdl keeps
+// each statement it cannot represent whole in Unmanaged — including the
+// foreign keys and policies declared inline before the unsupported clause —
+// and still models the rest.
+func Test_ParseLiveDdl_KeepsUnsupportedStatementsUnmanaged(t *testing.T) {
+	unsupported := []string{
+		"CREATE TABLE Checked (\n" +
+			"  Id INT64 NOT NULL,\n" +
+			"  CONSTRAINT FK_Checked FOREIGN KEY(Id) REFERENCES Singers(SingerId),\n" +
+			"  CONSTRAINT CK_Positive CHECK(Id > 0),\n" +
+			") PRIMARY KEY(Id),\n" +
+			"  ROW DELETION POLICY (OLDER_THAN(Id, INTERVAL 1 DAY))",
+		"CREATE TABLE Located (\n" +
+			"  Id INT64 NOT NULL,\n" +
+			"  Payload BYTES(MAX) OPTIONS (\n    locality_group = 'cold'\n  ),\n" +
+			") PRIMARY KEY(Id)",
+		"CREATE TABLE Searched (\n" +
+			"  Id INT64 NOT NULL,\n" +
+			"  Name STRING(MAX),\n" +
+			"  NameTokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN,\n" +
+			") PRIMARY KEY(Id)",
+		"ALTER TABLE Albums ADD CONSTRAINT FK_Pair FOREIGN KEY(SingerId, AlbumId) REFERENCES Pairs(SingerId, AlbumId)",
+		"ALTER TABLE Albums ADD CONSTRAINT CK_Title CHECK(Title != '')",
+		"ALTER TABLE Albums ALTER COLUMN Title SET DEFAULT ('untitled')",
+	}
+	statements := append([]string{
+		"CREATE TABLE Singers (\n  SingerId INT64 NOT NULL,\n) PRIMARY KEY(SingerId)",
+		"CREATE INDEX SingersById ON Singers(SingerId)",
+		"GRANT SELECT ON TABLE Singers TO ROLE analyst",
+	}, unsupported...)
+
+	got, err := ParseLiveDdl(testDatabase, statements...)
+	if err != nil {
+		t.Fatalf("ParseLiveDdl() error = %v", err)
+	}
+
+	if len(got.Tables) != 1 || got.Table("Singers") == nil || len(got.Indexes) != 1 {
+		t.Errorf("ParseLiveDdl() = %d tables, %d indexes, want only Singers and its index", len(got.Tables), len(got.Indexes))
+	}
+	if len(got.ForeignKeys) != 0 || len(got.RowDeletionPolicies) != 0 {
+		t.Errorf("ParseLiveDdl() kept %d foreign keys and %d policies of unsupported statements", len(got.ForeignKeys), len(got.RowDeletionPolicies))
+	}
+	wantUnmanaged := append([]string{"GRANT SELECT ON TABLE Singers TO ROLE analyst"}, unsupported...)
+	if !reflect.DeepEqual(got.Unmanaged, wantUnmanaged) {
+		t.Errorf("Unmanaged = %q, want %q", got.Unmanaged, wantUnmanaged)
+	}
+	if want := []string{"Checked", "Located", "Searched"}; !reflect.DeepEqual(got.UnmanagedTables, want) {
+		t.Errorf("UnmanagedTables = %q, want %q", got.UnmanagedTables, want)
+	}
+
+	// Desired DDL still has to be represented exactly.
+	for _, statement := range unsupported {
+		if _, err := ParseDdl(testDatabase, statement); err == nil {
+			t.Errorf("ParseDdl(%q) succeeded, want an error", statement)
+		}
+	}
+}
+
+func Test_ParseLiveDdl_UnmanagesObjectsOnUnmanagedTables(t *testing.T) {
+	got, err := ParseLiveDdl(testDatabase,
+		"CREATE TABLE a (\n  id INT64 NOT NULL,\n  CONSTRAINT ck CHECK(id > 0),\n) PRIMARY KEY(id)",
+		"CREATE INDEX a_by_id ON a(id)",
+		"CREATE TABLE b (\n  id INT64 NOT NULL,\n) PRIMARY KEY(id)",
+		"CREATE INDEX b_by_id ON b(id)",
+		"ALTER TABLE b ADD CONSTRAINT b_a FOREIGN KEY(id) REFERENCES a(id)",
+		"ALTER TABLE a ADD ROW DELETION POLICY (OLDER_THAN(ts, INTERVAL 1 DAY))",
+	)
+	if err != nil {
+		t.Fatalf("ParseLiveDdl() error = %v", err)
+	}
+
+	if len(got.Indexes) != 1 || got.Indexes[0].Index.Name != "b_by_id" {
+		t.Errorf("Indexes = %v, want only b_by_id", got.Indexes)
+	}
+	if len(got.ForeignKeys) != 0 || len(got.RowDeletionPolicies) != 0 {
+		t.Errorf("ParseLiveDdl() kept %d foreign keys and %d policies on unmanaged tables", len(got.ForeignKeys), len(got.RowDeletionPolicies))
+	}
+	if len(got.Unmanaged) != 4 {
+		t.Errorf("Unmanaged = %q, want the table and its three dependents", got.Unmanaged)
+	}
+}
+
+// Every CreateDdl in the package renders DDL ParseDdl reads back into the
+// model it was rendered from.
+func Test_ParseDdl_RoundTripsCreateDdl(t *testing.T) {
+	table := &SpannerTable{
+		Name: testDatabase + "/tables/albums",
+		Schema: &SpannerTableSchema{Columns: []*SpannerTableColumn{
+			{
+				Name: "singer_id", Type: "INT64", Required: wrapperspb.Bool(true),
+				IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(1), KeyOrder: SpannerTableIndexColumnOrder_ASC,
+			},
+			{
+				Name: "album_id", Type: "STRING", Size: wrapperspb.Int64(36), Required: wrapperspb.Bool(true),
+				IsPrimaryKey: wrapperspb.Bool(true), KeyPosition: wrapperspb.Int64(2), KeyOrder: SpannerTableIndexColumnOrder_DESC,
+			},
+			{Name: "title", Type: "STRING", DefaultValue: wrapperspb.String("'untitled'")},
+			{Name: "title_upper", Type: "STRING", IsComputed: wrapperspb.Bool(true), ComputationDdl: wrapperspb.String("UPPER(title)"), IsStored: wrapperspb.Bool(true)},
+			{Name: "cover", Type: "BYTES", Size: wrapperspb.Int64(1024)},
+			{Name: "tags", Type: "ARRAY<STRING>", Size: wrapperspb.Int64(64)},
+			{Name: "scores", Type: "ARRAY<FLOAT64>"},
+			{Name: "info", Type: "PROTO", ProtoPackage: wrapperspb.String("examples.AlbumInfo")},
+			{Name: "update_time", Type: "TIMESTAMP", AutoUpdateTime: wrapperspb.Bool(true)},
+			{Name: "release_date", Type: "DATE"},
+		}},
+		Interleave: &SpannerTableInterleave{ParentTable: "singers", OnDelete: SpannerTableConstraintActionCascade},
+	}
+	index := &SpannerTableIndex{
+		Name: "albums_by_title",
+		Columns: []*SpannerTableIndexColumn{
+			{Name: "singer_id", Order: SpannerTableIndexColumnOrder_ASC},
+			{Name: "title", Order: SpannerTableIndexColumnOrder_DESC},
+		},
+		Unique:       wrapperspb.Bool(true),
+		NullFiltered: wrapperspb.Bool(true),
+		Storing:      []string{"release_date"},
+		InterleaveIn: "singers",
+	}
+	foreignKey := &SpannerTableForeignKeyConstraint{
+		Name: "fk_label", Column: "label_id", ReferencedTable: "labels", ReferencedColumn: "id",
+		OnDelete: SpannerTableConstraintActionCascade, Enforced: wrapperspb.Bool(false),
+	}
+	policy := &SpannerTableRowDeletionPolicy{Column: "update_time", Duration: wrapperspb.Int64(30)}
+	sequence := &SpannerSequence{Name: "album_ids", Options: &SpannerSequenceOptions{
+		SequenceKind:     SpannerSequenceKindBitReversedPositive,
+		SkipRange:        &SpannerSequenceSkipRange{Min: wrapperspb.Int64(1), Max: wrapperspb.Int64(1000)},
+		StartWithCounter: wrapperspb.Int64(50),
+	}}
+	allTables := &SpannerChangeStream{Name: "everything", All: true, Options: &SpannerChangeStreamOptions{
+		RetentionPeriod:   wrapperspb.String("36h"),
+		ValueCaptureType:  wrapperspb.String("NEW_ROW"),
+		ExcludeTtlDeletes: wrapperspb.Bool(true),
+		ExcludeInsert:     wrapperspb.Bool(false),
+		ExcludeUpdate:     wrapperspb.Bool(false),
+		ExcludeDelete:     wrapperspb.Bool(true),
+		AllowTxnExclusion: wrapperspb.Bool(true),
+	}}
+	someTables := &SpannerChangeStream{Name: "albums_changes", Tables: []*SpannerChangeStreamTable{
+		{Table: "albums", Columns: []string{"title", "release_date"}},
+		{Table: "singers"},
+		{Table: "labels", Columns: []string{}},
+	}}
+	view := &SpannerView{Name: "album_titles", SqlSecurity: SpannerViewSqlSecurityInvoker, Query: "SELECT a.title FROM albums AS a WHERE a.title != 'x'"}
+
+	tests := []struct {
+		name   string
+		render func() (string, error)
+		parsed func(s *SpannerDatabaseSchema) any
+		want   any
+	}{
+		{
+			name:   "table",
+			render: table.CreateDdl,
+			parsed: func(s *SpannerDatabaseSchema) any { return s.Table("albums") },
+			want:   table,
+		},
+		{
+			name:   "index",
+			render: func() (string, error) { return index.CreateDdl("albums") },
+			parsed: func(s *SpannerDatabaseSchema) any { return s.Indexes },
+			want:   []*SpannerSchemaIndex{{Table: "albums", Index: index}},
+		},
+		{
+			name:   "foreignKey",
+			render: func() (string, error) { return foreignKey.CreateDdl("albums") },
+			parsed: func(s *SpannerDatabaseSchema) any { return s.ForeignKeys },
+			want:   []*SpannerSchemaForeignKey{{Table: "albums", Constraint: foreignKey}},
+		},
+		{
+			name:   "rowDeletionPolicy",
+			render: func() (string, error) { return policy.CreateDdl("albums") },
+			parsed: func(s *SpannerDatabaseSchema) any { return s.RowDeletionPolicy("albums") },
+			want:   policy,
+		},
+		{
+			name:   "sequence",
+			render: sequence.CreateDdl,
+			parsed: func(s *SpannerDatabaseSchema) any { return s.Sequences },
+			want:   []*SpannerSequence{sequence},
+		},
+		{
+			name:   "role",
+			render: func() (string, error) { return CreateRoleDdl("analyst"), nil },
+			parsed: func(s *SpannerDatabaseSchema) any { return s.Roles },
+			want:   []string{"analyst"},
+		},
+		{
+			name:   "changeStreamForAll",
+			render: allTables.CreateDdl,
+			parsed: func(s *SpannerDatabaseSchema) any { return s.ChangeStreams },
+			want:   []*SpannerChangeStream{allTables},
+		},
+		{
+			name:   "changeStreamForTables",
+			render: someTables.CreateDdl,
+			parsed: func(s *SpannerDatabaseSchema) any { return s.ChangeStreams },
+			want:   []*SpannerChangeStream{someTables},
+		},
+		{
+			name:   "view",
+			render: view.CreateDdl,
+			parsed: func(s *SpannerDatabaseSchema) any { return s.Views },
+			want:   []*SpannerView{view},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ddl, err := tt.render()
+			if err != nil {
+				t.Fatalf("CreateDdl() error = %v", err)
+			}
+
+			s, err := ParseDdl(testDatabase, ddl)
+			if err != nil {
+				t.Fatalf("ParseDdl(%q) error = %v", ddl, err)
+			}
+			if len(s.Unmanaged) != 0 {
+				t.Errorf("Unmanaged = %q", s.Unmanaged)
+			}
+			if got := tt.parsed(s); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDdl(%q) = %v, want %v", ddl, got, tt.want)
+			}
+		})
+	}
+}
+
+// Sequences come back from GetDatabaseDdl in the OPTIONS form, but the
+// clause form is accepted too.
+func Test_ParseDdl_Sequences(t *testing.T) {
+	got, err := ParseDdl(testDatabase,
+		"CREATE SEQUENCE a OPTIONS (\n  sequence_kind = 'bit_reversed_positive',\n  skip_range_min = -10,\n  skip_range_max = 10\n)",
+		"CREATE SEQUENCE IF NOT EXISTS b BIT_REVERSED_POSITIVE SKIP RANGE 1, 5 START COUNTER WITH 100",
+		"CREATE SEQUENCE c",
+	)
+	if err != nil {
+		t.Fatalf("ParseDdl() error = %v", err)
+	}
+
+	want := []*SpannerSequence{
+		{Name: "a", Options: &SpannerSequenceOptions{
+			SequenceKind: SpannerSequenceKindBitReversedPositive,
+			SkipRange:    &SpannerSequenceSkipRange{Min: wrapperspb.Int64(-10), Max: wrapperspb.Int64(10)},
+		}},
+		{Name: "b", Options: &SpannerSequenceOptions{
+			SequenceKind:     SpannerSequenceKindBitReversedPositive,
+			SkipRange:        &SpannerSequenceSkipRange{Min: wrapperspb.Int64(1), Max: wrapperspb.Int64(5)},
+			StartWithCounter: wrapperspb.Int64(100),
+		}},
+		{Name: "c"},
+	}
+	if !reflect.DeepEqual(got.Sequences, want) {
+		t.Errorf("Sequences = %v, want %v", got.Sequences, want)
+	}
+}
+
+func Test_ParseDdl_ViewsAndChangeStreams(t *testing.T) {
+	got, err := ParseDdl(testDatabase,
+		"CREATE OR REPLACE VIEW `v` SQL SECURITY DEFINER AS\n  SELECT id\n  FROM t",
+		"CREATE CHANGE STREAM idle",
+	)
+	if err != nil {
+		t.Fatalf("ParseDdl() error = %v", err)
+	}
+
+	if want := []*SpannerView{{Name: "v", SqlSecurity: SpannerViewSqlSecurityDefiner, Query: "SELECT id FROM t"}}; !reflect.DeepEqual(got.Views, want) {
+		t.Errorf("Views = %v, want %v", got.Views, want)
+	}
+	if want := []*SpannerChangeStream{{Name: "idle"}}; !reflect.DeepEqual(got.ChangeStreams, want) {
+		t.Errorf("ChangeStreams = %v, want %v", got.ChangeStreams, want)
+	}
+	if want := []string{`change stream "idle"`, `view "v"`}; !reflect.DeepEqual(got.UndiffedObjects(), want) {
+		t.Errorf("UndiffedObjects() = %q, want %q", got.UndiffedObjects(), want)
+	}
+}
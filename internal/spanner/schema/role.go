@@ -10,6 +10,13 @@ func CreateRoleDdl(roleId string) string {
 	return "CREATE ROLE " + roleId
 }
 
+// IsSystemRole reports whether role is one Spanner defines in every database
+// (public and the spanner_ roles). System roles cannot be created, dropped or
+// granted table privileges, so they are never managed objects.
+func IsSystemRole(role string) bool {
+	return role == "public" || strings.HasPrefix(role, "spanner_")
+}
+
 // DropRoleDdl renders the DROP ROLE statement.
 func DropRoleDdl(roleId string) string {
 	return "DROP ROLE " + roleId
@@ -25,3 +32,27 @@ func GrantTablePrivilegesDdl(table, role string, permissions []string) string {
 func RevokeTablePrivilegesDdl(table, role string, permissions []string) string {
 	return fmt.Sprintf("REVOKE %s ON TABLE %s FROM ROLE %s", strings.Join(permissions, ", "), table, role)
 }
+
+// RevokeObjectPrivilegesDdl renders the REVOKE statement for privileges on
+// an object of any kind, e.g. objectType VIEW, CHANGE STREAM or FUNCTION.
+func RevokeObjectPrivilegesDdl(objectType, object, role string, privileges []string) string {
+	return fmt.Sprintf("REVOKE %s ON %s %s FROM ROLE %s", strings.Join(privileges, ", "), objectType, object, role)
+}
+
+// ColumnPrivilege renders a privilege narrowed to columns, e.g.
+// SELECT(name, email), as an operand of GrantTablePrivilegesDdl or
+// RevokeTablePrivilegesDdl.
+func ColumnPrivilege(permission string, columns []string) string {
+	return permission + "(" + strings.Join(columns, ", ") + ")"
+}
+
+// GrantRoleDdl renders the GRANT ROLE statement making member inherit the
+// privileges of role.
+func GrantRoleDdl(role, member string) string {
+	return fmt.Sprintf("GRANT ROLE %s TO ROLE %s", role, member)
+}
+
+// RevokeRoleDdl renders the REVOKE ROLE statement.
+func RevokeRoleDdl(role, member string) string {
+	return fmt.Sprintf("REVOKE ROLE %s FROM ROLE %s", role, member)
+}
@@ -23,6 +23,38 @@ func TestRoleAndGrantDdl(t *testing.T) {
 		}
 	})
 
+	t.Run("GrantTablePrivilegesDdl mixes table and column privileges", func(t *testing.T) {
+		got := GrantTablePrivilegesDdl("customers", "support", []string{ColumnPrivilege("SELECT", []string{"name", "email"}), "INSERT"})
+		want := "GRANT SELECT(name, email), INSERT ON TABLE customers TO ROLE support"
+		if got != want {
+			t.Errorf("GrantTablePrivilegesDdl() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("RevokeObjectPrivilegesDdl", func(t *testing.T) {
+		got := RevokeObjectPrivilegesDdl("CHANGE STREAM", "order_changes", "auditor", []string{"SELECT"})
+		want := "REVOKE SELECT ON CHANGE STREAM order_changes FROM ROLE auditor"
+		if got != want {
+			t.Errorf("RevokeObjectPrivilegesDdl() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("GrantRoleDdl", func(t *testing.T) {
+		got := GrantRoleDdl("inventory_reader", "inventory_admin")
+		want := "GRANT ROLE inventory_reader TO ROLE inventory_admin"
+		if got != want {
+			t.Errorf("GrantRoleDdl() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("RevokeRoleDdl", func(t *testing.T) {
+		got := RevokeRoleDdl("inventory_reader", "inventory_admin")
+		want := "REVOKE ROLE inventory_reader FROM ROLE inventory_admin"
+		if got != want {
+			t.Errorf("RevokeRoleDdl() = %q, want %q", got, want)
+		}
+	})
+
 	t.Run("RevokeTablePrivilegesDdl", func(t *testing.T) {
 		got := RevokeTablePrivilegesDdl("inventory", "inventory_admin", []string{"SELECT"})
 		want := "REVOKE SELECT ON TABLE inventory FROM ROLE inventory_admin"
@@ -31,3 +63,17 @@ func TestRoleAndGrantDdl(t *testing.T) {
 		}
 	})
 }
+
+func TestIsSystemRole(t *testing.T) {
+	for role, want := range map[string]bool{
+		"public":              true,
+		"spanner_info_reader": true,
+		"spanner_sys_reader":  true,
+		"inventory_admin":     false,
+		"publisher":           false,
+	} {
+		if got := IsSystemRole(role); got != want {
+			t.Errorf("IsSystemRole(%q) = %v, want %v", role, got, want)
+		}
+	}
+}
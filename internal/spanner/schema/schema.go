@@ -1,6 +1,10 @@
 package schema
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
 // SpannerTableSchema represents the schema of a Spanner table.
 type SpannerTableSchema struct {
@@ -16,18 +20,74 @@ func (s *SpannerTableSchema) GetColumns() []*SpannerTableColumn {
 	return s.Columns
 }
 
-// GetPrimaryKeyColumns returns the backtick-quoted names of the primary-key
-// columns in declaration order, ready for a PRIMARY KEY (...) clause.
-func (s *SpannerTableSchema) GetPrimaryKeyColumns() []string {
+// PrimaryKey returns the primary-key columns in key order (see
+// keyPositions).
+func (s *SpannerTableSchema) PrimaryKey() []*SpannerTableColumn {
 	if s == nil {
 		return nil
 	}
 
-	var primaryKeys []string
+	positions := keyPositions(s.GetColumns())
+
+	var columns []*SpannerTableColumn
 	for _, column := range s.GetColumns() {
 		if column.PrimaryKey() {
-			primaryKeys = append(primaryKeys, fmt.Sprintf("`%s`", column.GetName()))
+			columns = append(columns, column)
+		}
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		return positions[columns[i].GetName()] < positions[columns[j].GetName()]
+	})
+
+	return columns
+}
+
+// keyPositions resolves the 1-based key position of every primary-key column.
+// Columns with a KeyPosition take it; the rest fill the unclaimed positions
+// in declaration order, so a schema that sets no positions keeps its
+// declaration order and one that pins a single column shifts the others
+// around it.
+func keyPositions(columns []*SpannerTableColumn) map[string]int64 {
+	positions := make(map[string]int64)
+	claimed := make(map[int64]bool)
+	for _, column := range columns {
+		if column.PrimaryKey() && column.GetKeyPosition() != nil {
+			positions[column.GetName()] = column.GetKeyPosition().GetValue()
+			claimed[column.GetKeyPosition().GetValue()] = true
+		}
+	}
+
+	next := int64(1)
+	for _, column := range columns {
+		if !column.PrimaryKey() || column.GetKeyPosition() != nil {
+			continue
+		}
+		for claimed[next] {
+			next++
+		}
+		positions[column.GetName()] = next
+		next++
+	}
+
+	return positions
+}
+
+// GetPrimaryKeyColumns returns the backtick-quoted primary-key parts in key
+// order (see PrimaryKey), ready for a PRIMARY KEY (...) clause. Descending
+// columns carry a DESC suffix; ascending ones render bare, as Spanner's
+// default.
+func (s *SpannerTableSchema) GetPrimaryKeyColumns() []string {
+	if s == nil {
+		return nil
+	}
+
+	var primaryKeys []string
+	for _, column := range s.PrimaryKey() {
+		part := fmt.Sprintf("`%s`", column.GetName())
+		if column.effectiveKeyOrder() == SpannerTableIndexColumnOrder_DESC {
+			part += " " + strings.ToUpper(column.effectiveKeyOrder().String())
 		}
+		primaryKeys = append(primaryKeys, part)
 	}
 
 	return primaryKeys
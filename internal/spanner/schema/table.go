@@ -286,7 +286,9 @@ type informationSchemaColumnRow struct {
 }
 
 type primaryKeyRow struct {
-	ColumnName sql.NullString `gorm:"column:COLUMN_NAME"`
+	ColumnName      sql.NullString `gorm:"column:COLUMN_NAME"`
+	OrdinalPosition sql.NullInt64  `gorm:"column:ORDINAL_POSITION"`
+	ColumnOrdering  sql.NullString `gorm:"column:COLUMN_ORDERING"`
 }
 
 type columnOptionRow struct {
@@ -420,22 +422,29 @@ func (t *SpannerTable) Get(ctx context.Context, cn conn.Connection, name string)
 			ctx,
 			t.GetDatabase(),
 			&rows,
-			`SELECT COLUMN_NAME, ORDINAL_POSITION FROM INFORMATION_SCHEMA.INDEX_COLUMNS WHERE TABLE_NAME = ? AND INDEX_NAME = 'PRIMARY_KEY' ORDER BY ORDINAL_POSITION`,
+			`SELECT COLUMN_NAME, ORDINAL_POSITION, COLUMN_ORDERING FROM INFORMATION_SCHEMA.INDEX_COLUMNS WHERE TABLE_NAME = ? AND INDEX_NAME = 'PRIMARY_KEY' ORDER BY ORDINAL_POSITION`,
 			t.GetTableId(),
 		); err != nil {
 			return nil, err
 		}
 
-		var primaryKeys []string
+		primaryKeys := make(map[string]*primaryKeyRow, len(rows))
 		for _, r := range rows {
-			primaryKeys = append(primaryKeys, r.ColumnName.String)
+			primaryKeys[r.ColumnName.String] = r
 		}
 
 		for _, column := range columns {
-			for _, primaryKey := range primaryKeys {
-				if column.GetName() == primaryKey {
-					column.IsPrimaryKey = wrapperspb.Bool(true)
-				}
+			r, ok := primaryKeys[column.GetName()]
+			if !ok {
+				continue
+			}
+			column.IsPrimaryKey = wrapperspb.Bool(true)
+			if r.OrdinalPosition.Valid {
+				column.KeyPosition = wrapperspb.Int64(r.OrdinalPosition.Int64)
+			}
+			column.KeyOrder = SpannerTableIndexColumnOrder_ASC
+			if strings.EqualFold(r.ColumnOrdering.String, "DESC") {
+				column.KeyOrder = SpannerTableIndexColumnOrder_DESC
 			}
 		}
 	}
@@ -84,6 +84,38 @@ func Test_SpannerTable_createDdl(t1 *testing.T) {
 			},
 			want: "CREATE TABLE `tf_test` (`id` INT64 NOT NULL DEFAULT ((GET_NEXT_SEQUENCE_VALUE(SEQUENCE MySequence))), `name` STRING(255) NOT NULL, `proto` `play.nn.app.v1.App`, `update_time` TIMESTAMP OPTIONS (allow_commit_timestamp=true)) PRIMARY KEY (`id`, `name`), INTERLEAVE IN PARENT parent_table ON DELETE CASCADE",
 		},
+		{
+			name: "SpannerTable.createDdl key position and DESC",
+			fields: fields{
+				Name: fmt.Sprintf("projects/%s/instances/%s/databases/%s/tables/%s", testProject, testInstance, "play-np", "events"),
+				Schema: &SpannerTableSchema{
+					Columns: []*SpannerTableColumn{
+						{
+							Name:         "create_time",
+							IsPrimaryKey: wrapperspb.Bool(true),
+							KeyPosition:  wrapperspb.Int64(2),
+							KeyOrder:     SpannerTableIndexColumnOrder_DESC,
+							Type:         SpannerTableDataTypeTimestamp.String(),
+							Required:     wrapperspb.Bool(true),
+						},
+						{
+							Name: "payload",
+							Type: SpannerTableDataTypeString.String(),
+						},
+						{
+							Name:         "tenant_id",
+							IsPrimaryKey: wrapperspb.Bool(true),
+							KeyPosition:  wrapperspb.Int64(1),
+							KeyOrder:     SpannerTableIndexColumnOrder_ASC,
+							Type:         SpannerTableDataTypeString.String(),
+							Size:         wrapperspb.Int64(36),
+							Required:     wrapperspb.Bool(true),
+						},
+					},
+				},
+			},
+			want: "CREATE TABLE `events` (`create_time` TIMESTAMP NOT NULL, `payload` STRING(MAX), `tenant_id` STRING(36) NOT NULL) PRIMARY KEY (`tenant_id`, `create_time` DESC)",
+		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SpannerViewSqlSecurity is the security type a view runs its query with.
+type SpannerViewSqlSecurity int64
+
+const (
+	SpannerViewSqlSecurityUnspecified SpannerViewSqlSecurity = iota
+	SpannerViewSqlSecurityInvoker
+	SpannerViewSqlSecurityDefiner
+)
+
+func (s SpannerViewSqlSecurity) String() string {
+	return [...]string{"", "INVOKER", "DEFINER"}[s]
+}
+
+// SpannerView represents a Spanner view.
+type SpannerView struct {
+	// The name of the view.
+	Name string
+	// The security type of the view. Spanner requires one; there is no
+	// default.
+	SqlSecurity SpannerViewSqlSecurity
+	// The SELECT statement defining the view.
+	Query string
+}
+
+// CreateDdl renders the CREATE VIEW statement.
+func (v *SpannerView) CreateDdl() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	if v.Name == "" {
+		return "", errors.New("view name is required")
+	}
+	if v.SqlSecurity == SpannerViewSqlSecurityUnspecified {
+		return "", fmt.Errorf("sql security is required for view %s", v.Name)
+	}
+	if v.Query == "" {
+		return "", fmt.Errorf("query is required for view %s", v.Name)
+	}
+
+	return fmt.Sprintf("CREATE VIEW `%s` SQL SECURITY %s AS %s", v.Name, v.SqlSecurity, v.Query), nil
+}
+
+// DropViewDdl renders the DROP VIEW statement.
+func DropViewDdl(name string) string {
+	return fmt.Sprintf("DROP VIEW `%s`", name)
+}
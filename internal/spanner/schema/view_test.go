@@ -0,0 +1,23 @@
+package schema
+
+import "testing"
+
+func TestSpannerView_CreateDdl(t *testing.T) {
+	view := &SpannerView{Name: "active_users", SqlSecurity: SpannerViewSqlSecurityInvoker, Query: "SELECT id FROM users WHERE active"}
+	got, err := view.CreateDdl()
+	if err != nil {
+		t.Fatalf("CreateDdl() error = %v", err)
+	}
+	if want := "CREATE VIEW `active_users` SQL SECURITY INVOKER AS SELECT id FROM users WHERE active"; got != want {
+		t.Errorf("CreateDdl() = %q, want %q", got, want)
+	}
+
+	// Spanner has no default security type.
+	if _, err := (&SpannerView{Name: "v", Query: "SELECT 1"}).CreateDdl(); err == nil {
+		t.Error("CreateDdl() without SqlSecurity: want error")
+	}
+
+	if got := DropViewDdl("active_users"); got != "DROP VIEW `active_users`" {
+		t.Errorf("DropViewDdl() = %q", got)
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateSpannerBackupSchedule creates a backup schedule with the given ID on
+// the parent database.
+func (s *SpannerService) CreateSpannerBackupSchedule(
+	ctx context.Context,
+	parent string,
+	scheduleId string,
+	schedule conn.BackupSchedule,
+) (*conn.BackupSchedule, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+	if !utils.ValidateArgument(scheduleId, utils.SpannerBackupScheduleIdRegex) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument scheduleId (%s), must match %s", scheduleId, utils.SpannerBackupScheduleIdRegex)
+	}
+	if err := validateBackupSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	return s.conn.CreateBackupSchedule(ctx, parent, scheduleId, schedule)
+}
+
+// GetSpannerBackupSchedule reads a backup schedule. codes.NotFound is
+// returned when the schedule, or its database, does not exist.
+func (s *SpannerService) GetSpannerBackupSchedule(ctx context.Context, name string) (*conn.BackupSchedule, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlBackupScheduleNameRegex,
+		utils.SpannerPostgresSqlBackupScheduleNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	return s.conn.GetBackupSchedule(ctx, name)
+}
+
+// UpdateSpannerBackupSchedule writes the conn.BackupScheduleField* fields
+// named in fields from schedule. The backup type cannot change in place.
+func (s *SpannerService) UpdateSpannerBackupSchedule(
+	ctx context.Context,
+	schedule conn.BackupSchedule,
+	fields []string,
+) (*conn.BackupSchedule, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"schedule.name",
+		schedule.Name,
+		utils.SpannerGoogleSqlBackupScheduleNameRegex,
+		utils.SpannerPostgresSqlBackupScheduleNameRegex,
+	); err != nil {
+		return nil, err
+	}
+	if err := validateBackupSchedule(schedule); err != nil {
+		return nil, err
+	}
+
+	// An empty mask would make the admin API overwrite every field.
+	if len(fields) == 0 {
+		return s.conn.GetBackupSchedule(ctx, schedule.Name)
+	}
+
+	return s.conn.UpdateBackupSchedule(ctx, schedule, fields)
+}
+
+// DeleteSpannerBackupSchedule deletes a backup schedule; backups it already
+// took are kept until they expire.
+func (s *SpannerService) DeleteSpannerBackupSchedule(ctx context.Context, name string) error {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlBackupScheduleNameRegex,
+		utils.SpannerPostgresSqlBackupScheduleNameRegex,
+	); err != nil {
+		return err
+	}
+
+	return s.conn.DeleteBackupSchedule(ctx, name)
+}
+
+// validateBackupSchedule rejects schedules the admin API would refuse, before
+// any call is made.
+func validateBackupSchedule(schedule conn.BackupSchedule) error {
+	if schedule.CronText == "" {
+		return status.Error(codes.InvalidArgument, "Invalid argument schedule.cron_text, field is required but not provided")
+	}
+	if schedule.RetentionDuration <= 0 {
+		return status.Error(codes.InvalidArgument, "Invalid argument schedule.retention_duration, must be positive")
+	}
+
+	hasKey := schedule.KmsKeyName != "" || len(schedule.KmsKeyNames) > 0
+	if hasKey && schedule.EncryptionType != DatabaseEncryptionType_CustomerManaged {
+		return status.Errorf(codes.InvalidArgument,
+			"Invalid argument schedule.encryption_config, KMS keys require encryption type %s", DatabaseEncryptionType_CustomerManaged)
+	}
+	if !hasKey && schedule.EncryptionType == DatabaseEncryptionType_CustomerManaged {
+		return status.Errorf(codes.InvalidArgument,
+			"Invalid argument schedule.encryption_config, encryption type %s requires a KMS key", DatabaseEncryptionType_CustomerManaged)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testBackupSchedule = testDatabase + "/backupSchedules/daily"
+
+func TestBackupScheduleLifecycle(t *testing.T) {
+	fake := connfake.New()
+	svc := NewSpannerService(fake)
+	ctx := context.Background()
+
+	created, err := svc.CreateSpannerBackupSchedule(ctx, testDatabase, "daily", conn.BackupSchedule{
+		CronText:          "0 2 * * *",
+		Incremental:       true,
+		RetentionDuration: 72 * time.Hour,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testBackupSchedule, created.Name)
+	assert.Equal(t, BackupEncryptionType_UseDatabaseEncryption, created.EncryptionType, "unset encryption reads back as the database's")
+
+	_, err = svc.CreateSpannerBackupSchedule(ctx, testDatabase, "daily", conn.BackupSchedule{CronText: "0 2 * * *", RetentionDuration: time.Hour})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Only the masked field changes, even though schedule carries others.
+	updated, err := svc.UpdateSpannerBackupSchedule(ctx, conn.BackupSchedule{
+		Name:              testBackupSchedule,
+		CronText:          "0 4 * * *",
+		RetentionDuration: 24 * time.Hour,
+	}, []string{conn.BackupScheduleFieldRetentionDuration})
+	require.NoError(t, err)
+	assert.Equal(t, "0 2 * * *", updated.CronText)
+	assert.Equal(t, 24*time.Hour, updated.RetentionDuration)
+	assert.True(t, updated.Incremental)
+
+	require.NoError(t, svc.DeleteSpannerBackupSchedule(ctx, testBackupSchedule))
+	_, err = svc.GetSpannerBackupSchedule(ctx, testBackupSchedule)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// The admin API reads an empty update mask as "replace everything", which
+// would silently reset fields Terraform never meant to touch.
+func TestUpdateSpannerBackupSchedule_EmptyMaskIsARead(t *testing.T) {
+	fake := connfake.New()
+	fake.SetBackupSchedule(conn.BackupSchedule{Name: testBackupSchedule, CronText: "0 2 * * *", RetentionDuration: time.Hour})
+
+	got, err := NewSpannerService(fake).UpdateSpannerBackupSchedule(context.Background(), conn.BackupSchedule{
+		Name:              testBackupSchedule,
+		CronText:          "0 4 * * *",
+		RetentionDuration: time.Hour,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0 2 * * *", got.CronText)
+	assert.Empty(t, fake.OpsOf(connfake.OpUpdateBackupSchedule))
+}
+
+func TestCreateSpannerBackupSchedule_Validates(t *testing.T) {
+	tests := []struct {
+		name       string
+		scheduleId string
+		schedule   conn.BackupSchedule
+	}{
+		{
+			name:       "invalid id",
+			scheduleId: "Daily",
+			schedule:   conn.BackupSchedule{CronText: "0 2 * * *", RetentionDuration: time.Hour},
+		},
+		{
+			name:       "missing cron",
+			scheduleId: "daily",
+			schedule:   conn.BackupSchedule{RetentionDuration: time.Hour},
+		},
+		{
+			name:       "missing retention",
+			scheduleId: "daily",
+			schedule:   conn.BackupSchedule{CronText: "0 2 * * *"},
+		},
+		{
+			name:       "key without customer-managed encryption",
+			scheduleId: "daily",
+			schedule: conn.BackupSchedule{
+				CronText:          "0 2 * * *",
+				RetentionDuration: time.Hour,
+				EncryptionType:    BackupEncryptionType_UseDatabaseEncryption,
+				KmsKeyName:        "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+			},
+		},
+		{
+			name:       "customer-managed encryption without key",
+			scheduleId: "daily",
+			schedule: conn.BackupSchedule{
+				CronText:          "0 2 * * *",
+				RetentionDuration: time.Hour,
+				EncryptionType:    DatabaseEncryptionType_CustomerManaged,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := connfake.New()
+
+			_, err := NewSpannerService(fake).CreateSpannerBackupSchedule(context.Background(), testDatabase, tt.scheduleId, tt.schedule)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Empty(t, fake.Ops())
+		})
+	}
+}
+
+// Dropping a database takes its schedules with it; a later read must say so
+// rather than report a schedule that no longer exists.
+func TestGetSpannerBackupSchedule_GoneWithDatabase(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabase(testDatabase, conn.DatabaseInfo{State: DatabaseState_Ready})
+	fake.SetBackupSchedule(conn.BackupSchedule{Name: testBackupSchedule, CronText: "0 2 * * *", RetentionDuration: time.Hour})
+	svc := NewSpannerService(fake)
+
+	require.NoError(t, svc.DeleteSpannerDatabase(context.Background(), testDatabase))
+
+	_, err := svc.GetSpannerBackupSchedule(context.Background(), testBackupSchedule)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Constraint types reported by ListSpannerTableConstraints, spelled as
+// INFORMATION_SCHEMA.TABLE_CONSTRAINTS spells them.
+const (
+	TableConstraintTypeForeignKey = "FOREIGN KEY"
+	TableConstraintTypeCheck      = "CHECK"
+)
+
+// notNullCheckPrefix starts the name of the CHECK constraint Spanner reports
+// for every NOT NULL column. They restate the column definitions rather than
+// constraints anyone declared, so they are left out.
+const notNullCheckPrefix = "CK_IS_NOT_NULL_"
+
+// TableConstraint is a foreign key or check constraint of a table as
+// INFORMATION_SCHEMA reports it. Unlike schema.SpannerTableForeignKeyConstraint
+// it keeps every column of a multi-column foreign key.
+type TableConstraint struct {
+	// The name of the constraint
+	Name string
+	// TableConstraintTypeForeignKey or TableConstraintTypeCheck
+	Type string
+	// Referencing columns of a foreign key, in key order
+	Columns []string
+	// Referenced table of a foreign key
+	ReferencedTable string
+	// Referenced columns of a foreign key, paired with Columns
+	ReferencedColumns []string
+	// Referential action of a foreign key on delete
+	OnDelete schema.SpannerTableConstraintAction
+	// Whether Spanner validates the constraint on writes
+	Enforced bool
+	// Boolean expression of a check constraint
+	CheckClause string
+}
+
+// foreignKeyColumnRow is one referencing/referenced column pair of a foreign
+// key.
+type foreignKeyColumnRow struct {
+	ConstraintName   string `gorm:"column:constraint_name"`
+	Enforced         string `gorm:"column:enforced"`
+	DeleteRule       string `gorm:"column:delete_rule"`
+	ColumnName       string `gorm:"column:column_name"`
+	ReferencedTable  string `gorm:"column:referenced_table"`
+	ReferencedColumn string `gorm:"column:referenced_column"`
+}
+
+// checkConstraintRow is one check constraint.
+type checkConstraintRow struct {
+	ConstraintName string `gorm:"column:constraint_name"`
+	Enforced       string `gorm:"column:enforced"`
+	CheckClause    string `gorm:"column:check_clause"`
+}
+
+// ListSpannerTableConstraints lists the foreign key and check constraints of
+// a table, sorted by name. Primary keys, the unique constraints backing
+// unique indexes and the implicit NOT NULL checks are not included.
+//
+// Params:
+//   - ctx: context.Context - The context to use for RPCs.
+//   - parent: string - Required. The name of the table whose constraints should be listed.
+//
+// Returns: []*TableConstraint.
+func (s *SpannerService) ListSpannerTableConstraints(ctx context.Context, parent string) ([]*TableConstraint, error) {
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlTableNameRegex,
+		utils.SpannerPostgresSqlTableNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	parentName, err := names.ParseTable(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+	database, _, schemaName, err := s.defaultSchema(ctx, parentName.DatabaseName().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var fkRows []*foreignKeyColumnRow
+	if err := s.conn.Query(
+		ctx, database.String(), &fkRows,
+		"SELECT tc.constraint_name AS constraint_name,"+
+			" tc.enforced AS enforced,"+
+			" rc.delete_rule AS delete_rule,"+
+			" kcu.column_name AS column_name,"+
+			" ukcu.table_name AS referenced_table,"+
+			" ukcu.column_name AS referenced_column"+
+			" FROM information_schema.table_constraints tc"+
+			" JOIN information_schema.referential_constraints rc"+
+			" ON rc.constraint_schema = tc.constraint_schema AND rc.constraint_name = tc.constraint_name"+
+			" JOIN information_schema.key_column_usage kcu"+
+			" ON kcu.constraint_schema = tc.constraint_schema AND kcu.constraint_name = tc.constraint_name"+
+			" JOIN information_schema.key_column_usage ukcu"+
+			" ON ukcu.constraint_schema = rc.unique_constraint_schema AND ukcu.constraint_name = rc.unique_constraint_name"+
+			" AND ukcu.ordinal_position = kcu.position_in_unique_constraint"+
+			" WHERE tc.table_schema = ? AND tc.table_name = ? AND tc.constraint_type = 'FOREIGN KEY'"+
+			" ORDER BY tc.constraint_name, kcu.ordinal_position",
+		schemaName, parentName.Table,
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing foreign key constraints: %v", err)
+	}
+
+	var checkRows []*checkConstraintRow
+	if err := s.conn.Query(
+		ctx, database.String(), &checkRows,
+		"SELECT tc.constraint_name AS constraint_name,"+
+			" tc.enforced AS enforced,"+
+			" cc.check_clause AS check_clause"+
+			" FROM information_schema.table_constraints tc"+
+			" JOIN information_schema.check_constraints cc"+
+			" ON cc.constraint_schema = tc.constraint_schema AND cc.constraint_name = tc.constraint_name"+
+			" WHERE tc.table_schema = ? AND tc.table_name = ? AND tc.constraint_type = 'CHECK'"+
+			" ORDER BY tc.constraint_name",
+		schemaName, parentName.Table,
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing check constraints: %v", err)
+	}
+
+	constraints := make([]*TableConstraint, 0, len(checkRows))
+	byName := map[string]*TableConstraint{}
+	for _, row := range fkRows {
+		constraint, ok := byName[row.ConstraintName]
+		if !ok {
+			constraint = &TableConstraint{
+				Name:     row.ConstraintName,
+				Type:     TableConstraintTypeForeignKey,
+				OnDelete: schema.SpannerTableConstraintActionFromString(row.DeleteRule),
+				Enforced: row.Enforced != "NO",
+				// Every column of a foreign key references the same table.
+				ReferencedTable: row.ReferencedTable,
+			}
+			byName[row.ConstraintName] = constraint
+			constraints = append(constraints, constraint)
+		}
+		constraint.Columns = append(constraint.Columns, row.ColumnName)
+		constraint.ReferencedColumns = append(constraint.ReferencedColumns, row.ReferencedColumn)
+	}
+	for _, row := range checkRows {
+		if strings.HasPrefix(row.ConstraintName, notNullCheckPrefix) {
+			continue
+		}
+		constraints = append(constraints, &TableConstraint{
+			Name:        row.ConstraintName,
+			Type:        TableConstraintTypeCheck,
+			Enforced:    row.Enforced != "NO",
+			CheckClause: row.CheckClause,
+		})
+	}
+
+	sort.SliceStable(constraints, func(i, j int) bool { return constraints[i].Name < constraints[j].Name })
+
+	return constraints, nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Foreign keys come back one row per column and are merged in key order;
+// the checks Spanner derives from NOT NULL columns are not constraints
+// anyone declared.
+func TestListSpannerTableConstraints(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("constraint_type = 'FOREIGN KEY'", []*foreignKeyColumnRow{
+		{ConstraintName: "FK_orders_users", Enforced: "YES", DeleteRule: "CASCADE", ColumnName: "tenant_id", ReferencedTable: "users", ReferencedColumn: "tenant_id"},
+		{ConstraintName: "FK_orders_users", Enforced: "YES", DeleteRule: "CASCADE", ColumnName: "user_id", ReferencedTable: "users", ReferencedColumn: "id"},
+	})
+	fake.OnQuery("constraint_type = 'CHECK'", []*checkConstraintRow{
+		{ConstraintName: "CK_IS_NOT_NULL_orders_id", Enforced: "YES", CheckClause: "id IS NOT NULL"},
+		{ConstraintName: "CK_positive_total", Enforced: "NO", CheckClause: "total > 0"},
+	})
+
+	got, err := NewSpannerService(fake).ListSpannerTableConstraints(context.Background(), testTable)
+	require.NoError(t, err)
+	assert.Equal(t, []*TableConstraint{
+		{
+			Name:        "CK_positive_total",
+			Type:        TableConstraintTypeCheck,
+			CheckClause: "total > 0",
+		},
+		{
+			Name:              "FK_orders_users",
+			Type:              TableConstraintTypeForeignKey,
+			Columns:           []string{"tenant_id", "user_id"},
+			ReferencedTable:   "users",
+			ReferencedColumns: []string{"tenant_id", "id"},
+			OnDelete:          schema.SpannerTableConstraintActionCascade,
+			Enforced:          true,
+		},
+	}, got)
+
+	for _, query := range fake.OpsOf(connfake.OpQuery) {
+		assert.Equal(t, []any{"", "tftest_table"}, query.Params)
+	}
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+)
+
+// ErrExtraStatementsNotApplied is wrapped by the error CreateSpannerDatabase
+// returns when a PostgreSQL-dialect database was created but its extra
+// statements failed to apply.
+var ErrExtraStatementsNotApplied = errors.New("extra statements were not applied")
+
+// CreateSpannerDatabase creates the database name with the creation-time
+// settings in spec, then enables drop protection when asked to. Spanner does
+// not take extra statements with a PostgreSQL-dialect create, so those are
+// applied as a separate schema update once the database exists. Either of
+// these follow-up steps can fail after the database already exists; the
+// caller gets both the error and the created database in that case.
+func (s *SpannerService) CreateSpannerDatabase(
+	ctx context.Context,
+	name string,
+	spec conn.DatabaseSpec,
+	enableDropProtection bool,
+) (*conn.DatabaseInfo, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	// Each step is its own call, and so its own retry loop: a transient
+	// failure of the schema update must not run the create a second time.
+	create := spec
+	if spec.Dialect == conn.DialectPostgreSQL {
+		create.ExtraStatements, create.ProtoDescriptors = nil, nil
+	}
+	if err := s.conn.CreateDatabase(ctx, name, create); err != nil {
+		return nil, err
+	}
+
+	if spec.Dialect == conn.DialectPostgreSQL && len(spec.ExtraStatements) > 0 {
+		if err := s.conn.ExecuteDDLWithDescriptors(ctx, name, spec.ProtoDescriptors, spec.ExtraStatements...); err != nil {
+			info, _ := s.conn.GetDatabase(ctx, name)
+			return info, fmt.Errorf("%w: %w", ErrExtraStatementsNotApplied, err)
+		}
+	}
+
+	if enableDropProtection {
+		if err := s.conn.SetDatabaseDropProtection(ctx, name, true); err != nil {
+			info, _ := s.conn.GetDatabase(ctx, name)
+			return info, err
+		}
+	}
+
+	return s.conn.GetDatabase(ctx, name)
+}
+
+// GetSpannerDatabase reads a database's admin metadata. codes.NotFound is
+// returned when the database does not exist.
+func (s *SpannerService) GetSpannerDatabase(ctx context.Context, name string) (*conn.DatabaseInfo, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	return s.conn.GetDatabase(ctx, name)
+}
+
+// UpdateSpannerDatabaseDropProtection turns the database's drop protection on
+// or off and returns the database as it reads afterwards.
+func (s *SpannerService) UpdateSpannerDatabaseDropProtection(
+	ctx context.Context,
+	name string,
+	enabled bool,
+) (*conn.DatabaseInfo, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := s.conn.SetDatabaseDropProtection(ctx, name, enabled); err != nil {
+		return nil, err
+	}
+
+	return s.conn.GetDatabase(ctx, name)
+}
+
+// DeleteSpannerDatabase drops the database and all of its data. Spanner
+// refuses with codes.FailedPrecondition while drop protection is on.
+func (s *SpannerService) DeleteSpannerDatabase(ctx context.Context, name string) error {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return err
+	}
+
+	return s.conn.DropDatabase(ctx, name)
+}
+
+// GetSpannerDatabaseDdl reads the database's schema as Spanner reports it,
+// together with its fingerprint (see schema.DdlFingerprint). codes.NotFound
+// is returned when the database does not exist.
+func (s *SpannerService) GetSpannerDatabaseDdl(ctx context.Context, name string) (*DatabaseDdl, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	statements, protoDescriptors, err := s.conn.DatabaseDdl(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Quoted text escapes differently per dialect, so each normalizes its own
+	// way before fingerprinting.
+	dialect, err := s.conn.Dialect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := schema.DdlFingerprint
+	if dialect == conn.DialectPostgreSQL {
+		fingerprint = schema.PostgresDdlFingerprint
+	}
+
+	return &DatabaseDdl{
+		Statements:       statements,
+		ProtoDescriptors: protoDescriptors,
+		Fingerprint:      fingerprint(statements, protoDescriptors),
+	}, nil
+}
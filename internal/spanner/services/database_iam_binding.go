@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IAM roles that fine-grained access control is granted through. The first
+// lets a principal use fine-grained access control on the database at all;
+// the second, conditioned on database roles, picks which roles it may act as.
+const (
+	DatabaseIamRole_FineGrainedAccessUser = "roles/spanner.fineGrainedAccessUser"
+	DatabaseIamRole_DatabaseRoleUser      = "roles/spanner.databaseRoleUser"
+)
+
+// databaseIamPolicyAttempts bounds how often a database IAM policy change is
+// re-read and re-applied after losing a race with another writer.
+const databaseIamPolicyAttempts = 5
+
+// GetDatabaseIamBinding reads the database IAM binding named by name: the
+// binding of its role under the named condition title, or the role's
+// unconditional binding when the name has no title. Members are sorted.
+// codes.NotFound is returned when the policy has no such binding.
+func (s *SpannerService) GetDatabaseIamBinding(ctx context.Context, name string) (*conn.IamBinding, error) {
+	bindingName, err := parseDatabaseIamBindingName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.conn.GetDatabaseIamPolicy(ctx, bindingName.DatabaseName().String())
+	if err != nil {
+		return nil, err
+	}
+
+	// A policy edited by hand can hold the same role and condition twice;
+	// they read as one binding, as SetDatabaseIamBinding would leave them.
+	var found *conn.IamBinding
+	for _, binding := range policy.Bindings {
+		if !databaseIamBindingIs(binding, bindingName.Role, bindingName.ConditionTitle) {
+			continue
+		}
+		if found == nil {
+			found = &conn.IamBinding{Role: binding.Role, Condition: binding.Condition}
+		}
+		found.Members = append(found.Members, binding.Members...)
+	}
+	if found == nil {
+		return nil, status.Errorf(codes.NotFound, "Database IAM binding (%s) not found", name)
+	}
+	found.Members = sortedMembers(found.Members)
+
+	return found, nil
+}
+
+// SetDatabaseIamBinding makes binding the database's only IAM binding for its
+// role and condition title, leaving every other binding as found. The policy
+// is read and written back under its etag; when another writer changes it in
+// between, the change is re-applied to a fresh read.
+func (s *SpannerService) SetDatabaseIamBinding(ctx context.Context, parent string, binding conn.IamBinding) (*conn.IamBinding, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+	if binding.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "Invalid argument binding.role, field is required but not provided")
+	}
+	if len(binding.Members) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid argument binding.members, at least one member is required")
+	}
+	if binding.Condition != nil && (binding.Condition.Title == "" || binding.Condition.Expression == "") {
+		return nil, status.Error(codes.InvalidArgument, "Invalid argument binding.condition, title and expression are required")
+	}
+
+	binding.Members = sortedMembers(binding.Members)
+	title := databaseIamConditionTitle(binding)
+	if _, err := s.modifyDatabaseIamPolicy(ctx, parent, func(policy *conn.IamPolicy) bool {
+		policy.Bindings = slices.DeleteFunc(policy.Bindings, func(b conn.IamBinding) bool {
+			return databaseIamBindingIs(b, binding.Role, title)
+		})
+		policy.Bindings = append(policy.Bindings, binding)
+
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return &binding, nil
+}
+
+// DeleteDatabaseIamBinding removes the database IAM binding named by name,
+// leaving every other binding as found. Removing a binding the policy does
+// not hold is not an error.
+func (s *SpannerService) DeleteDatabaseIamBinding(ctx context.Context, name string) error {
+	bindingName, err := parseDatabaseIamBindingName(name)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.modifyDatabaseIamPolicy(ctx, bindingName.DatabaseName().String(), func(policy *conn.IamPolicy) bool {
+		before := len(policy.Bindings)
+		policy.Bindings = slices.DeleteFunc(policy.Bindings, func(b conn.IamBinding) bool {
+			return databaseIamBindingIs(b, bindingName.Role, bindingName.ConditionTitle)
+		})
+
+		return len(policy.Bindings) != before
+	})
+
+	return err
+}
+
+// modifyDatabaseIamPolicy applies change to the database's IAM policy as a
+// read-modify-write guarded by the policy's etag. change reports whether it
+// changed the policy; when it did not, nothing is written. A write rejected
+// because the policy moved on (codes.Aborted) is retried from a fresh read,
+// up to databaseIamPolicyAttempts times. This is the one retry loop outside
+// the Connection: the Connection cannot replay a stale etag usefully, only
+// the caller can re-apply its change.
+func (s *SpannerService) modifyDatabaseIamPolicy(ctx context.Context, database string, change func(*conn.IamPolicy) bool) (*conn.IamPolicy, error) {
+	var err error
+	for attempt := 1; attempt <= databaseIamPolicyAttempts; attempt++ {
+		var policy *conn.IamPolicy
+		policy, err = s.conn.GetDatabaseIamPolicy(ctx, database)
+		if err != nil {
+			return nil, err
+		}
+		if !change(policy) {
+			return policy, nil
+		}
+
+		var stored *conn.IamPolicy
+		stored, err = s.conn.SetDatabaseIamPolicy(ctx, database, *policy)
+		if status.Code(err) != codes.Aborted {
+			return stored, err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, status.Errorf(codes.Aborted, "IAM policy of database %s kept changing concurrently; gave up after %d attempts: %v", database, databaseIamPolicyAttempts, err)
+}
+
+// parseDatabaseIamBindingName validates and parses a database IAM binding
+// name.
+func parseDatabaseIamBindingName(name string) (names.DatabaseIamBindingName, error) {
+	bindingName, err := names.ParseDatabaseIamBinding(name)
+	if err != nil {
+		return names.DatabaseIamBindingName{}, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+	}
+	if err := utils.ValidateDialectArgument(
+		"name",
+		bindingName.DatabaseName().String(),
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return names.DatabaseIamBindingName{}, err
+	}
+
+	return bindingName, nil
+}
+
+// databaseIamBindingIs reports whether binding is the one of role under the
+// condition titled title, where an empty title means no condition.
+func databaseIamBindingIs(binding conn.IamBinding, role, title string) bool {
+	return binding.Role == role && databaseIamConditionTitle(binding) == title
+}
+
+// databaseIamConditionTitle returns the title of binding's condition, empty
+// for an unconditional binding.
+func databaseIamConditionTitle(binding conn.IamBinding) string {
+	if binding.Condition == nil {
+		return ""
+	}
+
+	return binding.Condition.Title
+}
+
+// sortedMembers returns members sorted with duplicates removed.
+func sortedMembers(members []string) []string {
+	sorted := slices.Clone(members)
+	slices.Sort(sorted)
+
+	return slices.Compact(sorted)
+}
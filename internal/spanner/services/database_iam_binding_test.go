@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testAnalystCondition = &conn.IamCondition{
+	Title:      "analyst",
+	Expression: `resource.type == "spanner.googleapis.com/DatabaseRole" && resource.name.endsWith("/databaseRoles/analyst")`,
+}
+
+// racingConn lets another writer change the database IAM policy right after
+// each of the first races reads of it, so every write made from those reads
+// carries a stale etag.
+type racingConn struct {
+	*connfake.Fake
+	races int
+	write func()
+}
+
+func (c *racingConn) GetDatabaseIamPolicy(ctx context.Context, database string) (*conn.IamPolicy, error) {
+	policy, err := c.Fake.GetDatabaseIamPolicy(ctx, database)
+	if err == nil && c.races > 0 {
+		c.races--
+		c.write()
+	}
+	return policy, err
+}
+
+func TestDatabaseIamBindingLifecycle(t *testing.T) {
+	fake := connfake.New()
+	unrelated := conn.IamBinding{Role: "roles/spanner.databaseReader", Members: []string{"group:readers@example.com"}}
+	fake.SetDatabaseIamBindings(testDatabase, []conn.IamBinding{unrelated})
+	svc := NewSpannerService(fake)
+	ctx := context.Background()
+
+	_, err := svc.SetDatabaseIamBinding(ctx, testDatabase, conn.IamBinding{
+		Role:    DatabaseIamRole_FineGrainedAccessUser,
+		Members: []string{"user:b@example.com", "user:a@example.com", "user:a@example.com"},
+	})
+	require.NoError(t, err)
+	_, err = svc.SetDatabaseIamBinding(ctx, testDatabase, conn.IamBinding{
+		Role:      DatabaseIamRole_DatabaseRoleUser,
+		Members:   []string{"user:a@example.com"},
+		Condition: testAnalystCondition,
+	})
+	require.NoError(t, err)
+
+	got, err := svc.GetDatabaseIamBinding(ctx, testDatabase+"/iamBindings/"+DatabaseIamRole_FineGrainedAccessUser)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user:a@example.com", "user:b@example.com"}, got.Members)
+	assert.Nil(t, got.Condition)
+
+	conditional := testDatabase + "/iamBindings/" + DatabaseIamRole_DatabaseRoleUser + "/conditions/analyst"
+	got, err = svc.GetDatabaseIamBinding(ctx, conditional)
+	require.NoError(t, err)
+	assert.Equal(t, testAnalystCondition, got.Condition)
+
+	// The role's unconditional binding is a different binding.
+	_, err = svc.GetDatabaseIamBinding(ctx, testDatabase+"/iamBindings/"+DatabaseIamRole_DatabaseRoleUser)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, svc.DeleteDatabaseIamBinding(ctx, conditional))
+	_, err = svc.GetDatabaseIamBinding(ctx, conditional)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	policy, err := fake.GetDatabaseIamPolicy(ctx, testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, []conn.IamBinding{
+		unrelated,
+		{Role: DatabaseIamRole_FineGrainedAccessUser, Members: []string{"user:a@example.com", "user:b@example.com"}},
+	}, policy.Bindings)
+}
+
+// A binding added by someone else between the read and the write survives:
+// the stale write is rejected and the change re-applied to a fresh read.
+func TestSetDatabaseIamBinding_ReappliesAfterConcurrentChange(t *testing.T) {
+	fake := connfake.New()
+	concurrent := conn.IamBinding{Role: "roles/spanner.databaseReader", Members: []string{"user:c@example.com"}}
+	racing := &racingConn{Fake: fake, races: 2, write: func() {
+		fake.SetDatabaseIamBindings(testDatabase, []conn.IamBinding{concurrent})
+	}}
+
+	_, err := NewSpannerService(racing).SetDatabaseIamBinding(context.Background(), testDatabase, conn.IamBinding{
+		Role:    DatabaseIamRole_FineGrainedAccessUser,
+		Members: []string{"user:a@example.com"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, fake.OpsOf(connfake.OpSetDatabaseIamPolicy), 3)
+
+	policy, err := fake.GetDatabaseIamPolicy(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, []conn.IamBinding{
+		concurrent,
+		{Role: DatabaseIamRole_FineGrainedAccessUser, Members: []string{"user:a@example.com"}},
+	}, policy.Bindings)
+}
+
+func TestSetDatabaseIamBinding_GivesUpUnderConstantContention(t *testing.T) {
+	fake := connfake.New()
+	fake.FailNext(connfake.OpSetDatabaseIamPolicy, databaseIamPolicyAttempts, status.Error(codes.Aborted, "etag mismatch"))
+
+	_, err := NewSpannerService(fake).SetDatabaseIamBinding(context.Background(), testDatabase, conn.IamBinding{
+		Role:    DatabaseIamRole_FineGrainedAccessUser,
+		Members: []string{"user:a@example.com"},
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Len(t, fake.OpsOf(connfake.OpGetDatabaseIamPolicy), databaseIamPolicyAttempts)
+}
+
+// Deleting a binding the policy does not hold writes nothing, so it cannot
+// race anyone.
+func TestDeleteDatabaseIamBinding_MissingIsANoop(t *testing.T) {
+	fake := connfake.New()
+
+	require.NoError(t, NewSpannerService(fake).DeleteDatabaseIamBinding(context.Background(), testDatabase+"/iamBindings/"+DatabaseIamRole_DatabaseRoleUser))
+	assert.Empty(t, fake.OpsOf(connfake.OpSetDatabaseIamPolicy))
+}
+
+func TestSetDatabaseIamBinding_InvalidArguments(t *testing.T) {
+	svc := NewSpannerService(connfake.New())
+	ctx := context.Background()
+
+	for name, binding := range map[string]conn.IamBinding{
+		"no role":          {Members: []string{"user:a@example.com"}},
+		"no members":       {Role: DatabaseIamRole_FineGrainedAccessUser},
+		"untitled":         {Role: DatabaseIamRole_DatabaseRoleUser, Members: []string{"user:a@example.com"}, Condition: &conn.IamCondition{Expression: "true"}},
+		"empty expression": {Role: DatabaseIamRole_DatabaseRoleUser, Members: []string{"user:a@example.com"}, Condition: &conn.IamCondition{Title: "t"}},
+	} {
+		_, err := svc.SetDatabaseIamBinding(ctx, testDatabase, binding)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// GetSpannerDatabaseOptions reads the database-level options back from
+// INFORMATION_SCHEMA.DATABASE_OPTIONS. An option the database does not report
+// is left nil. codes.NotFound is returned when the database does not exist.
+func (s *SpannerService) GetSpannerDatabaseOptions(ctx context.Context, name string) (*schema.SpannerDatabaseOptions, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	// Verify the database exists before querying it
+	if _, err := s.conn.Dialect(ctx, name); err != nil {
+		return nil, err
+	}
+
+	var rows []*DatabaseOptionRow
+	if err := s.conn.Query(
+		ctx,
+		name,
+		&rows,
+		"SELECT OPTION_NAME, OPTION_TYPE, OPTION_VALUE FROM INFORMATION_SCHEMA.DATABASE_OPTIONS",
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting database options: %v", err)
+	}
+
+	options := &schema.SpannerDatabaseOptions{}
+	for _, row := range rows {
+		if row.OptionValue == nil {
+			continue
+		}
+
+		// PostgreSQL databases report options under their spanner.* parameter
+		// names, and string values may come back as quoted literals.
+		optionName := strings.TrimPrefix(strings.ToLower(row.OptionName), "spanner.")
+		optionValue := strings.Trim(*row.OptionValue, `"'`)
+
+		switch optionName {
+		case schema.DatabaseOptionVersionRetentionPeriod:
+			options.VersionRetentionPeriod = wrapperspb.String(optionValue)
+		case schema.DatabaseOptionOptimizerVersion:
+			version, err := strconv.ParseInt(optionValue, 10, 64)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Error parsing optimizer_version: %v", err)
+			}
+			options.OptimizerVersion = wrapperspb.Int64(version)
+		case schema.DatabaseOptionOptimizerStatisticsPackage:
+			options.OptimizerStatisticsPackage = wrapperspb.String(optionValue)
+		case schema.DatabaseOptionDefaultLeader:
+			options.DefaultLeader = wrapperspb.String(optionValue)
+		case schema.DatabaseOptionDefaultSequenceKind:
+			options.DefaultSequenceKind = wrapperspb.String(optionValue)
+		case schema.DatabaseOptionDefaultTimeZone:
+			options.DefaultTimeZone = wrapperspb.String(optionValue)
+		}
+	}
+
+	return options, nil
+}
+
+// UpdateSpannerDatabaseOptions sets every non-nil option in options and
+// resets each option named in reset to its Spanner default, in one ALTER
+// DATABASE batch, then returns the options as the database reports them.
+func (s *SpannerService) UpdateSpannerDatabaseOptions(
+	ctx context.Context,
+	name string,
+	options *schema.SpannerDatabaseOptions,
+	reset []string,
+) (*schema.SpannerDatabaseOptions, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	databaseName, err := names.ParseDatabase(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+	}
+
+	dialect, err := s.conn.Dialect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	ddl := options.AlterDdl(databaseName.Database, reset)
+	if dialect == conn.DialectPostgreSQL {
+		ddl = options.AlterPostgresDdl(databaseName.Database, reset)
+	}
+	if len(ddl) > 0 {
+		if err := s.conn.ExecuteDDL(ctx, name, ddl...); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.GetSpannerDatabaseOptions(ctx, name)
+}
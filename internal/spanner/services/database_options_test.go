@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func strPtr(s string) *string { return &s }
+
+// PostgreSQL databases report spanner.* parameter names, and string options
+// may arrive quoted; both have to land on the same fields GoogleSQL fills.
+func TestGetSpannerDatabaseOptions_NormalizesRows(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("DATABASE_OPTIONS", []*DatabaseOptionRow{
+		{OptionName: "version_retention_period", OptionType: "STRING", OptionValue: strPtr("7d")},
+		{OptionName: "spanner.optimizer_version", OptionType: "INT64", OptionValue: strPtr("6")},
+		{OptionName: "default_leader", OptionType: "STRING", OptionValue: strPtr(`"us-east1"`)},
+		{OptionName: "default_time_zone", OptionType: "STRING"},
+		{OptionName: "some_future_option", OptionType: "STRING", OptionValue: strPtr("x")},
+	})
+
+	got, err := NewSpannerService(fake).GetSpannerDatabaseOptions(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, "7d", got.GetVersionRetentionPeriod().GetValue())
+	assert.Equal(t, int64(6), got.GetOptimizerVersion().GetValue())
+	assert.Equal(t, "us-east1", got.GetDefaultLeader().GetValue())
+	assert.Nil(t, got.GetDefaultTimeZone(), "a NULL value is an unset option")
+	assert.Nil(t, got.GetOptimizerStatisticsPackage())
+}
+
+func TestUpdateSpannerDatabaseOptions_SetsAndResetsInOneBatch(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).UpdateSpannerDatabaseOptions(context.Background(), testDatabase,
+		&schema.SpannerDatabaseOptions{VersionRetentionPeriod: wrapperspb.String("2d")},
+		[]string{schema.DatabaseOptionOptimizerVersion},
+	)
+	require.NoError(t, err)
+
+	ops := fake.OpsOf(connfake.OpExecuteDDL)
+	require.Len(t, ops, 1)
+	assert.Equal(t, []string{
+		"ALTER DATABASE `test-db` SET OPTIONS (version_retention_period = '2d', optimizer_version = NULL)",
+	}, ops[0].Statements)
+}
+
+func TestUpdateSpannerDatabaseOptions_PostgreSQL(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+
+	_, err := NewSpannerService(fake).UpdateSpannerDatabaseOptions(context.Background(), testDatabase,
+		&schema.SpannerDatabaseOptions{OptimizerVersion: wrapperspb.Int64(5)},
+		[]string{schema.DatabaseOptionDefaultTimeZone},
+	)
+	require.NoError(t, err)
+
+	fake.AssertSubsequence(t,
+		`ALTER DATABASE "test-db" SET spanner.optimizer_version = 5`,
+		`ALTER DATABASE "test-db" RESET spanner.default_time_zone`,
+	)
+}
+
+func TestUpdateSpannerDatabaseOptions_NothingToChange(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).UpdateSpannerDatabaseOptions(context.Background(), testDatabase, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL))
+}
@@ -3,7 +3,9 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"terraform-provider-alis/internal/spanner/conn"
 	"terraform-provider-alis/internal/spanner/names"
 	"terraform-provider-alis/internal/spanner/schema"
 	"terraform-provider-alis/internal/utils"
@@ -49,6 +51,7 @@ func (s *SpannerService) CreateDatabaseRole(ctx context.Context, parent, roleId
 // GetDatabaseRole fetches a database role by its full resource name. The
 // Admin API has no per-role lookup, so every role in the database is listed
 // and matched by name; codes.NotFound is returned if the role is absent.
+// Backends without the admin listing are read as databaseRoles describes.
 func (s *SpannerService) GetDatabaseRole(ctx context.Context, name string) (*databasepb.DatabaseRole, error) {
 	if err := utils.ValidateDialectArgument(
 		"name",
@@ -66,7 +69,7 @@ func (s *SpannerService) GetDatabaseRole(ctx context.Context, name string) (*dat
 	database := roleName.DatabaseName().String()
 
 	// List all roles (unpaged) and find the requested one.
-	roleNames, _, err := s.conn.DatabaseRoles(ctx, database, 0, "")
+	roleNames, _, err := s.databaseRoles(ctx, database, 0, "")
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +84,8 @@ func (s *SpannerService) GetDatabaseRole(ctx context.Context, name string) (*dat
 }
 
 // ListDatabaseRoles lists the roles of the parent database one page at a
-// time; the returned page token is empty on the final page.
+// time; the returned page token is empty on the final page. Page tokens are
+// only meaningful to the backend that issued them.
 func (s *SpannerService) ListDatabaseRoles(
 	ctx context.Context,
 	parent string,
@@ -97,7 +101,7 @@ func (s *SpannerService) ListDatabaseRoles(
 		return nil, "", err
 	}
 
-	roleNames, nextPageToken, err := s.conn.DatabaseRoles(ctx, parent, pageSize, pageToken)
+	roleNames, nextPageToken, err := s.databaseRoles(ctx, parent, pageSize, pageToken)
 	if err != nil {
 		return nil, "", err
 	}
@@ -110,29 +114,323 @@ func (s *SpannerService) ListDatabaseRoles(
 	return res, nextPageToken, nil
 }
 
+// roleNameRow is one row of INFORMATION_SCHEMA.ROLES (ENABLED_ROLES in
+// PostgreSQL databases).
+type roleNameRow struct {
+	RoleName string `gorm:"column:role_name"`
+}
+
+// databaseRoles lists role resource names through the admin API. Backends that
+// do not implement it, such as the emulator, answer codes.Unimplemented; the
+// roles are then read from INFORMATION_SCHEMA instead, paged by name: the page
+// token is the last role of the previous page.
+func (s *SpannerService) databaseRoles(ctx context.Context, database string, pageSize int32, pageToken string) ([]string, string, error) {
+	roleNames, nextPageToken, err := s.conn.DatabaseRoles(ctx, database, pageSize, pageToken)
+	if status.Code(err) != codes.Unimplemented {
+		return roleNames, nextPageToken, err
+	}
+
+	dialect, err := s.conn.Dialect(ctx, database)
+	if err != nil {
+		return nil, "", err
+	}
+	view := "information_schema.roles"
+	if dialect == conn.DialectPostgreSQL {
+		view = "information_schema.enabled_roles"
+	}
+
+	sql := "SELECT role_name FROM " + view
+	var params []any
+	if pageToken != "" {
+		sql += " WHERE role_name > ?"
+		params = append(params, pageToken)
+	}
+	sql += " ORDER BY role_name"
+	// One row past the page tells whether another page follows.
+	if pageSize > 0 {
+		sql += fmt.Sprintf(" LIMIT %d", pageSize+1)
+	}
+
+	var rows []*roleNameRow
+	if err := s.conn.Query(ctx, database, &rows, sql, params...); err != nil {
+		return nil, "", status.Errorf(codes.Internal, "Error listing database roles: %v", err)
+	}
+
+	nextPageToken = ""
+	if pageSize > 0 && len(rows) > int(pageSize) {
+		rows = rows[:pageSize]
+		nextPageToken = rows[len(rows)-1].RoleName
+	}
+	roleNames = make([]string, 0, len(rows))
+	for _, row := range rows {
+		roleNames = append(roleNames, database+"/databaseRoles/"+row.RoleName)
+	}
+
+	return roleNames, nextPageToken, nil
+}
+
 // DeleteDatabaseRole removes a database role by issuing DROP ROLE DDL in its
-// database.
+// database. Spanner rejects DROP ROLE while the role still holds privileges
+// or role memberships; when it does, the role's grants are looked up and
+// codes.FailedPrecondition lists the REVOKE statements that would clear them.
 func (s *SpannerService) DeleteDatabaseRole(ctx context.Context, name string) error {
+	roleName, err := parseDatabaseRoleName(name)
+	if err != nil {
+		return err
+	}
+	roleId := roleName.Role
+	database := roleName.DatabaseName().String()
+
+	// Verify the database exists before issuing DDL
+	dialect, err := s.conn.Dialect(ctx, database)
+	if err != nil {
+		return err
+	}
+
+	dropErr := s.conn.ExecuteDDL(ctx, database, schema.DropRoleDdl(roleId))
+	if dropErr == nil {
+		return nil
+	}
+
+	// Only explain the failure when the grants can be read; otherwise
+	// Spanner's own error is the best there is.
+	dependents, err := s.databaseRoleDependents(ctx, database, dialect, roleId)
+	if err != nil {
+		return dropErr
+	}
+	revokes := dependents.RevokeStatements(roleId)
+	if len(revokes) == 0 {
+		return dropErr
+	}
+
+	return status.Errorf(
+		codes.FailedPrecondition,
+		"Role %s is still referenced by grants; revoke them before dropping it:\n  %s",
+		roleId, strings.Join(revokes, "\n  "),
+	)
+}
+
+// ForceDeleteDatabaseRole removes a database role together with everything
+// that references it: its privileges, the roles granted to it and its grants
+// to other roles are revoked and the role dropped in a single DDL batch, so a
+// failure leaves the role as it was.
+func (s *SpannerService) ForceDeleteDatabaseRole(ctx context.Context, name string) error {
+	roleName, err := parseDatabaseRoleName(name)
+	if err != nil {
+		return err
+	}
+	roleId := roleName.Role
+	database := roleName.DatabaseName().String()
+
+	// Verify the database exists before issuing DDL
+	dialect, err := s.conn.Dialect(ctx, database)
+	if err != nil {
+		return err
+	}
+
+	dependents, err := s.databaseRoleDependents(ctx, database, dialect, roleId)
+	if err != nil {
+		return err
+	}
+
+	return s.conn.ExecuteDDL(ctx, database, append(dependents.RevokeStatements(roleId), schema.DropRoleDdl(roleId))...)
+}
+
+// parseDatabaseRoleName validates and parses a role name.
+func parseDatabaseRoleName(name string) (names.DatabaseRoleName, error) {
 	if err := utils.ValidateDialectArgument(
 		"name",
 		name,
 		utils.SpannerGoogleSqlDatabaseRoleNameRegex,
 		utils.SpannerPostgresSqlDatabaseRoleNameRegex,
 	); err != nil {
-		return err
+		return names.DatabaseRoleName{}, err
 	}
 
 	roleName, err := names.ParseDatabaseRole(name)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+		return names.DatabaseRoleName{}, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
 	}
-	roleId := roleName.Role
-	database := roleName.DatabaseName().String()
+
+	return roleName, nil
+}
+
+// DatabaseRoleDependents is everything that keeps DROP ROLE from succeeding.
+type DatabaseRoleDependents struct {
+	// Privileges granted directly to the role.
+	Privileges []*RolePrivilege
+	// Roles granted to the role.
+	GrantedRoles []string
+	// Roles the role is granted to.
+	Members []string
+}
+
+// RevokeStatements renders the REVOKE statements that clear the dependents of
+// role: one per privileged object, then one per role membership.
+func (d *DatabaseRoleDependents) RevokeStatements(role string) []string {
+	type object struct{ kind, name string }
+	var objects []object
+	operands := map[object][]string{}
+	add := func(o object, operand string) {
+		if _, ok := operands[o]; !ok {
+			objects = append(objects, o)
+		}
+		operands[o] = append(operands[o], operand)
+	}
+
+	// Columns of one table and privilege share a single operand.
+	type columnPrivilege struct{ table, privilege string }
+	var columnPrivileges []columnPrivilege
+	columns := map[columnPrivilege][]string{}
+	for _, privilege := range d.Privileges {
+		switch privilege.ObjectType {
+		case RolePrivilegeObjectTable:
+			add(object{"TABLE", privilege.Object}, privilege.Privilege)
+		case RolePrivilegeObjectColumn:
+			key := columnPrivilege{privilege.Object, privilege.Privilege}
+			if _, ok := columns[key]; !ok {
+				columnPrivileges = append(columnPrivileges, key)
+			}
+			columns[key] = append(columns[key], privilege.Column)
+		case RolePrivilegeObjectView:
+			add(object{"VIEW", privilege.Object}, privilege.Privilege)
+		case RolePrivilegeObjectChangeStream:
+			add(object{"CHANGE STREAM", privilege.Object}, privilege.Privilege)
+		case RolePrivilegeObjectFunction:
+			add(object{"FUNCTION", privilege.Object}, privilege.Privilege)
+		}
+	}
+	for _, key := range columnPrivileges {
+		add(object{"TABLE", key.table}, schema.ColumnPrivilege(key.privilege, columns[key]))
+	}
+
+	statements := make([]string, 0, len(objects)+len(d.GrantedRoles)+len(d.Members))
+	for _, o := range objects {
+		statements = append(statements, schema.RevokeObjectPrivilegesDdl(o.kind, o.name, role, operands[o]))
+	}
+	for _, granted := range d.GrantedRoles {
+		statements = append(statements, schema.RevokeRoleDdl(granted, role))
+	}
+	for _, member := range d.Members {
+		statements = append(statements, schema.RevokeRoleDdl(role, member))
+	}
+
+	return statements
+}
+
+// databaseRoleDependents reads the privileges and role memberships of role.
+// The implicit membership of every role in public cannot be revoked and is
+// left out.
+func (s *SpannerService) databaseRoleDependents(ctx context.Context, database string, dialect conn.Dialect, role string) (*DatabaseRoleDependents, error) {
+	privileges, err := s.granteePrivileges(ctx, database, dialect, role)
+	if err != nil {
+		return nil, err
+	}
+	dependents := &DatabaseRoleDependents{Privileges: privileges}
+
+	var granted []*roleGranteeRow
+	if err := s.conn.Query(ctx, database, &granted,
+		"SELECT role_name, grantee FROM information_schema.role_grantees WHERE grantee = ?", role); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting role grantees: %v", err)
+	}
+	for _, row := range granted {
+		if row.RoleName != "public" {
+			dependents.GrantedRoles = append(dependents.GrantedRoles, row.RoleName)
+		}
+	}
+
+	var members []*roleGranteeRow
+	if err := s.conn.Query(ctx, database, &members,
+		"SELECT role_name, grantee FROM information_schema.role_grantees WHERE role_name = ?", role); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting role grantees: %v", err)
+	}
+	for _, row := range members {
+		dependents.Members = append(dependents.Members, row.Grantee)
+	}
+
+	return dependents, nil
+}
+
+// roleGranteeRow is one row of INFORMATION_SCHEMA.ROLE_GRANTEES.
+type roleGranteeRow struct {
+	RoleName string `gorm:"column:role_name"`
+	Grantee  string `gorm:"column:grantee"`
+}
+
+// GrantDatabaseRole makes a role a member of another by issuing GRANT ROLE
+// DDL, so the member inherits every privilege of the granted role. name is
+// the membership's full resource name.
+func (s *SpannerService) GrantDatabaseRole(ctx context.Context, name string) error {
+	membership, err := parseRoleMembership(name)
+	if err != nil {
+		return err
+	}
+	database := membership.DatabaseName().String()
 
 	// Verify the database exists before issuing DDL
 	if _, err := s.conn.Dialect(ctx, database); err != nil {
 		return err
 	}
 
-	return s.conn.ExecuteDDL(ctx, database, schema.DropRoleDdl(roleId))
+	return s.conn.ExecuteDDL(ctx, database, schema.GrantRoleDdl(membership.Role, membership.Member))
+}
+
+// GetDatabaseRoleMembership reports whether the membership named by name is
+// granted, reading INFORMATION_SCHEMA.ROLE_GRANTEES. codes.NotFound is
+// returned when it is not.
+func (s *SpannerService) GetDatabaseRoleMembership(ctx context.Context, name string) (names.DatabaseRoleMemberName, error) {
+	membership, err := parseRoleMembership(name)
+	if err != nil {
+		return names.DatabaseRoleMemberName{}, err
+	}
+
+	var rows []*roleGranteeRow
+	if err := s.conn.Query(
+		ctx, membership.DatabaseName().String(), &rows,
+		"SELECT role_name, grantee FROM information_schema.role_grantees WHERE role_name = ? AND grantee = ?",
+		membership.Role, membership.Member,
+	); err != nil {
+		return names.DatabaseRoleMemberName{}, status.Errorf(codes.Internal, "Error getting role membership: %v", err)
+	}
+	if len(rows) == 0 {
+		return names.DatabaseRoleMemberName{}, status.Errorf(codes.NotFound, "Role %s is not granted to role %s", membership.Role, membership.Member)
+	}
+
+	return membership, nil
+}
+
+// RevokeDatabaseRole ends a role membership by issuing REVOKE ROLE DDL.
+func (s *SpannerService) RevokeDatabaseRole(ctx context.Context, name string) error {
+	membership, err := parseRoleMembership(name)
+	if err != nil {
+		return err
+	}
+	database := membership.DatabaseName().String()
+
+	// Verify the database exists before issuing DDL
+	if _, err := s.conn.Dialect(ctx, database); err != nil {
+		return err
+	}
+
+	return s.conn.ExecuteDDL(ctx, database, schema.RevokeRoleDdl(membership.Role, membership.Member))
+}
+
+// parseRoleMembership validates and parses a membership name. Both role IDs
+// reach DDL by concatenation, so neither may be anything but a role ID.
+func parseRoleMembership(name string) (names.DatabaseRoleMemberName, error) {
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseRoleMemberNameRegex,
+		utils.SpannerPostgresSqlDatabaseRoleMemberNameRegex,
+	); err != nil {
+		return names.DatabaseRoleMemberName{}, err
+	}
+
+	membership, err := names.ParseDatabaseRoleMember(name)
+	if err != nil {
+		return names.DatabaseRoleMemberName{}, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+	}
+
+	return membership, nil
 }
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testRoleMembership = testDatabase + "/databaseRoles/reader/members/analyst"
+
+// failRoleListing makes the admin role listing answer as the emulator does.
+func failRoleListing(fake *connfake.Fake) {
+	fake.FailNext(connfake.OpDatabaseRoles, 1, status.Error(codes.Unimplemented, "ListDatabaseRoles is not implemented"))
+}
+
+func TestGetDatabaseRole_FallsBackToInformationSchema(t *testing.T) {
+	fake := connfake.New()
+	failRoleListing(fake)
+	fake.OnQuery("information_schema.roles", []*roleNameRow{{RoleName: "public"}, {RoleName: "reader"}})
+
+	got, err := NewSpannerService(fake).GetDatabaseRole(context.Background(), testDatabase+"/databaseRoles/reader")
+	require.NoError(t, err)
+	assert.Equal(t, testDatabase+"/databaseRoles/reader", got.GetName())
+
+	failRoleListing(fake)
+	_, err = NewSpannerService(fake).GetDatabaseRole(context.Background(), testDatabase+"/databaseRoles/analyst")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// The fallback pages by role name, reading one extra row to learn whether
+// another page follows.
+func TestListDatabaseRoles_FallbackPreservesPaging(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQueryFunc(
+		func(op connfake.Op) bool { return len(op.Params) == 0 },
+		func(dest any) error {
+			*dest.(*[]*roleNameRow) = []*roleNameRow{{RoleName: "analyst"}, {RoleName: "public"}, {RoleName: "reader"}}
+			return nil
+		},
+	)
+	fake.OnQueryFunc(
+		func(op connfake.Op) bool { return len(op.Params) == 1 && op.Params[0] == "public" },
+		func(dest any) error {
+			*dest.(*[]*roleNameRow) = []*roleNameRow{{RoleName: "reader"}}
+			return nil
+		},
+	)
+	service := NewSpannerService(fake)
+
+	failRoleListing(fake)
+	page, next, err := service.ListDatabaseRoles(context.Background(), testDatabase, 2, "")
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, testDatabase+"/databaseRoles/analyst", page[0].GetName())
+	assert.Equal(t, testDatabase+"/databaseRoles/public", page[1].GetName())
+	assert.Equal(t, "public", next)
+
+	failRoleListing(fake)
+	page, next, err = service.ListDatabaseRoles(context.Background(), testDatabase, 2, next)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, testDatabase+"/databaseRoles/reader", page[0].GetName())
+	assert.Empty(t, next)
+
+	queries := fake.OpsOf(connfake.OpQuery)
+	require.Len(t, queries, 2)
+	assert.Equal(t, "SELECT role_name FROM information_schema.roles ORDER BY role_name LIMIT 3", queries[0].SQL)
+	assert.Equal(t, "SELECT role_name FROM information_schema.roles WHERE role_name > ? ORDER BY role_name LIMIT 3", queries[1].SQL)
+}
+
+func TestListDatabaseRoles_FallbackPostgreSQL(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+	failRoleListing(fake)
+
+	_, next, err := NewSpannerService(fake).ListDatabaseRoles(context.Background(), testDatabase, 0, "")
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	queries := fake.OpsOf(connfake.OpQuery)
+	require.Len(t, queries, 1)
+	assert.Contains(t, queries[0].SQL, "information_schema.enabled_roles")
+	assert.NotContains(t, queries[0].SQL, "LIMIT")
+}
+
+// Errors other than Unimplemented are the admin API's answer, not a missing
+// capability, so they surface unchanged.
+func TestListDatabaseRoles_NoFallbackOnOtherErrors(t *testing.T) {
+	fake := connfake.New()
+	fake.FailNext(connfake.OpDatabaseRoles, 1, status.Error(codes.PermissionDenied, "denied"))
+
+	_, _, err := NewSpannerService(fake).ListDatabaseRoles(context.Background(), testDatabase, 0, "")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpQuery))
+}
+
+func TestGrantAndRevokeDatabaseRole(t *testing.T) {
+	fake := connfake.New()
+	service := NewSpannerService(fake)
+
+	require.NoError(t, service.GrantDatabaseRole(context.Background(), testRoleMembership))
+	require.NoError(t, service.RevokeDatabaseRole(context.Background(), testRoleMembership))
+
+	fake.AssertSubsequence(t,
+		"GRANT ROLE reader TO ROLE analyst",
+		"REVOKE ROLE reader FROM ROLE analyst",
+	)
+}
+
+func TestGetDatabaseRoleMembership(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("information_schema.role_grantees", []*roleGranteeRow{{RoleName: "reader", Grantee: "analyst"}})
+
+	got, err := NewSpannerService(fake).GetDatabaseRoleMembership(context.Background(), testRoleMembership)
+	require.NoError(t, err)
+	assert.Equal(t, testRoleMembership, got.String())
+
+	queries := fake.OpsOf(connfake.OpQuery)
+	require.Len(t, queries, 1)
+	assert.Equal(t, []any{"reader", "analyst"}, queries[0].Params)
+}
+
+func TestGetDatabaseRoleMembership_NotFound(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).GetDatabaseRoleMembership(context.Background(), testRoleMembership)
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Role IDs are concatenated into DDL, so anything else is rejected before a
+// statement is built.
+func TestGrantDatabaseRole_InvalidMember(t *testing.T) {
+	fake := connfake.New()
+
+	err := NewSpannerService(fake).GrantDatabaseRole(context.Background(), testDatabase+"/databaseRoles/reader/members/x; DROP TABLE t")
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL))
+}
+
+// seedRoleDependents stubs a role that holds privileges on a table, a column
+// and a change stream, inherits one role and is granted to another.
+func seedRoleDependents(fake *connfake.Fake) {
+	fake.OnQuery("table_privileges", []*objectPrivilegeRow{
+		{ObjectName: "orders", ObjectType: "BASE TABLE", PrivilegeType: "SELECT"},
+	})
+	fake.OnQuery("column_privileges", []*objectPrivilegeRow{
+		{ObjectName: "orders", ColumnName: "total", PrivilegeType: "UPDATE"},
+		{ObjectName: "orders", ColumnName: "status", PrivilegeType: "UPDATE"},
+	})
+	fake.OnQuery("change_stream_privileges", []*objectPrivilegeRow{
+		{ObjectName: "order_changes", PrivilegeType: "SELECT"},
+	})
+	fake.OnQuery("role_grantees WHERE grantee = ?", []*roleGranteeRow{
+		{RoleName: "public", Grantee: "reader"},
+		{RoleName: "spanner_info_reader", Grantee: "reader"},
+	})
+	fake.OnQuery("role_grantees WHERE role_name = ?", []*roleGranteeRow{{RoleName: "reader", Grantee: "analyst"}})
+}
+
+// DROP ROLE fails while anything references the role, so the error names
+// every grant in the way instead of surfacing Spanner's bare rejection.
+func TestDeleteDatabaseRole_ListsDependents(t *testing.T) {
+	fake := connfake.New()
+	seedRoleDependents(fake)
+	fake.FailNext(connfake.OpExecuteDDL, 1, status.Error(codes.FailedPrecondition, "role reader still has privileges"))
+
+	err := NewSpannerService(fake).DeleteDatabaseRole(context.Background(), testDatabase+"/databaseRoles/reader")
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "REVOKE SELECT, UPDATE(total, status) ON TABLE orders FROM ROLE reader")
+	assert.Contains(t, err.Error(), "REVOKE ROLE reader FROM ROLE analyst")
+}
+
+// Grants are only read once DROP ROLE has failed, so deleting an
+// unreferenced role costs nothing extra.
+func TestDeleteDatabaseRole_Unreferenced(t *testing.T) {
+	fake := connfake.New()
+
+	require.NoError(t, NewSpannerService(fake).DeleteDatabaseRole(context.Background(), testDatabase+"/databaseRoles/reader"))
+	assert.Equal(t, []string{"DROP ROLE reader"}, fake.Statements())
+	assert.Empty(t, fake.OpsOf(connfake.OpQuery))
+}
+
+// Revoking and dropping in one batch means a failure leaves the role exactly
+// as it was, never half-stripped.
+func TestForceDeleteDatabaseRole_RevokesThenDropsInOneBatch(t *testing.T) {
+	fake := connfake.New()
+	seedRoleDependents(fake)
+
+	require.NoError(t, NewSpannerService(fake).ForceDeleteDatabaseRole(context.Background(), testDatabase+"/databaseRoles/reader"))
+	assert.Equal(t, []string{
+		"REVOKE SELECT, UPDATE(total, status) ON TABLE orders FROM ROLE reader",
+		"REVOKE SELECT ON CHANGE STREAM order_changes FROM ROLE reader",
+		"REVOKE ROLE spanner_info_reader FROM ROLE reader",
+		"REVOKE ROLE reader FROM ROLE analyst",
+		"DROP ROLE reader",
+	}, fake.Statements())
+	assert.Len(t, fake.OpsOf(connfake.OpExecuteDDL), 1)
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSpannerDatabaseSchema reads the live schema of a GoogleSQL database by
+// parsing its GetDatabaseDdl statements. Statements using constructs the
+// schema cannot model land in Unmanaged rather than failing the read.
+// codes.NotFound is returned when the database does not exist, and
+// codes.FailedPrecondition when it is a PostgreSQL database.
+func (s *SpannerService) GetSpannerDatabaseSchema(ctx context.Context, name string) (*schema.SpannerDatabaseSchema, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	dialect, err := s.conn.Dialect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if dialect == conn.DialectPostgreSQL {
+		return nil, status.Errorf(codes.FailedPrecondition, "Database %s uses the PostgreSQL dialect; declarative schemas support GoogleSQL databases only", name)
+	}
+
+	statements, _, err := s.conn.DatabaseDdl(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := schema.ParseLiveDdl(name, statements...)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Error reading the schema of database %s: %v", name, err)
+	}
+
+	return live, nil
+}
+
+// PlanSpannerDatabaseSchema parses ddl as the complete desired schema of the
+// database and diffs it against the live one, returning the migration
+// UpdateSpannerDatabaseSchema would run. ddl may only declare the objects
+// the diff manages (see schema.SpannerDatabaseSchema.AlterDdl).
+func (s *SpannerService) PlanSpannerDatabaseSchema(ctx context.Context, name string, ddl []string) (*schema.SchemaMigration, error) {
+	desired, err := parseDesiredSchema(name, ddl)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := s.GetSpannerDatabaseSchema(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return desired.AlterDdl(live)
+}
+
+// UpdateSpannerDatabaseSchema migrates the database to the schema ddl
+// declares, submitting the whole migration as one schema update, and
+// returns the migration it ran. The diff is recomputed against the schema
+// as it is now; when planned is non-nil and the recomputed statements differ
+// from it, nothing runs and codes.Aborted is returned, so a schema changed
+// out of band since plan time is never migrated with unreviewed statements.
+func (s *SpannerService) UpdateSpannerDatabaseSchema(ctx context.Context, name string, ddl []string, planned []string) (*schema.SchemaMigration, error) {
+	migration, err := s.PlanSpannerDatabaseSchema(ctx, name, ddl)
+	if err != nil {
+		return nil, err
+	}
+
+	if planned != nil && !slices.Equal(planned, migration.Statements) {
+		return nil, status.Errorf(
+			codes.Aborted,
+			"The schema of database %s changed since the plan was made; the migration now needs %q instead of %q",
+			name, migration.Statements, planned,
+		)
+	}
+
+	if len(migration.Statements) > 0 {
+		if err := s.conn.ExecuteDDL(ctx, name, migration.Statements...); err != nil {
+			return nil, err
+		}
+	}
+
+	return migration, nil
+}
+
+// parseDesiredSchema parses user-supplied DDL, rejecting statements the
+// declarative diff would otherwise silently ignore.
+func parseDesiredSchema(name string, ddl []string) (*schema.SpannerDatabaseSchema, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	desired, err := schema.ParseDdl(name, ddl...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument ddl, %v", err)
+	}
+	if undiffed := desired.UndiffedObjects(); len(undiffed) > 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Invalid argument ddl, %s is not a table, index, foreign key, or row deletion policy",
+			undiffed[0],
+		)
+	}
+
+	return desired, nil
+}
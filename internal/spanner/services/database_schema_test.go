@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateSpannerDatabaseSchema_RunsMigrationAsOneBatch(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectGoogleSQL)
+	fake.SetDatabaseDdl(testDatabase, []string{
+		"CREATE TABLE users (\n  id STRING(36) NOT NULL,\n) PRIMARY KEY(id)",
+		"CREATE ROLE analyst",
+	}, nil)
+
+	got, err := NewSpannerService(fake).UpdateSpannerDatabaseSchema(context.Background(), testDatabase, []string{
+		"CREATE TABLE users (id STRING(36) NOT NULL, name STRING(MAX)) PRIMARY KEY (id);" +
+			"CREATE INDEX users_by_name ON users (name)",
+	}, nil)
+	require.NoError(t, err)
+
+	want := []string{
+		"ALTER TABLE `users` ADD COLUMN `name` STRING(MAX)",
+		"CREATE  INDEX users_by_name ON users (name ASC)",
+	}
+	assert.Equal(t, want, got.Statements)
+	executes := fake.OpsOf(connfake.OpExecuteDDL)
+	require.Len(t, executes, 1)
+	assert.Equal(t, want, executes[0].Statements)
+}
+
+func TestUpdateSpannerDatabaseSchema_NoChangeSkipsExecute(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectGoogleSQL)
+	fake.SetDatabaseDdl(testDatabase, []string{"CREATE TABLE users (\n  id STRING(36) NOT NULL,\n) PRIMARY KEY(id)"}, nil)
+
+	got, err := NewSpannerService(fake).UpdateSpannerDatabaseSchema(context.Background(), testDatabase, []string{
+		"CREATE TABLE users (id STRING(36) NOT NULL) PRIMARY KEY (id)",
+	}, []string{})
+	require.NoError(t, err)
+	assert.Empty(t, got.Statements)
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL))
+}
+
+// Statements reviewed at plan time must still be the migration at apply
+// time; a schema changed in between aborts without running anything.
+func TestUpdateSpannerDatabaseSchema_AbortsOnStalePlan(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectGoogleSQL)
+	fake.SetDatabaseDdl(testDatabase, []string{"CREATE TABLE users (\n  id STRING(36) NOT NULL,\n  name STRING(MAX),\n) PRIMARY KEY(id)"}, nil)
+
+	_, err := NewSpannerService(fake).UpdateSpannerDatabaseSchema(context.Background(), testDatabase, []string{
+		"CREATE TABLE users (id STRING(36) NOT NULL, name STRING(MAX)) PRIMARY KEY (id)",
+	}, []string{"ALTER TABLE `users` ADD COLUMN `name` STRING(MAX)"})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL))
+}
+
+// A statement the diff does not manage would otherwise be ignored on every
+// apply; it is rejected before the live schema is even read.
+func TestPlanSpannerDatabaseSchema_RejectsUnmanagedStatements(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).PlanSpannerDatabaseSchema(context.Background(), testDatabase, []string{"CREATE ROLE analyst"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, fake.Ops())
+}
+
+func TestPlanSpannerDatabaseSchema_RejectsPostgreSQL(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+
+	_, err := NewSpannerService(fake).PlanSpannerDatabaseSchema(context.Background(), testDatabase, []string{
+		"CREATE TABLE users (id STRING(36) NOT NULL) PRIMARY KEY (id)",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpDatabaseDdl))
+}
+
+// Live constructs the schema cannot model leave the objects they declare
+// alone rather than failing the plan of everything else.
+func TestPlanSpannerDatabaseSchema_ToleratesUnmodelledLiveStatements(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectGoogleSQL)
+	fake.SetDatabaseDdl(testDatabase, []string{
+		"CREATE TABLE users (\n  id STRING(36) NOT NULL,\n) PRIMARY KEY(id)",
+		"CREATE TABLE audits (\n  id INT64 NOT NULL,\n  CONSTRAINT ck_id CHECK(id > 0),\n) PRIMARY KEY(id)",
+	}, nil)
+
+	got, err := NewSpannerService(fake).PlanSpannerDatabaseSchema(context.Background(), testDatabase, []string{
+		"CREATE TABLE users (id STRING(36) NOT NULL) PRIMARY KEY (id)",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got.Statements)
+}
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Drop protection is not part of CreateDatabaseRequest, so it must follow
+// the create as an update; a database left unprotected because the second
+// call never ran is exactly what the setting exists to prevent.
+func TestCreateSpannerDatabase_EnablesDropProtectionAfterCreate(t *testing.T) {
+	fake := connfake.New()
+
+	got, err := NewSpannerService(fake).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{
+		Dialect:         conn.DialectPostgreSQL,
+		ExtraStatements: []string{"CREATE SEQUENCE seq BIT_REVERSED_POSITIVE"},
+	}, true)
+	require.NoError(t, err)
+	assert.True(t, got.EnableDropProtection)
+	assert.Equal(t, conn.DialectPostgreSQL, got.Dialect)
+
+	assert.Len(t, fake.OpsOf(connfake.OpCreateDatabase), 1)
+	assert.Len(t, fake.OpsOf(connfake.OpSetDatabaseDropProtection), 1)
+}
+
+// Spanner refuses extra statements on a PostgreSQL-dialect create, so they
+// must reach the database as a DDL update once it exists.
+func TestCreateSpannerDatabase_AppliesPostgreSQLStatementsAfterCreate(t *testing.T) {
+	fake := connfake.New()
+	statements := []string{"CREATE SEQUENCE seq BIT_REVERSED_POSITIVE"}
+
+	_, err := NewSpannerService(fake).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{
+		Dialect:         conn.DialectPostgreSQL,
+		ExtraStatements: statements,
+	}, false)
+	require.NoError(t, err)
+
+	ops := fake.Ops()
+	require.Len(t, ops, 3)
+	assert.Equal(t, connfake.OpCreateDatabase, ops[0].Kind)
+	assert.Empty(t, ops[0].Statements)
+	assert.Equal(t, connfake.OpExecuteDDL, ops[1].Kind)
+	assert.Equal(t, statements, ops[1].Statements)
+}
+
+// The database exists once the create finishes, so a failed DDL update must
+// neither repeat the create under retry nor lose track of the database.
+func TestCreateSpannerDatabase_PostgreSQLStatementsFailAfterCreate(t *testing.T) {
+	fake := connfake.New()
+	fake.FailNext(connfake.OpExecuteDDL, 1, status.Error(codes.Aborted, "schema change aborted"))
+	cn := conn.WithRetry(fake, conn.RetryPolicy{Attempts: 2, InitialBackoff: time.Millisecond})
+
+	got, err := NewSpannerService(cn).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{
+		Dialect:         conn.DialectPostgreSQL,
+		ExtraStatements: []string{"CREATE SEQUENCE seq BIT_REVERSED_POSITIVE"},
+	}, true)
+	require.NoError(t, err)
+	assert.True(t, got.EnableDropProtection)
+	assert.Len(t, fake.OpsOf(connfake.OpCreateDatabase), 1)
+	assert.Len(t, fake.OpsOf(connfake.OpExecuteDDL), 2)
+
+	fake = connfake.New()
+	fake.FailNext(connfake.OpExecuteDDL, 1, status.Error(codes.InvalidArgument, "syntax error"))
+
+	got, err = NewSpannerService(fake).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{
+		Dialect:         conn.DialectPostgreSQL,
+		ExtraStatements: []string{"CREATE SEQUENC seq"},
+	}, true)
+	require.ErrorIs(t, err, ErrExtraStatementsNotApplied)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.NotNil(t, got)
+	assert.Equal(t, testDatabase, got.Name)
+	assert.False(t, got.EnableDropProtection)
+	assert.Empty(t, fake.OpsOf(connfake.OpSetDatabaseDropProtection))
+}
+
+func TestCreateSpannerDatabase_SendsGoogleSQLStatementsWithCreate(t *testing.T) {
+	fake := connfake.New()
+	statements := []string{"CREATE SEQUENCE seq OPTIONS (sequence_kind = 'bit_reversed_positive')"}
+
+	_, err := NewSpannerService(fake).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{
+		ExtraStatements: statements,
+	}, false)
+	require.NoError(t, err)
+
+	creates := fake.OpsOf(connfake.OpCreateDatabase)
+	require.Len(t, creates, 1)
+	assert.Equal(t, statements, creates[0].Statements)
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL))
+}
+
+func TestCreateSpannerDatabase_SkipsDropProtectionWhenOff(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).CreateSpannerDatabase(context.Background(), testDatabase, conn.DatabaseSpec{}, false)
+	require.NoError(t, err)
+	assert.Empty(t, fake.OpsOf(connfake.OpSetDatabaseDropProtection))
+}
+
+func TestCreateSpannerDatabase_ValidatesName(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).CreateSpannerDatabase(context.Background(), "projects/test-project/databases/test-db", conn.DatabaseSpec{}, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, fake.Ops())
+}
+
+func TestDeleteSpannerDatabase_RefusedWhileProtected(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabase(testDatabase, conn.DatabaseInfo{State: DatabaseState_Ready, EnableDropProtection: true})
+	svc := NewSpannerService(fake)
+
+	err := svc.DeleteSpannerDatabase(context.Background(), testDatabase)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = svc.UpdateSpannerDatabaseDropProtection(context.Background(), testDatabase, false)
+	require.NoError(t, err)
+	require.NoError(t, svc.DeleteSpannerDatabase(context.Background(), testDatabase))
+
+	_, err = svc.GetSpannerDatabase(context.Background(), testDatabase)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetSpannerDatabaseDdl_FingerprintsReportedSchema(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabaseDdl(testDatabase, []string{"CREATE TABLE t (\n  id INT64\n) PRIMARY KEY(id)"}, []byte{1})
+
+	got, err := NewSpannerService(fake).GetSpannerDatabaseDdl(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CREATE TABLE t (\n  id INT64\n) PRIMARY KEY(id)"}, got.Statements)
+	assert.Equal(t, []byte{1}, got.ProtoDescriptors)
+	assert.Equal(t, schema.DdlFingerprint([]string{"CREATE TABLE t (id INT64) PRIMARY KEY(id)"}, []byte{1}), got.Fingerprint)
+}
+
+// A backslash is literal in PostgreSQL strings, so that dialect's schema
+// fingerprints with its own normalization.
+func TestGetSpannerDatabaseDdl_FingerprintsPostgreSQLSchema(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+	fake.SetDatabaseDdl(testDatabase, []string{"CREATE TABLE t (\n  p character varying DEFAULT 'C:\\'\n)"}, nil)
+
+	got, err := NewSpannerService(fake).GetSpannerDatabaseDdl(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, schema.PostgresDdlFingerprint([]string{"CREATE TABLE t (p character varying DEFAULT 'C:\\')"}, nil), got.Fingerprint)
+}
+
+func TestGetSpannerDatabaseDdl_NotFound(t *testing.T) {
+	_, err := NewSpannerService(connfake.New()).GetSpannerDatabaseDdl(context.Background(), testDatabase)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -9,27 +9,26 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-// CreateSpannerTableForeignKeyConstraint adds a foreign key constraint to the
-// table named by parent via ALTER TABLE ... ADD CONSTRAINT. Every constraint
-// field is validated up front; DDL failures surface as codes.Internal.
-func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
-	ctx context.Context,
+// foreignKeyTarget validates parent and every constraint field, returning the
+// database the DDL runs against and the bare ID of the constrained table.
+func foreignKeyTarget(
 	parent string,
 	constraint *schema.SpannerTableForeignKeyConstraint,
-) (*schema.SpannerTableForeignKeyConstraint, error) {
+) (database, tableId string, err error) {
 	if err := utils.ValidateDialectArgument(
 		"parent",
 		parent,
 		utils.SpannerGoogleSqlTableNameRegex,
 		utils.SpannerPostgresSqlTableNameRegex,
 	); err != nil {
-		return nil, err
+		return "", "", err
 	}
 	// Ensure constraint is provided and has a name and foreign keys
 	if constraint == nil {
-		return nil, status.Error(codes.InvalidArgument, "Invalid argument constraint, field is required but not provided")
+		return "", "", status.Error(codes.InvalidArgument, "Invalid argument constraint, field is required but not provided")
 	}
 	if err := utils.ValidateDialectArgument(
 		"constraint.name",
@@ -37,11 +36,11 @@ func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
 		utils.SpannerGoogleSqlConstraintIdRegex,
 		utils.SpannerPostgresSqlConstraintIdRegex,
 	); err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	if constraint.ReferencedTable == "" {
-		return nil, status.Error(codes.InvalidArgument, "Invalid argument constraint.referenced_table, field is required but not provided")
+		return "", "", status.Error(codes.InvalidArgument, "Invalid argument constraint.referenced_table, field is required but not provided")
 	}
 	if err := utils.ValidateDialectArgument(
 		"constraint.referenced_table",
@@ -49,11 +48,11 @@ func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
 		utils.SpannerGoogleSqlTableIdRegex,
 		utils.SpannerPostgresSqlTableIdRegex,
 	); err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	if constraint.ReferencedColumn == "" {
-		return nil, status.Error(codes.InvalidArgument, "Invalid argument constraint.referenced_column, field is required but not provided")
+		return "", "", status.Error(codes.InvalidArgument, "Invalid argument constraint.referenced_column, field is required but not provided")
 	}
 	if err := utils.ValidateDialectArgument(
 		"constraint.referenced_column",
@@ -61,11 +60,11 @@ func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
 		utils.SpannerGoogleSqlColumnIdRegex,
 		utils.SpannerPostgresSqlColumnIdRegex,
 	); err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	if constraint.Column == "" {
-		return nil, status.Error(codes.InvalidArgument, "Invalid argument constraint.column, field is required but not provided")
+		return "", "", status.Error(codes.InvalidArgument, "Invalid argument constraint.column, field is required but not provided")
 	}
 	if err := utils.ValidateDialectArgument(
 		"constraint.column",
@@ -73,15 +72,29 @@ func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
 		utils.SpannerGoogleSqlColumnIdRegex,
 		utils.SpannerPostgresSqlColumnIdRegex,
 	); err != nil {
-		return nil, err
+		return "", "", err
 	}
 
 	parentName, err := names.ParseTable(parent)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+		return "", "", status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+
+	return parentName.DatabaseName().String(), parentName.Table, nil
+}
+
+// CreateSpannerTableForeignKeyConstraint adds a foreign key constraint to the
+// table named by parent via ALTER TABLE ... ADD CONSTRAINT. Every constraint
+// field is validated up front; DDL failures surface as codes.Internal.
+func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
+	ctx context.Context,
+	parent string,
+	constraint *schema.SpannerTableForeignKeyConstraint,
+) (*schema.SpannerTableForeignKeyConstraint, error) {
+	database, tableId, err := foreignKeyTarget(parent, constraint)
+	if err != nil {
+		return nil, err
 	}
-	database := parentName.DatabaseName().String()
-	tableId := parentName.Table
 
 	ddl, err := constraint.CreateDdl(tableId)
 	if err != nil {
@@ -94,6 +107,34 @@ func (s *SpannerService) CreateSpannerTableForeignKeyConstraint(
 	return constraint, nil
 }
 
+// RecreateSpannerTableForeignKeyConstraint replaces the constraint of the same
+// name on the table named by parent. Spanner cannot alter a foreign key in
+// place, so the DROP and the ADD are submitted as one DDL batch: the window in
+// which the table has no constraint is a single schema change, not two.
+func (s *SpannerService) RecreateSpannerTableForeignKeyConstraint(
+	ctx context.Context,
+	parent string,
+	constraint *schema.SpannerTableForeignKeyConstraint,
+) (*schema.SpannerTableForeignKeyConstraint, error) {
+	database, tableId, err := foreignKeyTarget(parent, constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	ddl, err := constraint.CreateDdl(tableId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := s.conn.ExecuteDDL(ctx, database,
+		schema.DropForeignKeyConstraintDdl(tableId, constraint.Name),
+		ddl,
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error recreating foreign key constraint: %v", err)
+	}
+
+	return constraint, nil
+}
+
 // GetSpannerTableForeignKeyConstraint reconstructs a foreign key constraint
 // from the INFORMATION_SCHEMA constraint tables. parent is the constrained
 // table's resource name and name the bare constraint ID; codes.NotFound is
@@ -132,6 +173,7 @@ func (s *SpannerService) GetSpannerTableForeignKeyConstraint(
 	  TABLE_CONSTRAINTS.CONSTRAINT_NAME,
 	  TABLE_CONSTRAINTS.TABLE_NAME AS CONSTRAINED_TABLE,
 	  TABLE_CONSTRAINTS.CONSTRAINT_TYPE,
+	  TABLE_CONSTRAINTS.ENFORCED,
 	  REFERENTIAL_CONSTRAINTS.UPDATE_RULE,
 	  REFERENTIAL_CONSTRAINTS.DELETE_RULE,
 	  KEY_COLUMN_USAGE.COLUMN_NAME AS CONSTRAINED_COLUMN,
@@ -171,6 +213,7 @@ func (s *SpannerService) GetSpannerTableForeignKeyConstraint(
 		ReferencedColumn: result.REFERENCED_COLUMN,
 		Column:           result.CONSTRAINED_COLUMN,
 		OnDelete:         schema.SpannerTableConstraintActionFromString(result.DELETE_RULE),
+		Enforced:         wrapperspb.Bool(result.ENFORCED != "NO"),
 	}
 
 	return constaint, nil
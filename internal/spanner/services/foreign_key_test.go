@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Two separate schema changes would leave the table unconstrained for the
+// whole of the first one's rollout, so the drop and the re-add must share a
+// single batch.
+func TestRecreateSpannerTableForeignKeyConstraint_SingleBatch(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).RecreateSpannerTableForeignKeyConstraint(context.Background(), testTable,
+		&schema.SpannerTableForeignKeyConstraint{
+			Name:             "FK_tftest_user",
+			Column:           "user_id",
+			ReferencedTable:  "users",
+			ReferencedColumn: "id",
+			Enforced:         wrapperspb.Bool(false),
+		},
+	)
+	require.NoError(t, err)
+
+	ops := fake.OpsOf(connfake.OpExecuteDDL)
+	require.Len(t, ops, 1)
+	assert.Equal(t, []string{
+		"ALTER TABLE `tftest_table` DROP CONSTRAINT `FK_tftest_user`",
+		"ALTER TABLE `tftest_table` ADD CONSTRAINT `FK_tftest_user` FOREIGN KEY (`user_id`) REFERENCES users(`id`) NOT ENFORCED",
+	}, ops[0].Statements)
+}
+
+func TestRecreateSpannerTableForeignKeyConstraint_ValidatesBeforeDropping(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).RecreateSpannerTableForeignKeyConstraint(context.Background(), testTable,
+		&schema.SpannerTableForeignKeyConstraint{Name: "FK_tftest_user", Column: "user_id", ReferencedTable: "users"},
+	)
+	require.Error(t, err)
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL), "an invalid constraint must not drop the existing one")
+}
+
+// ENFORCED is "YES" or "NO"; anything else (an older backend that omits the
+// column) keeps the Spanner default rather than reporting drift.
+func TestGetSpannerTableForeignKeyConstraint_ReadsEnforced(t *testing.T) {
+	tests := []struct {
+		enforced string
+		want     bool
+	}{
+		{enforced: "YES", want: true},
+		{enforced: "NO", want: false},
+		{enforced: "", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.enforced, func(t *testing.T) {
+			fake := connfake.New()
+			fake.OnQuery("TABLE_CONSTRAINTS", []*Constraint{{
+				CONSTRAINT_NAME:    "FK_tftest_user",
+				CONSTRAINT_TYPE:    "FOREIGN KEY",
+				ENFORCED:           tc.enforced,
+				CONSTRAINED_TABLE:  "tftest_table",
+				CONSTRAINED_COLUMN: "user_id",
+				DELETE_RULE:        "CASCADE",
+				REFERENCED_TABLE:   "users",
+				REFERENCED_COLUMN:  "id",
+			}})
+
+			got, err := NewSpannerService(fake).GetSpannerTableForeignKeyConstraint(context.Background(), testTable, "FK_tftest_user")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.IsEnforced())
+			assert.Equal(t, schema.SpannerTableConstraintActionCascade, got.OnDelete)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Object types of a RolePrivilege.
+const (
+	RolePrivilegeObjectTable        = "TABLE"
+	RolePrivilegeObjectView         = "VIEW"
+	RolePrivilegeObjectColumn       = "COLUMN"
+	RolePrivilegeObjectChangeStream = "CHANGE_STREAM"
+	RolePrivilegeObjectFunction     = "FUNCTION"
+)
+
+// RolePrivilege is one privilege a role holds, directly or through a role it
+// inherits.
+type RolePrivilege struct {
+	// One of the RolePrivilegeObject constants.
+	ObjectType string
+	// The table, view, change stream or function the privilege is on. For a
+	// column privilege, the column's table.
+	Object string
+	// The column, for a column privilege; empty otherwise.
+	Column string
+	// The privilege type, e.g. SELECT or EXECUTE.
+	Privilege string
+	// The role the privilege is granted to: the reported role itself, or one
+	// of its InheritedRoles.
+	GrantedVia string
+}
+
+// RolePrivilegeReport is everything a role can do in a database.
+type RolePrivilegeReport struct {
+	// The role reported on.
+	Role string
+	// Every role the role inherits, directly or transitively, sorted.
+	InheritedRoles []string
+	// Every privilege of the role and of its inherited roles, sorted by
+	// object type, object, column, privilege and granting role.
+	Privileges []*RolePrivilege
+}
+
+// objectPrivilegeRow is one row of a *_PRIVILEGES view, with the privileged
+// object's name aliased to object_name. object_type is only selected from
+// TABLE_PRIVILEGES, where it tells views from tables.
+type objectPrivilegeRow struct {
+	ObjectName    string `gorm:"column:object_name"`
+	ColumnName    string `gorm:"column:column_name"`
+	ObjectType    string `gorm:"column:object_type"`
+	PrivilegeType string `gorm:"column:privilege_type"`
+}
+
+// GetDatabaseRolePrivileges reports every table, view, column, change stream
+// and function privilege the role named by name holds, including those it
+// inherits from the roles granted to it. Roles are followed through
+// INFORMATION_SCHEMA.ROLE_GRANTEES; privileges are read from TABLE_PRIVILEGES,
+// COLUMN_PRIVILEGES, CHANGE_STREAM_PRIVILEGES and, in GoogleSQL databases,
+// ROUTINE_PRIVILEGES. codes.NotFound is returned when the role does not exist.
+func (s *SpannerService) GetDatabaseRolePrivileges(ctx context.Context, name string) (*RolePrivilegeReport, error) {
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlDatabaseRoleNameRegex,
+		utils.SpannerPostgresSqlDatabaseRoleNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	roleName, err := names.ParseDatabaseRole(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+	}
+	database := roleName.DatabaseName().String()
+
+	// The privilege views return nothing for a role that does not exist,
+	// which would read as a role with no access.
+	if _, err := s.GetDatabaseRole(ctx, name); err != nil {
+		return nil, err
+	}
+
+	dialect, err := s.conn.Dialect(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	inherited, err := s.inheritedRoles(ctx, database, roleName.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &RolePrivilegeReport{
+		Role:           roleName.Role,
+		InheritedRoles: inherited,
+		Privileges:     make([]*RolePrivilege, 0),
+	}
+	for _, grantee := range append([]string{roleName.Role}, inherited...) {
+		privileges, err := s.granteePrivileges(ctx, database, dialect, grantee)
+		if err != nil {
+			return nil, err
+		}
+		report.Privileges = append(report.Privileges, privileges...)
+	}
+
+	slices.SortFunc(report.Privileges, func(a, b *RolePrivilege) int {
+		return cmp.Or(
+			cmp.Compare(a.ObjectType, b.ObjectType),
+			cmp.Compare(a.Object, b.Object),
+			cmp.Compare(a.Column, b.Column),
+			cmp.Compare(a.Privilege, b.Privilege),
+			cmp.Compare(a.GrantedVia, b.GrantedVia),
+		)
+	})
+
+	return report, nil
+}
+
+// inheritedRoles walks ROLE_GRANTEES from role, returning every role granted
+// to it directly or through another granted role, sorted. Cycles cannot be
+// created in Spanner, but the walk visits each role once regardless.
+func (s *SpannerService) inheritedRoles(ctx context.Context, database, role string) ([]string, error) {
+	seen := map[string]bool{role: true}
+	var inherited []string
+	for queue := []string{role}; len(queue) > 0; queue = queue[1:] {
+		var rows []*roleGranteeRow
+		if err := s.conn.Query(ctx, database, &rows,
+			"SELECT role_name, grantee FROM information_schema.role_grantees WHERE grantee = ?", queue[0]); err != nil {
+			return nil, status.Errorf(codes.Internal, "Error getting role grantees: %v", err)
+		}
+
+		for _, row := range rows {
+			if seen[row.RoleName] {
+				continue
+			}
+			seen[row.RoleName] = true
+			inherited = append(inherited, row.RoleName)
+			queue = append(queue, row.RoleName)
+		}
+	}
+
+	slices.Sort(inherited)
+
+	return inherited, nil
+}
+
+// granteePrivileges reads the privileges granted directly to grantee.
+func (s *SpannerService) granteePrivileges(ctx context.Context, database string, dialect conn.Dialect, grantee string) ([]*RolePrivilege, error) {
+	var privileges []*RolePrivilege
+	query := func(sql string) ([]*objectPrivilegeRow, error) {
+		var rows []*objectPrivilegeRow
+		if err := s.conn.Query(ctx, database, &rows, sql, grantee); err != nil {
+			return nil, status.Errorf(codes.Internal, "Error getting privileges of role %s: %v", grantee, err)
+		}
+
+		return rows, nil
+	}
+
+	tableRows, err := query(
+		"SELECT p.table_name AS object_name, t.table_type AS object_type, p.privilege_type " +
+			"FROM information_schema.table_privileges AS p " +
+			"LEFT JOIN information_schema.tables AS t ON t.table_schema = p.table_schema AND t.table_name = p.table_name " +
+			"WHERE p.grantee = ?")
+	if err != nil {
+		return nil, err
+	}
+	// A privilege held on the whole table covers every column, whether or
+	// not the column view repeats it.
+	tableWide := map[[2]string]bool{}
+	for _, row := range tableRows {
+		objectType := RolePrivilegeObjectTable
+		if row.ObjectType == "VIEW" {
+			objectType = RolePrivilegeObjectView
+		}
+		tableWide[[2]string{row.ObjectName, row.PrivilegeType}] = true
+		privileges = append(privileges, &RolePrivilege{
+			ObjectType: objectType,
+			Object:     row.ObjectName,
+			Privilege:  row.PrivilegeType,
+			GrantedVia: grantee,
+		})
+	}
+
+	columnRows, err := query(
+		"SELECT table_name AS object_name, column_name, privilege_type " +
+			"FROM information_schema.column_privileges WHERE grantee = ?")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range columnRows {
+		if tableWide[[2]string{row.ObjectName, row.PrivilegeType}] {
+			continue
+		}
+		privileges = append(privileges, &RolePrivilege{
+			ObjectType: RolePrivilegeObjectColumn,
+			Object:     row.ObjectName,
+			Column:     row.ColumnName,
+			Privilege:  row.PrivilegeType,
+			GrantedVia: grantee,
+		})
+	}
+
+	changeStreamRows, err := query(
+		"SELECT change_stream_name AS object_name, privilege_type " +
+			"FROM information_schema.change_stream_privileges WHERE grantee = ?")
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range changeStreamRows {
+		privileges = append(privileges, &RolePrivilege{
+			ObjectType: RolePrivilegeObjectChangeStream,
+			Object:     row.ObjectName,
+			Privilege:  row.PrivilegeType,
+			GrantedVia: grantee,
+		})
+	}
+
+	// Function privileges only exist in GoogleSQL databases.
+	if dialect == conn.DialectGoogleSQL {
+		functionRows, err := query(
+			"SELECT specific_name AS object_name, privilege_type " +
+				"FROM information_schema.routine_privileges WHERE grantee = ?")
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range functionRows {
+			privileges = append(privileges, &RolePrivilege{
+				ObjectType: RolePrivilegeObjectFunction,
+				Object:     row.ObjectName,
+				Privilege:  row.PrivilegeType,
+				GrantedVia: grantee,
+			})
+		}
+	}
+
+	return privileges, nil
+}
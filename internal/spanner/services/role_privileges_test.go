@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// onGranteeQuery stubs the rows a query over view returns for one grantee.
+func onGranteeQuery[T any](fake *connfake.Fake, view, grantee string, rows []T) {
+	fake.OnQueryFunc(
+		func(op connfake.Op) bool {
+			return strings.Contains(op.SQL, view) && len(op.Params) == 1 && op.Params[0] == grantee
+		},
+		func(dest any) error {
+			*dest.(*[]T) = rows
+			return nil
+		},
+	)
+}
+
+func TestGetDatabaseRolePrivileges(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDatabaseRoles(testDatabase, []string{testDatabase + "/databaseRoles/analyst"})
+	// analyst inherits reader, which inherits spanner_info_reader.
+	onGranteeQuery(fake, "role_grantees", "analyst", []*roleGranteeRow{{RoleName: "reader", Grantee: "analyst"}})
+	onGranteeQuery(fake, "role_grantees", "reader", []*roleGranteeRow{{RoleName: "spanner_info_reader", Grantee: "reader"}})
+	onGranteeQuery(fake, "table_privileges", "analyst", []*objectPrivilegeRow{
+		{ObjectName: "orders", ObjectType: "BASE TABLE", PrivilegeType: "INSERT"},
+	})
+	onGranteeQuery(fake, "column_privileges", "analyst", []*objectPrivilegeRow{
+		{ObjectName: "customers", ColumnName: "name", PrivilegeType: "SELECT"},
+		// Repeats the table-wide grant, so it is not reported again.
+		{ObjectName: "orders", ColumnName: "id", PrivilegeType: "INSERT"},
+	})
+	onGranteeQuery(fake, "table_privileges", "reader", []*objectPrivilegeRow{
+		{ObjectName: "order_totals", ObjectType: "VIEW", PrivilegeType: "SELECT"},
+	})
+	onGranteeQuery(fake, "change_stream_privileges", "reader", []*objectPrivilegeRow{
+		{ObjectName: "order_changes", PrivilegeType: "SELECT"},
+	})
+	onGranteeQuery(fake, "routine_privileges", "reader", []*objectPrivilegeRow{
+		{ObjectName: "read_order_changes", PrivilegeType: "EXECUTE"},
+	})
+
+	got, err := NewSpannerService(fake).GetDatabaseRolePrivileges(context.Background(), testDatabase+"/databaseRoles/analyst")
+	require.NoError(t, err)
+	assert.Equal(t, "analyst", got.Role)
+	assert.Equal(t, []string{"reader", "spanner_info_reader"}, got.InheritedRoles)
+	assert.Equal(t, []*RolePrivilege{
+		{ObjectType: RolePrivilegeObjectChangeStream, Object: "order_changes", Privilege: "SELECT", GrantedVia: "reader"},
+		{ObjectType: RolePrivilegeObjectColumn, Object: "customers", Column: "name", Privilege: "SELECT", GrantedVia: "analyst"},
+		{ObjectType: RolePrivilegeObjectFunction, Object: "read_order_changes", Privilege: "EXECUTE", GrantedVia: "reader"},
+		{ObjectType: RolePrivilegeObjectTable, Object: "orders", Privilege: "INSERT", GrantedVia: "analyst"},
+		{ObjectType: RolePrivilegeObjectView, Object: "order_totals", Privilege: "SELECT", GrantedVia: "reader"},
+	}, got.Privileges)
+}
+
+// PostgreSQL databases have no function privileges, so the routine view is
+// not queried at all.
+func TestGetDatabaseRolePrivileges_PostgreSQLSkipsFunctions(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+	fake.SetDatabaseRoles(testDatabase, []string{testDatabase + "/databaseRoles/analyst"})
+
+	got, err := NewSpannerService(fake).GetDatabaseRolePrivileges(context.Background(), testDatabase+"/databaseRoles/analyst")
+	require.NoError(t, err)
+	assert.Empty(t, got.Privileges)
+	for _, op := range fake.OpsOf(connfake.OpQuery) {
+		assert.NotContains(t, op.SQL, "routine_privileges")
+	}
+}
+
+func TestGetDatabaseRolePrivileges_UnknownRole(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).GetDatabaseRolePrivileges(context.Background(), testDatabase+"/databaseRoles/analyst")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpQuery))
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The List* methods in this file enumerate the schema objects of a database
+// from INFORMATION_SCHEMA and return their fully qualified resource names,
+// the same names each resource accepts as an import ID. Only objects in the
+// default schema are returned: the resources cannot address named schemas.
+
+// schemaObjectRow is one (table, object) pair read from INFORMATION_SCHEMA.
+// Columns are aliased to lower case so both dialects scan alike.
+type schemaObjectRow struct {
+	TableName  string `gorm:"column:table_name"`
+	ObjectName string `gorm:"column:object_name"`
+}
+
+// defaultSchema resolves the database named by parent and returns its dialect
+// together with the INFORMATION_SCHEMA name of its default schema: "" for
+// GoogleSQL and "public" for PostgreSQL.
+func (s *SpannerService) defaultSchema(ctx context.Context, parent string) (names.DatabaseName, conn.Dialect, string, error) {
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlDatabaseNameRegex,
+		utils.SpannerPostgresSqlDatabaseNameRegex,
+	); err != nil {
+		return names.DatabaseName{}, conn.DialectUnknown, "", err
+	}
+
+	databaseName, err := names.ParseDatabase(parent)
+	if err != nil {
+		return names.DatabaseName{}, conn.DialectUnknown, "", status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+
+	dialect, err := s.conn.Dialect(ctx, parent)
+	if err != nil {
+		return names.DatabaseName{}, conn.DialectUnknown, "", err
+	}
+	if dialect == conn.DialectPostgreSQL {
+		return databaseName, dialect, "public", nil
+	}
+
+	return databaseName, dialect, "", nil
+}
+
+// querySchemaObjects runs sql, which selects object_name and, for objects owned
+// by a table, table_name.
+func (s *SpannerService) querySchemaObjects(ctx context.Context, parent, sql string, params ...any) ([]*schemaObjectRow, error) {
+	var rows []*schemaObjectRow
+	if err := s.conn.Query(ctx, parent, &rows, sql, params...); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing schema objects: %v", err)
+	}
+
+	return rows, nil
+}
+
+// tableListRow is one row of INFORMATION_SCHEMA.TABLES as ListSpannerTables
+// reads it.
+type tableListRow struct {
+	TableName       string         `gorm:"column:table_name"`
+	ParentTableName sql.NullString `gorm:"column:parent_table_name"`
+	OnDeleteAction  sql.NullString `gorm:"column:on_delete_action"`
+	InterleaveType  sql.NullString `gorm:"column:interleave_type"`
+}
+
+// ListSpannerTables lists the tables of the parent database, ordered by name.
+// Only Name and Interleave are populated; GetSpannerTable hydrates the
+// columns of a single table.
+func (s *SpannerService) ListSpannerTables(ctx context.Context, parent string) ([]*schema.SpannerTable, error) {
+	databaseName, _, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*tableListRow
+	if err := s.conn.Query(ctx, parent, &rows,
+		"SELECT table_name AS table_name, parent_table_name AS parent_table_name,"+
+			" on_delete_action AS on_delete_action, interleave_type AS interleave_type"+
+			" FROM information_schema.tables"+
+			" WHERE table_schema = ? AND table_type = 'BASE TABLE' ORDER BY table_name",
+		schemaName,
+	); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error listing tables: %v", err)
+	}
+
+	res := make([]*schema.SpannerTable, 0, len(rows))
+	for _, row := range rows {
+		table := &schema.SpannerTable{
+			Name: names.TableName{
+				Project:  databaseName.Project,
+				Instance: databaseName.Instance,
+				Database: databaseName.Database,
+				Table:    row.TableName,
+			}.String(),
+		}
+		// Mirrors SpannerTable.Get: only INTERLEAVE IN PARENT carries an
+		// ON DELETE action.
+		if row.ParentTableName.String != "" && row.InterleaveType.String != "" {
+			table.Interleave = &schema.SpannerTableInterleave{ParentTable: row.ParentTableName.String}
+			if row.InterleaveType.String == "IN PARENT" {
+				table.Interleave.OnDelete = schema.SpannerTableConstraintActionFromString(row.OnDeleteAction.String)
+			}
+		}
+		res = append(res, table)
+	}
+
+	return res, nil
+}
+
+// ListSpannerTableIndexes lists the secondary indexes of every table in the
+// parent database. Primary keys and the indexes Spanner manages to back
+// foreign keys are left out.
+func (s *SpannerService) ListSpannerTableIndexes(ctx context.Context, parent string) ([]string, error) {
+	databaseName, dialect, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// spanner_is_managed is a BOOL in GoogleSQL and a YES/NO string in
+	// PostgreSQL.
+	unmanaged := "NOT spanner_is_managed"
+	if dialect == conn.DialectPostgreSQL {
+		unmanaged = "spanner_is_managed = 'NO'"
+	}
+
+	rows, err := s.querySchemaObjects(ctx, parent,
+		"SELECT table_name AS table_name, index_name AS object_name FROM information_schema.indexes"+
+			" WHERE table_schema = ? AND index_type = 'INDEX' AND "+unmanaged+" ORDER BY table_name, index_name",
+		schemaName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, names.IndexName{
+			Project:  databaseName.Project,
+			Instance: databaseName.Instance,
+			Database: databaseName.Database,
+			Table:    row.TableName,
+			Index:    row.ObjectName,
+		}.String())
+	}
+
+	return res, nil
+}
+
+// ListSpannerTableForeignKeyConstraints lists the foreign key constraints of
+// every table in the parent database.
+func (s *SpannerService) ListSpannerTableForeignKeyConstraints(ctx context.Context, parent string) ([]string, error) {
+	databaseName, _, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.querySchemaObjects(ctx, parent,
+		"SELECT table_name AS table_name, constraint_name AS object_name FROM information_schema.table_constraints"+
+			" WHERE table_schema = ? AND constraint_type = 'FOREIGN KEY' ORDER BY table_name, constraint_name",
+		schemaName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, names.ForeignKeyName{
+			Project:    databaseName.Project,
+			Instance:   databaseName.Instance,
+			Database:   databaseName.Database,
+			Table:      row.TableName,
+			Constraint: row.ObjectName,
+		}.String())
+	}
+
+	return res, nil
+}
+
+// ListSpannerTableRowDeletionPolicies lists the tables of the parent database
+// that have a row deletion (TTL) policy. A table has at most one, so the
+// policy is named by its table.
+func (s *SpannerService) ListSpannerTableRowDeletionPolicies(ctx context.Context, parent string) ([]string, error) {
+	databaseName, _, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.querySchemaObjects(ctx, parent,
+		"SELECT table_name AS table_name FROM information_schema.tables"+
+			" WHERE table_schema = ? AND row_deletion_policy_expression IS NOT NULL ORDER BY table_name",
+		schemaName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, names.TableName{
+			Project:  databaseName.Project,
+			Instance: databaseName.Instance,
+			Database: databaseName.Database,
+			Table:    row.TableName,
+		}.String())
+	}
+
+	return res, nil
+}
+
+// ListSpannerSequences lists the sequences of the parent database.
+func (s *SpannerService) ListSpannerSequences(ctx context.Context, parent string) ([]string, error) {
+	databaseName, dialect, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// The PostgreSQL INFORMATION_SCHEMA.SEQUENCES follows the standard column
+	// names; the GoogleSQL one does not.
+	sql := "SELECT name AS object_name FROM information_schema.sequences" +
+		" WHERE schema = ? ORDER BY name"
+	if dialect == conn.DialectPostgreSQL {
+		sql = "SELECT sequence_name AS object_name FROM information_schema.sequences" +
+			" WHERE sequence_schema = ? ORDER BY sequence_name"
+	}
+
+	rows, err := s.querySchemaObjects(ctx, parent, sql, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, names.SequenceName{
+			Project:  databaseName.Project,
+			Instance: databaseName.Instance,
+			Database: databaseName.Database,
+			Sequence: row.ObjectName,
+		}.String())
+	}
+
+	return res, nil
+}
+
+// ListTableIamBindings lists one binding per (table, role) pair that holds
+// privileges on the table or on some of its columns in the parent database.
+// Grants to system roles are left out.
+func (s *SpannerService) ListTableIamBindings(ctx context.Context, parent string) ([]string, error) {
+	databaseName, _, schemaName, err := s.defaultSchema(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.querySchemaObjects(ctx, parent,
+		"SELECT DISTINCT table_name AS table_name, grantee AS object_name FROM ("+
+			"SELECT table_schema, table_name, grantee FROM information_schema.table_privileges"+
+			" UNION ALL SELECT table_schema, table_name, grantee FROM information_schema.column_privileges"+
+			") AS grants WHERE table_schema = ? ORDER BY table_name, object_name",
+		schemaName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if schema.IsSystemRole(row.ObjectName) {
+			continue
+		}
+
+		res = append(res, names.TableRoleName{
+			Project:  databaseName.Project,
+			Instance: databaseName.Instance,
+			Database: databaseName.Database,
+			Table:    row.TableName,
+			Role:     row.ObjectName,
+		}.String())
+	}
+
+	return res, nil
+}
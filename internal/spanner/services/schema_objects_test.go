@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+	"terraform-provider-alis/internal/spanner/schema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Each listed name must be one the matching resource accepts as its import
+// ID, or terraform query would generate import blocks that fail.
+func TestListSchemaObjects_ReturnsImportIds(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		rows  []*schemaObjectRow
+		list  func(*SpannerService) ([]string, error)
+		want  []string
+	}{
+		{
+			name:  "indexes",
+			query: "information_schema.indexes",
+			rows:  []*schemaObjectRow{{TableName: "users", ObjectName: "users_by_email"}},
+			list: func(s *SpannerService) ([]string, error) {
+				return s.ListSpannerTableIndexes(context.Background(), testDatabase)
+			},
+			want: []string{testDatabase + "/tables/users/indexes/users_by_email"},
+		},
+		{
+			name:  "foreign keys",
+			query: "constraint_type = 'FOREIGN KEY'",
+			rows:  []*schemaObjectRow{{TableName: "orders", ObjectName: "FK_orders_users"}},
+			list: func(s *SpannerService) ([]string, error) {
+				return s.ListSpannerTableForeignKeyConstraints(context.Background(), testDatabase)
+			},
+			want: []string{testDatabase + "/tables/orders/constraints/FK_orders_users"},
+		},
+		{
+			name:  "row deletion policies",
+			query: "row_deletion_policy_expression IS NOT NULL",
+			rows:  []*schemaObjectRow{{TableName: "events"}},
+			list: func(s *SpannerService) ([]string, error) {
+				return s.ListSpannerTableRowDeletionPolicies(context.Background(), testDatabase)
+			},
+			want: []string{testDatabase + "/tables/events"},
+		},
+		{
+			name:  "sequences",
+			query: "information_schema.sequences",
+			rows:  []*schemaObjectRow{{ObjectName: "order_ids"}},
+			list: func(s *SpannerService) ([]string, error) {
+				return s.ListSpannerSequences(context.Background(), testDatabase)
+			},
+			want: []string{testDatabase + "/sequences/order_ids"},
+		},
+		{
+			name:  "table iam bindings skip system roles",
+			query: "information_schema.table_privileges",
+			rows: []*schemaObjectRow{
+				{TableName: "orders", ObjectName: "analyst"},
+				{TableName: "orders", ObjectName: "spanner_sys_reader"},
+				{TableName: "users", ObjectName: "public"},
+			},
+			list: func(s *SpannerService) ([]string, error) {
+				return s.ListTableIamBindings(context.Background(), testDatabase)
+			},
+			want: []string{testDatabase + "/tables/orders/tableRoles/analyst"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := connfake.New()
+			fake.OnQuery(tt.query, tt.rows)
+
+			got, err := tt.list(NewSpannerService(fake))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			queries := fake.OpsOf(connfake.OpQuery)
+			require.Len(t, queries, 1)
+			assert.Equal(t, []any{""}, queries[0].Params, "GoogleSQL objects live in the unnamed default schema")
+		})
+	}
+}
+
+// Tables come back with their interleave so callers can filter by parent
+// without hydrating every table.
+func TestListSpannerTables_ReadsInterleave(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("table_type = 'BASE TABLE'", []*tableListRow{
+		{TableName: "orders", ParentTableName: sql.NullString{String: "users", Valid: true}, OnDeleteAction: sql.NullString{String: "CASCADE", Valid: true}, InterleaveType: sql.NullString{String: "IN PARENT", Valid: true}},
+		{TableName: "order_events", ParentTableName: sql.NullString{String: "orders", Valid: true}, InterleaveType: sql.NullString{String: "IN", Valid: true}},
+		{TableName: "users"},
+	})
+
+	got, err := NewSpannerService(fake).ListSpannerTables(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, []*schema.SpannerTable{
+		{
+			Name:       testDatabase + "/tables/orders",
+			Interleave: &schema.SpannerTableInterleave{ParentTable: "users", OnDelete: schema.SpannerTableConstraintActionCascade},
+		},
+		{
+			Name:       testDatabase + "/tables/order_events",
+			Interleave: &schema.SpannerTableInterleave{ParentTable: "orders"},
+		},
+		{Name: testDatabase + "/tables/users"},
+	}, got)
+
+	queries := fake.OpsOf(connfake.OpQuery)
+	require.Len(t, queries, 1)
+	assert.Equal(t, []any{""}, queries[0].Params)
+}
+
+// PostgreSQL names its default schema and uses the standard sequence columns.
+func TestListSpannerSequences_PostgreSQL(t *testing.T) {
+	fake := connfake.New()
+	fake.SetDialect(testDatabase, conn.DialectPostgreSQL)
+	fake.OnQuery("sequence_schema = ?", []*schemaObjectRow{{ObjectName: "order_ids"}})
+
+	got, err := NewSpannerService(fake).ListSpannerSequences(context.Background(), testDatabase)
+	require.NoError(t, err)
+	assert.Equal(t, []string{testDatabase + "/sequences/order_ids"}, got)
+
+	queries := fake.OpsOf(connfake.OpQuery)
+	require.Len(t, queries, 1)
+	assert.Equal(t, []any{"public"}, queries[0].Params)
+}
+
+func TestListSpannerTables_InvalidParent(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).ListSpannerTables(context.Background(), testTable)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, fake.OpsOf(connfake.OpQuery))
+}
@@ -2,10 +2,12 @@ package services
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"terraform-provider-alis/internal/spanner/conn"
 	"terraform-provider-alis/internal/spanner/names"
 	"terraform-provider-alis/internal/spanner/schema"
 	"terraform-provider-alis/internal/utils"
@@ -178,6 +180,63 @@ func (s *SpannerService) GetSpannerSequence(ctx context.Context, name string) (*
 	}, nil
 }
 
+// sequenceStateRow is the single row GET_INTERNAL_SEQUENCE_STATE returns.
+type sequenceStateRow struct {
+	State sql.NullInt64 `gorm:"column:state"`
+}
+
+// GetSpannerSequenceState reads the internal counter of a sequence via
+// GET_INTERNAL_SEQUENCE_STATE: the counter value behind the last value the
+// sequence produced, before bit reversal. The result is nil while the sequence
+// has not produced a value yet. codes.NotFound is returned when the sequence
+// does not exist.
+func (s *SpannerService) GetSpannerSequenceState(ctx context.Context, name string) (*wrapperspb.Int64Value, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"name",
+		name,
+		utils.SpannerGoogleSqlSequenceNameRegex,
+		utils.SpannerPostgresSqlSequenceNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	sequenceName, err := names.ParseSequence(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument name (%s): %v", name, err)
+	}
+	database := sequenceName.DatabaseName().String()
+
+	// Reading the state of a missing sequence fails the whole query with an
+	// error indistinguishable from any other, so look the sequence up first.
+	if _, err := s.GetSpannerSequence(ctx, name); err != nil {
+		return nil, err
+	}
+
+	dialect, err := s.conn.Dialect(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sequence is an identifier, not a value, in GoogleSQL, so it cannot
+	// be bound; the name was validated above. It is quoted so reserved words
+	// resolve, and in PostgreSQL so mixed case is not folded away.
+	query := fmt.Sprintf("SELECT GET_INTERNAL_SEQUENCE_STATE(SEQUENCE `%s`) AS state", sequenceName.Sequence)
+	if dialect == conn.DialectPostgreSQL {
+		query = fmt.Sprintf(`SELECT spanner.get_internal_sequence_state('"%s"') AS state`, sequenceName.Sequence)
+	}
+
+	var row sequenceStateRow
+	if err := s.conn.Query(ctx, database, &row, query); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting sequence state: %v", err)
+	}
+	if !row.State.Valid {
+		return nil, nil
+	}
+
+	return wrapperspb.Int64(row.State.Int64), nil
+}
+
 // UpdateSpannerSequence applies the sequence's options in place via ALTER
 // SEQUENCE ... SET OPTIONS.
 func (s *SpannerService) UpdateSpannerSequence(ctx context.Context, sequence *schema.SpannerSequence) (*schema.SpannerSequence, error) {
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn"
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testSequence = testDatabase + "/sequences/order_ids"
+
+// seedSequence makes GetSpannerSequence find the test sequence.
+func seedSequence(fake *connfake.Fake) {
+	fake.OnQuery("INFORMATION_SCHEMA.SEQUENCES", []*SequenceRow{{SequenceName: "order_ids"}})
+}
+
+func TestGetSpannerSequenceState(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   conn.Dialect
+		state     sql.NullInt64
+		wantQuery string
+		want      *wrapperspb.Int64Value
+	}{
+		{
+			name:      "used sequence",
+			dialect:   conn.DialectGoogleSQL,
+			state:     sql.NullInt64{Int64: 42, Valid: true},
+			wantQuery: "SELECT GET_INTERNAL_SEQUENCE_STATE(SEQUENCE `order_ids`) AS state",
+			want:      wrapperspb.Int64(42),
+		},
+		{
+			name:      "unused sequence",
+			dialect:   conn.DialectGoogleSQL,
+			wantQuery: "SELECT GET_INTERNAL_SEQUENCE_STATE(SEQUENCE `order_ids`) AS state",
+		},
+		{
+			name:      "postgresql",
+			dialect:   conn.DialectPostgreSQL,
+			state:     sql.NullInt64{Int64: 7, Valid: true},
+			wantQuery: `SELECT spanner.get_internal_sequence_state('"order_ids"') AS state`,
+			want:      wrapperspb.Int64(7),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := connfake.New()
+			fake.SetDialect(testDatabase, tt.dialect)
+			seedSequence(fake)
+			fake.OnQuery(" AS state", []*sequenceStateRow{{State: tt.state}})
+
+			got, err := NewSpannerService(fake).GetSpannerSequenceState(context.Background(), testSequence)
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got, "a sequence that has produced nothing has no state")
+			} else {
+				require.NotNil(t, got)
+				assert.Equal(t, tt.want.GetValue(), got.GetValue())
+			}
+
+			queries := fake.OpsOf(connfake.OpQuery)
+			require.NotEmpty(t, queries)
+			assert.Equal(t, tt.wantQuery, queries[len(queries)-1].SQL)
+		})
+	}
+}
+
+// A sequence named by a reserved word, or with mixed case in PostgreSQL,
+// only resolves quoted.
+func TestGetSpannerSequenceState_QuotesSequenceId(t *testing.T) {
+	tests := []struct {
+		name      string
+		dialect   conn.Dialect
+		sequence  string
+		wantQuery string
+	}{
+		{
+			name:      "googlesql reserved word",
+			dialect:   conn.DialectGoogleSQL,
+			sequence:  "select",
+			wantQuery: "SELECT GET_INTERNAL_SEQUENCE_STATE(SEQUENCE `select`) AS state",
+		},
+		{
+			name:      "postgresql reserved word",
+			dialect:   conn.DialectPostgreSQL,
+			sequence:  "select",
+			wantQuery: `SELECT spanner.get_internal_sequence_state('"select"') AS state`,
+		},
+		{
+			name:      "postgresql mixed case",
+			dialect:   conn.DialectPostgreSQL,
+			sequence:  "OrderIds",
+			wantQuery: `SELECT spanner.get_internal_sequence_state('"OrderIds"') AS state`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := connfake.New()
+			fake.SetDialect(testDatabase, tt.dialect)
+			fake.OnQuery("INFORMATION_SCHEMA.SEQUENCES", []*SequenceRow{{SequenceName: tt.sequence}})
+			fake.OnQuery(" AS state", []*sequenceStateRow{{State: sql.NullInt64{Int64: 1, Valid: true}}})
+
+			_, err := NewSpannerService(fake).GetSpannerSequenceState(context.Background(), testDatabase+"/sequences/"+tt.sequence)
+			require.NoError(t, err)
+
+			queries := fake.OpsOf(connfake.OpQuery)
+			require.NotEmpty(t, queries)
+			assert.Equal(t, tt.wantQuery, queries[len(queries)-1].SQL)
+		})
+	}
+}
+
+// The state function fails the query for a missing sequence with an error
+// that says nothing about the sequence, so existence is checked first.
+func TestGetSpannerSequenceState_NotFound(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).GetSpannerSequenceState(context.Background(), testSequence)
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Len(t, fake.OpsOf(connfake.OpQuery), 1, "the state is not queried")
+}
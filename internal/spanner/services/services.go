@@ -16,8 +16,18 @@ const (
 
 	DatabaseEncryptionType_CustomerManaged         = "CUSTOMER_MANAGED_ENCRYPTION"
 	DatabaseEncryptionType_GoogleDefaultEncryption = "GOOGLE_DEFAULT_ENCRYPTION"
+
+	BackupEncryptionType_UseDatabaseEncryption = "USE_DATABASE_ENCRYPTION"
 )
 
+// BackupEncryptionTypes lists the encryption types a backup schedule can
+// request for the backups it takes.
+var BackupEncryptionTypes = []string{
+	BackupEncryptionType_UseDatabaseEncryption,
+	DatabaseEncryptionType_GoogleDefaultEncryption,
+	DatabaseEncryptionType_CustomerManaged,
+}
+
 // SpannerService talks to Spanner exclusively through the Connection module —
 // no client construction, logging, credential, or retry concerns live here.
 type SpannerService struct {
@@ -92,33 +92,23 @@ func (s *IntegrationSuite) TestDatabaseRoleLifecycle() {
 	s.T().Cleanup(func() { _ = s.service.DeleteDatabaseRole(context.Background(), roleName) })
 	s.Equal(roleName, role.GetName())
 
-	// Role reads go through the ListDatabaseRoles admin API, which the
-	// emulator does not implement; DDL-side create/delete is still covered.
-	readable := true
+	// On the emulator, which lacks the ListDatabaseRoles admin API, these reads
+	// exercise the INFORMATION_SCHEMA fallback.
 	got, err := s.service.GetDatabaseRole(s.ctx, roleName)
-	if !s.live && status.Code(err) == codes.Unimplemented {
-		readable = false
-		s.T().Log("emulator does not implement the ListDatabaseRoles admin API; skipping read-back assertions")
-	} else {
-		s.Require().NoError(err, "GetDatabaseRole")
-		s.Equal(roleName, got.GetName())
-	}
-
-	if readable {
-		roles, _, err := s.service.ListDatabaseRoles(s.ctx, s.db, 0, "")
-		s.Require().NoError(err, "ListDatabaseRoles")
-		names := make([]string, 0, len(roles))
-		for _, r := range roles {
-			names = append(names, r.GetName())
-		}
-		s.Contains(names, roleName)
+	s.Require().NoError(err, "GetDatabaseRole")
+	s.Equal(roleName, got.GetName())
+
+	roles, _, err := s.service.ListDatabaseRoles(s.ctx, s.db, 0, "")
+	s.Require().NoError(err, "ListDatabaseRoles")
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.GetName())
 	}
+	s.Contains(names, roleName)
 
 	s.Require().NoError(s.service.DeleteDatabaseRole(s.ctx, roleName), "DeleteDatabaseRole")
-	if readable {
-		_, err := s.service.GetDatabaseRole(s.ctx, roleName)
-		s.Equal(codes.NotFound, status.Code(err), "GetDatabaseRole after delete")
-	}
+	_, err = s.service.GetDatabaseRole(s.ctx, roleName)
+	s.Equal(codes.NotFound, status.Code(err), "GetDatabaseRole after delete")
 }
 
 func (s *IntegrationSuite) TestTableLifecycle() {
@@ -293,6 +283,19 @@ func (s *IntegrationSuite) TestForeignKeyLifecycle() {
 	s.Equal("user_id", got.Column)
 	s.Equal("tftest_fk_users", got.ReferencedTable)
 	s.Equal("id", got.ReferencedColumn)
+	s.True(got.IsEnforced(), "constraint created without an ENFORCED clause")
+
+	_, err = s.service.RecreateSpannerTableForeignKeyConstraint(s.ctx, childName, &schema.SpannerTableForeignKeyConstraint{
+		Name:             constraintName,
+		Column:           "user_id",
+		ReferencedTable:  "tftest_fk_users",
+		ReferencedColumn: "id",
+		Enforced:         wrapperspb.Bool(false),
+	})
+	s.Require().NoError(err, "RecreateSpannerTableForeignKeyConstraint")
+	got, err = s.service.GetSpannerTableForeignKeyConstraint(s.ctx, childName, constraintName)
+	s.Require().NoError(err, "GetSpannerTableForeignKeyConstraint after recreate")
+	s.False(got.IsEnforced(), "constraint recreated NOT ENFORCED")
 
 	s.Require().NoError(
 		s.service.DeleteSpannerTableForeignKeyConstraint(s.ctx, childName, constraintName),
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"terraform-provider-alis/internal/spanner/names"
 	"terraform-provider-alis/internal/spanner/schema"
@@ -13,7 +15,8 @@ import (
 
 // SetTableIamBinding makes the role's privileges on the table named by parent
 // match binding exactly: missing permissions are granted and permissions the
-// role holds that binding omits are revoked, in one DDL batch. The binding is
+// role holds that binding omits are revoked, in one DDL batch. Permissions
+// narrowed to columns are diffed column by column. The binding is
 // authoritative for its own role only — other roles on the table keep their
 // grants.
 func (s *SpannerService) SetTableIamBinding(ctx context.Context, parent string, binding *TablePolicyBinding) (*TablePolicyBinding, error) {
@@ -32,22 +35,10 @@ func (s *SpannerService) SetTableIamBinding(ctx context.Context, parent string,
 		return nil, status.Error(codes.InvalidArgument, "Invalid argument binding, field is required but not provided")
 	}
 
-	// The role reaches GRANT/REVOKE by concatenation, so it must be a bare
-	// identifier — never a fragment that could carry its own DDL.
-	if err := utils.ValidateDialectArgument(
-		"binding.role",
-		binding.Role,
-		utils.SpannerGoogleSqlRoleIdRegex,
-		utils.SpannerPostgresSqlRoleIdRegex,
-	); err != nil {
+	if err := validateBinding("binding", binding); err != nil {
 		return nil, err
 	}
 
-	// Ensure permissions are provided
-	if len(binding.Permissions) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Invalid argument binding.permissions, field is required but not provided")
-	}
-
 	parentName, err := names.ParseTable(parent)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
@@ -60,66 +51,174 @@ func (s *SpannerService) SetTableIamBinding(ctx context.Context, parent string,
 		return nil, err
 	}
 
-	granted, err := s.grantedPermissions(ctx, parent, binding.Role)
+	granted, err := s.grantedPrivileges(ctx, parent, binding.Role)
 	if err != nil {
 		return nil, err
 	}
 
-	desired := make(map[TablePolicyBindingPermission]bool, len(binding.Permissions))
-	for _, permission := range binding.Permissions {
-		desired[permission] = true
+	var statements []string
+	revoke, grant := bindingChangeDdl(tableId, binding, granted)
+	if revoke != "" {
+		statements = append(statements, revoke)
+	}
+	if grant != "" {
+		statements = append(statements, grant)
+	}
+
+	if err := s.conn.ExecuteDDL(ctx, database, statements...); err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// validateBinding checks a binding before any of it reaches DDL. field names
+// the binding in error messages.
+func validateBinding(field string, binding *TablePolicyBinding) error {
+	// The role reaches GRANT/REVOKE by concatenation, so it must be a bare
+	// identifier — never a fragment that could carry its own DDL.
+	if err := utils.ValidateDialectArgument(
+		field+".role",
+		binding.Role,
+		utils.SpannerGoogleSqlRoleIdRegex,
+		utils.SpannerPostgresSqlRoleIdRegex,
+	); err != nil {
+		return err
+	}
+
+	// Ensure permissions are provided
+	if len(binding.Permissions) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid argument %s.permissions, field is required but not provided", field)
+	}
+
+	// Column names reach GRANT/REVOKE the same way, so each must be a bare
+	// identifier too.
+	for permission, columns := range binding.Columns {
+		if permission == TablePolicyBindingPermission_DELETE {
+			return status.Errorf(codes.InvalidArgument, "Invalid argument %s.columns, DELETE cannot be granted on columns", field)
+		}
+		if !slices.Contains(binding.Permissions, permission) {
+			return status.Errorf(codes.InvalidArgument, "Invalid argument %s.columns, %s is not in %s.permissions", field, permission, field)
+		}
+		if len(columns) == 0 {
+			return status.Errorf(codes.InvalidArgument, "Invalid argument %s.columns, the column list for %s is empty", field, permission)
+		}
+		for _, column := range columns {
+			if err := utils.ValidateDialectArgument(
+				field+".columns",
+				column,
+				utils.SpannerGoogleSqlColumnIdRegex,
+				utils.SpannerPostgresSqlColumnIdRegex,
+			); err != nil {
+				return err
+			}
+		}
 	}
 
-	var toGrant, toRevoke []TablePolicyBindingPermission
+	return nil
+}
+
+// bindingChangeDdl renders the REVOKE and GRANT statements that take the
+// role from the privileges in granted to those in want; the REVOKE must run
+// first. Permissions narrowed to columns are diffed column by column. Either
+// statement is empty when there is nothing to revoke or grant.
+func bindingChangeDdl(table string, want, granted *TablePolicyBinding) (revoke, grant string) {
+	var toGrant, toRevoke []string
 	for _, permission := range TablePolicyBindingPermissions {
+		wantHeld, wantColumns := heldPrivilege(want, permission)
+		haveHeld, haveColumns := heldPrivilege(granted, permission)
+
 		switch {
-		case desired[permission] && !granted[permission]:
-			toGrant = append(toGrant, permission)
-		case !desired[permission] && granted[permission]:
-			toRevoke = append(toRevoke, permission)
+		case wantHeld && !haveHeld:
+			toGrant = append(toGrant, privilegeOperand(permission, wantColumns))
+		case !wantHeld && haveHeld:
+			toRevoke = append(toRevoke, privilegeOperand(permission, haveColumns))
+		case !wantHeld:
+			// Neither wanted nor held.
+		case wantColumns == nil && haveColumns == nil:
+			// Held on the whole table, as wanted.
+		case wantColumns == nil || haveColumns == nil:
+			// Widening to or narrowing from the whole table replaces the grant.
+			toRevoke = append(toRevoke, privilegeOperand(permission, haveColumns))
+			toGrant = append(toGrant, privilegeOperand(permission, wantColumns))
+		default:
+			if revoke := columnsMissingFrom(haveColumns, wantColumns); len(revoke) > 0 {
+				toRevoke = append(toRevoke, schema.ColumnPrivilege(permission.String(), revoke))
+			}
+			if grant := columnsMissingFrom(wantColumns, haveColumns); len(grant) > 0 {
+				toGrant = append(toGrant, schema.ColumnPrivilege(permission.String(), grant))
+			}
 		}
 	}
 
-	var statements []string
 	if len(toRevoke) > 0 {
-		statements = append(statements, schema.RevokeTablePrivilegesDdl(tableId, binding.Role, permissionNames(toRevoke)))
+		revoke = schema.RevokeTablePrivilegesDdl(table, want.Role, toRevoke)
 	}
 	if len(toGrant) > 0 {
-		statements = append(statements, schema.GrantTablePrivilegesDdl(tableId, binding.Role, permissionNames(toGrant)))
+		grant = schema.GrantTablePrivilegesDdl(table, want.Role, toGrant)
 	}
 
-	if err := s.conn.ExecuteDDL(ctx, database, statements...); err != nil {
-		return nil, err
+	return revoke, grant
+}
+
+// heldPrivilege reports whether binding includes permission and, when it is
+// narrowed to columns, which ones, sorted. Nil columns mean the whole table.
+func heldPrivilege(binding *TablePolicyBinding, permission TablePolicyBindingPermission) (bool, []string) {
+	if !slices.Contains(binding.Permissions, permission) {
+		return false, nil
 	}
 
-	return binding, nil
+	columns, ok := binding.Columns[permission]
+	if !ok {
+		return true, nil
+	}
+
+	return true, slices.Sorted(slices.Values(columns))
+}
+
+// privilegeOperand renders permission as a GRANT/REVOKE operand, narrowed to
+// columns when there are any.
+func privilegeOperand(permission TablePolicyBindingPermission, columns []string) string {
+	if columns == nil {
+		return permission.String()
+	}
+
+	return schema.ColumnPrivilege(permission.String(), columns)
 }
 
-// grantedPermissions reports the permissions role currently holds on the table
-// as a set. Holding none is not an error here: that is the ordinary starting
-// state when the binding is first created.
-func (s *SpannerService) grantedPermissions(ctx context.Context, parent, role string) (map[TablePolicyBindingPermission]bool, error) {
-	granted := map[TablePolicyBindingPermission]bool{}
+// columnsMissingFrom returns the columns of a that b does not contain, in the
+// order of a.
+func columnsMissingFrom(a, b []string) []string {
+	var res []string
+	for _, column := range a {
+		if !slices.Contains(b, column) {
+			res = append(res, column)
+		}
+	}
+
+	return res
+}
 
+// grantedPrivileges reads the privileges role currently holds on the table.
+// Holding none is not an error here: that is the ordinary starting state when
+// the binding is first created, and it is reported as an empty binding.
+func (s *SpannerService) grantedPrivileges(ctx context.Context, parent, role string) (*TablePolicyBinding, error) {
 	existing, err := s.GetTableIamBinding(ctx, parent, role)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
-			return granted, nil
+			return &TablePolicyBinding{Role: role}, nil
 		}
 
 		return nil, err
 	}
 
-	for _, permission := range existing.Permissions {
-		granted[permission] = true
-	}
-
-	return granted, nil
+	return existing, nil
 }
 
 // GetTableIamBinding reads the permissions currently granted to role on the
-// table from INFORMATION_SCHEMA.TABLE_PRIVILEGES. codes.NotFound is returned
-// when the role holds no privileges on the table.
+// table from INFORMATION_SCHEMA.TABLE_PRIVILEGES, and those granted on only
+// some of its columns from INFORMATION_SCHEMA.COLUMN_PRIVILEGES. codes.NotFound
+// is returned when the role holds no privileges on the table.
 func (s *SpannerService) GetTableIamBinding(ctx context.Context, parent, role string) (*TablePolicyBinding, error) {
 	// Validate arguments
 	if err := utils.ValidateDialectArgument(
@@ -153,22 +252,78 @@ func (s *SpannerService) GetTableIamBinding(ctx context.Context, parent, role st
 		return nil, status.Errorf(codes.Internal, "Error getting table IAM binding: %v", err)
 	}
 
-	if len(rows) == 0 {
-		return nil, status.Errorf(codes.NotFound, "Table IAM binding %s not found", role)
+	var columnRows []*ColumnPermissionsRow
+	if err := s.conn.Query(ctx, database, &columnRows,
+		"SELECT * FROM INFORMATION_SCHEMA.COLUMN_PRIVILEGES WHERE table_name = ? AND grantee = ?", tableId, role); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting table IAM binding: %v", err)
 	}
 
-	binding := &TablePolicyBinding{
-		Role: role,
+	bindings := bindingsFromRows(rows, columnRows)
+	if len(bindings) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Table IAM binding %s not found", role)
 	}
+
+	return bindings[0], nil
+}
+
+// bindingsFromRows groups TABLE_PRIVILEGES and COLUMN_PRIVILEGES rows of one
+// table into one binding per grantee, sorted by role. Permissions come out in
+// TablePolicyBindingPermissions order and column lists sorted, so bindings
+// read at different times compare equal when the grants have not changed.
+func bindingsFromRows(rows []*TablePermissionsRow, columnRows []*ColumnPermissionsRow) []*TablePolicyBinding {
+	tableWide := map[string]map[TablePolicyBindingPermission]bool{}
 	for _, row := range rows {
-		if row.GetPermission() == TablePolicyBindingPermission_UNSPECIFIED {
+		if tableWide[row.GRANTEE] == nil {
+			tableWide[row.GRANTEE] = map[TablePolicyBindingPermission]bool{}
+		}
+		tableWide[row.GRANTEE][row.GetPermission()] = true
+	}
+	columns := map[string]map[TablePolicyBindingPermission][]string{}
+	for _, row := range columnRows {
+		// A privilege held on the whole table covers every column, whether or
+		// not the column view repeats it.
+		permission := row.GetPermission()
+		if tableWide[row.GRANTEE][permission] {
 			continue
 		}
+		if columns[row.GRANTEE] == nil {
+			columns[row.GRANTEE] = map[TablePolicyBindingPermission][]string{}
+		}
+		columns[row.GRANTEE][permission] = append(columns[row.GRANTEE][permission], row.COLUMN_NAME)
+	}
 
-		binding.Permissions = append(binding.Permissions, row.GetPermission())
+	roles := slices.Collect(maps.Keys(tableWide))
+	for role := range columns {
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
 	}
+	slices.Sort(roles)
 
-	return binding, nil
+	bindings := make([]*TablePolicyBinding, 0, len(roles))
+	for _, role := range roles {
+		binding := &TablePolicyBinding{
+			Role: role,
+		}
+		for _, permission := range TablePolicyBindingPermissions {
+			switch {
+			case tableWide[role][permission]:
+				binding.Permissions = append(binding.Permissions, permission)
+			case len(columns[role][permission]) > 0:
+				binding.Permissions = append(binding.Permissions, permission)
+				if binding.Columns == nil {
+					binding.Columns = map[TablePolicyBindingPermission][]string{}
+				}
+				binding.Columns[permission] = slices.Sorted(slices.Values(columns[role][permission]))
+			}
+		}
+		// Only unrecognized privilege types: nothing this provider manages.
+		if len(binding.Permissions) > 0 {
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings
 }
 
 // DeleteTableIamBinding revokes every permission the role currently holds on
@@ -212,21 +367,21 @@ func (s *SpannerService) DeleteTableIamBinding(ctx context.Context, parent, role
 		return err
 	}
 
-	granted, err := s.grantedPermissions(ctx, parent, role)
+	granted, err := s.grantedPrivileges(ctx, parent, role)
 	if err != nil {
 		return err
 	}
 
-	var permissions []TablePolicyBindingPermission
+	var privileges []string
 	for _, permission := range TablePolicyBindingPermissions {
-		if granted[permission] {
-			permissions = append(permissions, permission)
+		if held, columns := heldPrivilege(granted, permission); held {
+			privileges = append(privileges, privilegeOperand(permission, columns))
 		}
 	}
 	// Nothing granted: a REVOKE with no privileges is not valid DDL.
-	if len(permissions) == 0 {
+	if len(privileges) == 0 {
 		return nil
 	}
 
-	return s.conn.ExecuteDDL(ctx, database, schema.RevokeTablePrivilegesDdl(tableId, role, permissionNames(permissions)))
+	return s.conn.ExecuteDDL(ctx, database, schema.RevokeTablePrivilegesDdl(tableId, role, privileges))
 }
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddTableIamMember grants member's one permission to its role on the table
+// named by parent, narrowed to member.Columns when set. It is additive: the
+// role's other permissions, and other grants of the same permission, are left
+// as they are.
+func (s *SpannerService) AddTableIamMember(ctx context.Context, parent string, member *TablePolicyMember) (*TablePolicyMember, error) {
+	parentName, err := validateTableIamMember(parent, member)
+	if err != nil {
+		return nil, err
+	}
+	database := parentName.DatabaseName().String()
+
+	// Verify the database exists before issuing DDL
+	if _, err := s.conn.Dialect(ctx, database); err != nil {
+		return nil, err
+	}
+
+	grant := schema.GrantTablePrivilegesDdl(parentName.Table, member.Role, []string{
+		privilegeOperand(member.Permission, sortedColumns(member.Columns)),
+	})
+	if err := s.conn.ExecuteDDL(ctx, database, grant); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// GetTableIamMember reads how role holds permission on the table named by
+// parent: Columns lists the columns it is narrowed to, sorted, and is nil when
+// it is held on the whole table. codes.NotFound is returned when the role
+// does not hold the permission at all.
+func (s *SpannerService) GetTableIamMember(ctx context.Context, parent, role string, permission TablePolicyBindingPermission) (*TablePolicyMember, error) {
+	if !slices.Contains(TablePolicyBindingPermissions, permission) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument permission (%s), must be one of %v", permission, SpannerTablePolicyBindingPermissions)
+	}
+
+	binding, err := s.GetTableIamBinding(ctx, parent, role)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Table IAM member %s of role %s not found", permission, role)
+		}
+
+		return nil, err
+	}
+
+	held, columns := heldPrivilege(binding, permission)
+	if !held {
+		return nil, status.Errorf(codes.NotFound, "Table IAM member %s of role %s not found", permission, role)
+	}
+
+	return &TablePolicyMember{
+		Role:       role,
+		Permission: permission,
+		Columns:    columns,
+	}, nil
+}
+
+// RemoveTableIamMember revokes member's one permission from its role, and
+// nothing else. Only what is still visibly granted is revoked: a table-wide
+// member whose grant has already narrowed to columns, or a column member
+// whose role now holds the permission table-wide, revokes nothing, since the
+// grant it would revoke is no longer the one it made. Having nothing to
+// revoke is success, so a destroy converges after an out-of-band REVOKE.
+func (s *SpannerService) RemoveTableIamMember(ctx context.Context, parent string, member *TablePolicyMember) error {
+	parentName, err := validateTableIamMember(parent, member)
+	if err != nil {
+		return err
+	}
+	database := parentName.DatabaseName().String()
+
+	// Verify the database exists before issuing DDL
+	if _, err := s.conn.Dialect(ctx, database); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	granted, err := s.grantedPrivileges(ctx, parent, member.Role)
+	if err != nil {
+		return err
+	}
+
+	held, heldColumns := heldPrivilege(granted, member.Permission)
+	var operand string
+	switch {
+	case !held:
+		// Already revoked.
+	case member.Columns == nil && heldColumns == nil:
+		operand = member.Permission.String()
+	case member.Columns != nil && heldColumns != nil:
+		// Other members may hold other columns of the same permission.
+		var columns []string
+		for _, column := range sortedColumns(member.Columns) {
+			if slices.Contains(heldColumns, column) {
+				columns = append(columns, column)
+			}
+		}
+		if len(columns) > 0 {
+			operand = schema.ColumnPrivilege(member.Permission.String(), columns)
+		}
+	}
+	if operand == "" {
+		return nil
+	}
+
+	return s.conn.ExecuteDDL(ctx, database, schema.RevokeTablePrivilegesDdl(parentName.Table, member.Role, []string{operand}))
+}
+
+// validateTableIamMember checks parent and member before any of them reach
+// DDL, returning the parsed parent.
+func validateTableIamMember(parent string, member *TablePolicyMember) (names.TableName, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlTableNameRegex,
+		utils.SpannerPostgresSqlTableNameRegex,
+	); err != nil {
+		return names.TableName{}, err
+	}
+
+	// Ensure member is provided
+	if member == nil {
+		return names.TableName{}, status.Error(codes.InvalidArgument, "Invalid argument member, field is required but not provided")
+	}
+
+	if !slices.Contains(TablePolicyBindingPermissions, member.Permission) {
+		return names.TableName{}, status.Errorf(codes.InvalidArgument, "Invalid argument member.permission (%s), must be one of %v", member.Permission, SpannerTablePolicyBindingPermissions)
+	}
+
+	if err := validateBinding("member", member.binding()); err != nil {
+		return names.TableName{}, err
+	}
+
+	parentName, err := names.ParseTable(parent)
+	if err != nil {
+		return names.TableName{}, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+
+	return parentName, nil
+}
+
+// sortedColumns returns a sorted copy of columns, nil for nil.
+func sortedColumns(columns []string) []string {
+	if columns == nil {
+		return nil
+	}
+
+	return slices.Sorted(slices.Values(columns))
+}
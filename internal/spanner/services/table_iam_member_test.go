@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A member only ever grants: whatever else the role holds stays untouched,
+// so two configurations can each add a permission to one shared role.
+func TestAddTableIamMember_OnlyGrants(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("TABLE_PRIVILEGES", privilegeRows("SELECT", "DELETE"))
+
+	_, err := NewSpannerService(fake).AddTableIamMember(context.Background(), testTable, &TablePolicyMember{
+		Role:       testRole,
+		Permission: TablePolicyBindingPermission_UPDATE,
+		Columns:    []string{"name", "email"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GRANT UPDATE(email, name) ON TABLE tftest_table TO ROLE tftest_role"}, fake.Statements())
+}
+
+func TestAddTableIamMember_RejectsInvalidMember(t *testing.T) {
+	tests := map[string]*TablePolicyMember{
+		"missing permission": {Role: testRole},
+		"invalid role":       {Role: "r; DROP TABLE t", Permission: TablePolicyBindingPermission_SELECT},
+		"columns on delete":  {Role: testRole, Permission: TablePolicyBindingPermission_DELETE, Columns: []string{"name"}},
+		"empty columns":      {Role: testRole, Permission: TablePolicyBindingPermission_SELECT, Columns: []string{}},
+	}
+	for name, member := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := connfake.New()
+
+			_, err := NewSpannerService(fake).AddTableIamMember(context.Background(), testTable, member)
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Empty(t, fake.Statements())
+		})
+	}
+}
+
+func TestGetTableIamMember(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("TABLE_PRIVILEGES", privilegeRows("INSERT"))
+	fake.OnQuery("COLUMN_PRIVILEGES", columnPrivilegeRows("SELECT", "name", "email"))
+	s := NewSpannerService(fake)
+
+	got, err := s.GetTableIamMember(context.Background(), testTable, testRole, TablePolicyBindingPermission_SELECT)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email", "name"}, got.Columns)
+
+	got, err = s.GetTableIamMember(context.Background(), testTable, testRole, TablePolicyBindingPermission_INSERT)
+	require.NoError(t, err)
+	assert.Nil(t, got.Columns)
+
+	_, err = s.GetTableIamMember(context.Background(), testTable, testRole, TablePolicyBindingPermission_DELETE)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// Destroying a member revokes its own permission and never touches the rest
+// of the role's grants, including other columns of the same permission.
+func TestRemoveTableIamMember_RevokesOnlyItsPermission(t *testing.T) {
+	tests := []struct {
+		name    string
+		member  *TablePolicyMember
+		wantDdl []string
+	}{
+		{
+			name:    "table-wide",
+			member:  &TablePolicyMember{Role: testRole, Permission: TablePolicyBindingPermission_INSERT},
+			wantDdl: []string{"REVOKE INSERT ON TABLE tftest_table FROM ROLE tftest_role"},
+		},
+		{
+			name:    "columns still held",
+			member:  &TablePolicyMember{Role: testRole, Permission: TablePolicyBindingPermission_SELECT, Columns: []string{"name", "phone"}},
+			wantDdl: []string{"REVOKE SELECT(name) ON TABLE tftest_table FROM ROLE tftest_role"},
+		},
+		{
+			name:   "already revoked",
+			member: &TablePolicyMember{Role: testRole, Permission: TablePolicyBindingPermission_DELETE},
+		},
+		{
+			name:   "narrowed out of band",
+			member: &TablePolicyMember{Role: testRole, Permission: TablePolicyBindingPermission_SELECT},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := connfake.New()
+			fake.OnQuery("TABLE_PRIVILEGES", privilegeRows("INSERT", "UPDATE"))
+			fake.OnQuery("COLUMN_PRIVILEGES", columnPrivilegeRows("SELECT", "name", "email"))
+
+			err := NewSpannerService(fake).RemoveTableIamMember(context.Background(), testTable, tt.member)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDdl, fake.Statements())
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"terraform-provider-alis/internal/spanner/names"
+	"terraform-provider-alis/internal/spanner/schema"
+	"terraform-provider-alis/internal/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTableIamPolicy reads every role's privileges on the table named by parent
+// from INFORMATION_SCHEMA.TABLE_PRIVILEGES and COLUMN_PRIVILEGES, one binding
+// per role sorted by role. A table nobody holds privileges on has an empty
+// policy, not a missing one. System roles are left out: their grants are
+// Spanner's, not the policy's.
+func (s *SpannerService) GetTableIamPolicy(ctx context.Context, parent string) (*TablePolicy, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlTableNameRegex,
+		utils.SpannerPostgresSqlTableNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	parentName, err := names.ParseTable(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+	database := parentName.DatabaseName().String()
+	tableId := parentName.Table
+
+	var rows []*TablePermissionsRow
+	if err := s.conn.Query(ctx, database, &rows,
+		"SELECT * FROM INFORMATION_SCHEMA.TABLE_PRIVILEGES WHERE table_name = ?", tableId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting table IAM policy: %v", err)
+	}
+
+	var columnRows []*ColumnPermissionsRow
+	if err := s.conn.Query(ctx, database, &columnRows,
+		"SELECT * FROM INFORMATION_SCHEMA.COLUMN_PRIVILEGES WHERE table_name = ?", tableId); err != nil {
+		return nil, status.Errorf(codes.Internal, "Error getting table IAM policy: %v", err)
+	}
+
+	policy := &TablePolicy{
+		Bindings: make([]*TablePolicyBinding, 0),
+	}
+	for _, binding := range bindingsFromRows(rows, columnRows) {
+		if schema.IsSystemRole(binding.Role) {
+			continue
+		}
+		policy.Bindings = append(policy.Bindings, binding)
+	}
+
+	return policy, nil
+}
+
+// TablePolicyChange is the DDL that brings a table's privileges in line with
+// a policy. Revocations run before Grants, in one batch.
+type TablePolicyChange struct {
+	// REVOKE statements, one per role losing privileges
+	Revocations []string
+	// GRANT statements, one per role gaining privileges
+	Grants []string
+}
+
+// Statements returns the change as the DDL batch that applies it.
+func (c *TablePolicyChange) Statements() []string {
+	return slices.Concat(c.Revocations, c.Grants)
+}
+
+// PlanTableIamPolicy diffs policy against the privileges currently held on
+// the table named by parent, returning the change SetTableIamPolicy would
+// make: each declared binding is brought in line as SetTableIamBinding would,
+// and every privilege held by a role the policy does not declare is revoked.
+func (s *SpannerService) PlanTableIamPolicy(ctx context.Context, parent string, policy *TablePolicy) (*TablePolicyChange, error) {
+	// Validate arguments
+	if err := utils.ValidateDialectArgument(
+		"parent",
+		parent,
+		utils.SpannerGoogleSqlTableNameRegex,
+		utils.SpannerPostgresSqlTableNameRegex,
+	); err != nil {
+		return nil, err
+	}
+
+	// Ensure policy is provided
+	if policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid argument policy, field is required but not provided")
+	}
+
+	declared := make(map[string]bool, len(policy.Bindings))
+	for _, binding := range policy.Bindings {
+		if binding == nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid argument policy.bindings, bindings must not be null")
+		}
+		if err := validateBinding("policy.bindings", binding); err != nil {
+			return nil, err
+		}
+		// Two bindings for one role would each undo the other's revokes.
+		if declared[binding.Role] {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid argument policy.bindings, role %s is bound more than once", binding.Role)
+		}
+		if schema.IsSystemRole(binding.Role) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid argument policy.bindings, system role %s cannot be granted table privileges", binding.Role)
+		}
+		declared[binding.Role] = true
+	}
+
+	parentName, err := names.ParseTable(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid argument parent (%s): %v", parent, err)
+	}
+
+	current, err := s.GetTableIamPolicy(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make(map[string]*TablePolicyBinding, len(current.Bindings))
+	for _, binding := range current.Bindings {
+		granted[binding.Role] = binding
+	}
+
+	change := &TablePolicyChange{}
+	add := func(revoke, grant string) {
+		if revoke != "" {
+			change.Revocations = append(change.Revocations, revoke)
+		}
+		if grant != "" {
+			change.Grants = append(change.Grants, grant)
+		}
+	}
+	for _, binding := range current.Bindings {
+		if !declared[binding.Role] {
+			add(bindingChangeDdl(parentName.Table, &TablePolicyBinding{Role: binding.Role}, binding))
+		}
+	}
+	for _, binding := range policy.Bindings {
+		have, ok := granted[binding.Role]
+		if !ok {
+			have = &TablePolicyBinding{Role: binding.Role}
+		}
+		add(bindingChangeDdl(parentName.Table, binding, have))
+	}
+
+	return change, nil
+}
+
+// SetTableIamPolicy makes the privileges on the table named by parent match
+// policy exactly, for every role, and returns the change it made. The diff is
+// recomputed against the privileges as they are now; when plannedRevocations
+// is non-nil and the recomputed revocations differ from it, nothing runs and
+// codes.Aborted is returned, so a grant made out of band since plan time is
+// never revoked without having been reviewed.
+func (s *SpannerService) SetTableIamPolicy(ctx context.Context, parent string, policy *TablePolicy, plannedRevocations []string) (*TablePolicyChange, error) {
+	change, err := s.PlanTableIamPolicy(ctx, parent, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if plannedRevocations != nil && !slices.Equal(plannedRevocations, change.Revocations) {
+		return nil, status.Errorf(
+			codes.Aborted,
+			"The privileges on table %s changed since the plan was made; the policy now revokes %q instead of %q",
+			parent, change.Revocations, plannedRevocations,
+		)
+	}
+
+	// PlanTableIamPolicy validated parent.
+	parentName, _ := names.ParseTable(parent)
+	if err := s.conn.ExecuteDDL(ctx, parentName.DatabaseName().String(), change.Statements()...); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
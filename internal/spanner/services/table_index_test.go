@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-alis/internal/spanner/conn/connfake"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Submitting the drop and the create as separate schema changes leaves
+// queries running without the index for as long as the create takes to
+// backfill; one batch keeps that gap to a single schema change.
+func TestRecreateSpannerTableIndex_SingleBatch(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).RecreateSpannerTableIndex(context.Background(), testTable, &SpannerTableIndex{
+		Name: "tftest_idx",
+		Columns: []*SpannerTableIndexColumn{
+			{Name: "display_name", Order: SpannerTableIndexColumnOrder_ASC},
+			{Name: "created_at", Order: SpannerTableIndexColumnOrder_DESC},
+		},
+		Unique: wrapperspb.Bool(true),
+	})
+	require.NoError(t, err)
+
+	ops := fake.OpsOf(connfake.OpExecuteDDL)
+	require.Len(t, ops, 1)
+	assert.Equal(t, []string{
+		"DROP INDEX tftest_idx",
+		"CREATE UNIQUE INDEX tftest_idx ON tftest_table (display_name ASC, created_at DESC)",
+	}, ops[0].Statements)
+}
+
+func TestRecreateSpannerTableIndex_ValidatesBeforeDropping(t *testing.T) {
+	fake := connfake.New()
+
+	_, err := NewSpannerService(fake).RecreateSpannerTableIndex(context.Background(), testTable, &SpannerTableIndex{
+		Name: "tftest_idx",
+	})
+	require.Error(t, err)
+	assert.Empty(t, fake.OpsOf(connfake.OpExecuteDDL), "an index without columns must not drop the existing one")
+}
+
+// INDEX_COLUMNS reports STORING columns with no ordering or position; read as
+// key columns they would sort first and turn the index into a different one.
+func TestListSpannerTableIndices_SeparatesStoring(t *testing.T) {
+	fake := connfake.New()
+	fake.OnQuery("information_schema.indexes i", []*Index{
+		{IndexName: "PRIMARY_KEY", IndexType: "PRIMARY_KEY", ColumnName: "id", ColumnOrdering: "ASC", OrdinalPosition: 1},
+		{IndexName: "users_by_email", IndexType: "INDEX", ColumnName: "display_name"},
+		{IndexName: "users_by_email", IndexType: "INDEX", ColumnName: "created_at", ColumnOrdering: "DESC", OrdinalPosition: 2},
+		{IndexName: "users_by_email", IndexType: "INDEX", ColumnName: "email", ColumnOrdering: "ASC", OrdinalPosition: 1},
+		{IndexName: "users_by_email", IndexType: "INDEX", ColumnName: "is_active"},
+	})
+
+	got, err := NewSpannerService(fake).ListSpannerTableIndices(context.Background(), testTable)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, []*SpannerTableIndexColumn{
+		{Name: "email", Order: SpannerTableIndexColumnOrder_ASC},
+		{Name: "created_at", Order: SpannerTableIndexColumnOrder_DESC},
+	}, got[0].Columns)
+	assert.Equal(t, []string{"display_name", "is_active"}, got[0].Storing)
+}
@@ -70,6 +70,35 @@ type TablePolicyBinding struct {
 	Role string
 	// The permissions to grant to role.
 	Permissions []TablePolicyBindingPermission
+	// Columns narrows a permission to the listed columns of the table. A
+	// permission without an entry is granted on the whole table. DELETE
+	// cannot be narrowed.
+	Columns map[TablePolicyBindingPermission][]string
+}
+
+// TablePolicyMember is a single permission held by a role on a table. Unlike
+// a TablePolicyBinding it says nothing about the role's other permissions.
+type TablePolicyMember struct {
+	// The role the permission is granted to.
+	Role string
+	// The permission granted to role.
+	Permission TablePolicyBindingPermission
+	// Columns narrows the permission to the listed columns of the table. Nil
+	// grants it on the whole table. DELETE cannot be narrowed.
+	Columns []string
+}
+
+// binding returns the member as a one-permission binding.
+func (m *TablePolicyMember) binding() *TablePolicyBinding {
+	binding := &TablePolicyBinding{
+		Role:        m.Role,
+		Permissions: []TablePolicyBindingPermission{m.Permission},
+	}
+	if m.Columns != nil {
+		binding.Columns = map[TablePolicyBindingPermission][]string{m.Permission: m.Columns}
+	}
+
+	return binding
 }
 
 // TablePolicy represents a Spanner table roles policy.
@@ -88,7 +117,28 @@ type TablePermissionsRow struct {
 // GetPermission maps the row's PRIVILEGE_TYPE to a
 // TablePolicyBindingPermission, returning UNSPECIFIED for unrecognized types.
 func (r TablePermissionsRow) GetPermission() TablePolicyBindingPermission {
-	switch r.PRIVILEGE_TYPE {
+	return permissionFromPrivilegeType(r.PRIVILEGE_TYPE)
+}
+
+// ColumnPermissionsRow is one row of INFORMATION_SCHEMA.COLUMN_PRIVILEGES.
+// Field names match the column names so the query scanner can map them.
+type ColumnPermissionsRow struct {
+	TABLE_NAME     string
+	COLUMN_NAME    string
+	PRIVILEGE_TYPE string
+	GRANTEE        string
+}
+
+// GetPermission maps the row's PRIVILEGE_TYPE to a
+// TablePolicyBindingPermission, returning UNSPECIFIED for unrecognized types.
+func (r ColumnPermissionsRow) GetPermission() TablePolicyBindingPermission {
+	return permissionFromPrivilegeType(r.PRIVILEGE_TYPE)
+}
+
+// permissionFromPrivilegeType maps an INFORMATION_SCHEMA privilege type to a
+// TablePolicyBindingPermission.
+func permissionFromPrivilegeType(privilegeType string) TablePolicyBindingPermission {
+	switch privilegeType {
 	case "SELECT":
 		return TablePolicyBindingPermission_SELECT
 	case "INSERT":
@@ -120,6 +170,7 @@ type Index struct {
 type Constraint struct {
 	CONSTRAINT_NAME    string
 	CONSTRAINT_TYPE    string
+	ENFORCED           string
 	CONSTRAINED_TABLE  string
 	CONSTRAINED_COLUMN string
 	UPDATE_RULE        string
@@ -141,3 +192,20 @@ type SequenceRow struct {
 	OptionValue *string `gorm:"column:OPTION_VALUE"`
 	OptionType  *string `gorm:"column:OPTION_TYPE"`
 }
+
+// DatabaseOptionRow is one row of INFORMATION_SCHEMA.DATABASE_OPTIONS.
+type DatabaseOptionRow struct {
+	OptionName  string  `gorm:"column:OPTION_NAME"`
+	OptionType  string  `gorm:"column:OPTION_TYPE"`
+	OptionValue *string `gorm:"column:OPTION_VALUE"`
+}
+
+// DatabaseDdl is a database's schema as GetDatabaseDdl reports it.
+type DatabaseDdl struct {
+	// The DDL statements, in the order that recreates the schema.
+	Statements []string
+	// The serialized FileDescriptorSet of the proto bundle, or nil.
+	ProtoDescriptors []byte
+	// The hex SHA-256 of the normalized statements and descriptors.
+	Fingerprint string
+}
@@ -12,7 +12,8 @@ import (
 // GetIndexes returns the secondary indexes of a table, reconstructed from the
 // INFORMATION_SCHEMA indexes/index_columns join. The per-column rows are
 // merged into one SpannerTableIndex each, with columns sorted by ordinal
-// position; the PRIMARY_KEY pseudo-index is excluded.
+// position; the PRIMARY_KEY pseudo-index is excluded. STORING columns have no
+// ordering in INDEX_COLUMNS and are collected into Storing instead.
 func GetIndexes(ctx context.Context, cn conn.Connection, database, tableName string) ([]*SpannerTableIndex, error) {
 	// Get the indexes for the table. "" is Spanner's default schema.
 	var results []*Index
@@ -56,6 +57,12 @@ func GetIndexes(ctx context.Context, cn conn.Connection, database, tableName str
 				Unique:  wrapperspb.Bool(r.IsUnique),
 			}
 		}
+		if r.ColumnOrdering == "" {
+			idx.Storing = append(idx.Storing, r.ColumnName)
+			indexMap[r.IndexName] = idx
+			continue
+		}
+
 		var order SpannerTableIndexColumnOrder
 		switch r.ColumnOrdering {
 		case "ASC":
@@ -77,6 +84,8 @@ func GetIndexes(ctx context.Context, cn conn.Connection, database, tableName str
 			return resultsMap[idx.Name][idx.Columns[i].Name].OrdinalPosition < resultsMap[idx.Name][idx.Columns[j].Name].OrdinalPosition
 		})
 
+		sort.Strings(idx.Storing)
+
 		// Append the index to the list
 		indexes = append(indexes, idx)
 	}
@@ -67,6 +67,15 @@ func tableColumnsToSchema(ctx context.Context, list types.List) ([]*tableschema.
 		if !column.ProtoPackage.IsNull() {
 			col.ProtoPackage = wrapperspb.String(column.ProtoPackage.ValueString())
 		}
+		switch column.KeyOrder.ValueString() {
+		case tableschema.SpannerTableIndexColumnOrder_ASC.String():
+			col.KeyOrder = tableschema.SpannerTableIndexColumnOrder_ASC
+		case tableschema.SpannerTableIndexColumnOrder_DESC.String():
+			col.KeyOrder = tableschema.SpannerTableIndexColumnOrder_DESC
+		}
+		if !column.KeyPosition.IsNull() && !column.KeyPosition.IsUnknown() {
+			col.KeyPosition = wrapperspb.Int64(column.KeyPosition.ValueInt64())
+		}
 
 		result = append(result, col)
 	}
@@ -121,6 +130,12 @@ func tableColumnsToModel(ctx context.Context, columns []*tableschema.SpannerTabl
 		if column.ProtoPackage != nil {
 			col.ProtoPackage = types.StringValue(column.ProtoPackage.GetValue())
 		}
+		if column.KeyOrder != tableschema.SpannerTableIndexColumnOrder_UNSPECIFIED {
+			col.KeyOrder = types.StringValue(column.KeyOrder.String())
+		}
+		if column.KeyPosition != nil {
+			col.KeyPosition = types.Int64Value(column.KeyPosition.GetValue())
+		}
 
 		cols = append(cols, col)
 	}
@@ -23,6 +23,8 @@ func fullColumnModel() spannerTableColumn {
 		Required:       types.BoolValue(true),
 		DefaultValue:   types.StringValue("'x'"),
 		ProtoPackage:   types.StringValue("com.example.Msg"),
+		KeyOrder:       types.StringValue("desc"),
+		KeyPosition:    types.Int64Value(1),
 	}
 }
 
@@ -77,6 +79,9 @@ func TestTableColumnsToSchema(t *testing.T) {
 	if full.GetProtoPackage().GetValue() != "com.example.Msg" {
 		t.Errorf("proto package lost: %v", full.ProtoPackage)
 	}
+	if full.GetKeyOrder() != tableschema.SpannerTableIndexColumnOrder_DESC || full.GetKeyPosition().GetValue() != 1 {
+		t.Errorf("key ordering lost: order=%v position=%v", full.KeyOrder, full.KeyPosition)
+	}
 
 	minimal := got[1]
 	if minimal.Name != "email" || minimal.Type != "STRING(MAX)" {
@@ -85,7 +90,8 @@ func TestTableColumnsToSchema(t *testing.T) {
 	// Null model attributes must stay nil wrappers (absent), not become explicit false/zero.
 	if minimal.IsPrimaryKey != nil || minimal.IsComputed != nil || minimal.IsStored != nil ||
 		minimal.AutoUpdateTime != nil || minimal.Size != nil || minimal.Required != nil ||
-		minimal.DefaultValue != nil || minimal.ProtoPackage != nil {
+		minimal.DefaultValue != nil || minimal.ProtoPackage != nil || minimal.KeyPosition != nil ||
+		minimal.KeyOrder != tableschema.SpannerTableIndexColumnOrder_UNSPECIFIED {
 		t.Errorf("minimal column grew explicit values: %+v", minimal)
 	}
 }
@@ -14,7 +14,8 @@ import (
 // tableColumnsRequireReplace is the RequiresReplaceIf handler for schema.columns.
 // It pairs prior and planned columns by name and forces a table replace whenever
 // schema.ClassifyColumnChange reports a change that cannot be applied in place,
-// emitting one warning per affected column.
+// emitting one warning per affected column, plus one for a reordered primary
+// key.
 func tableColumnsRequireReplace(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
 	priorColumns, d := tableColumnsToSchema(ctx, req.StateValue)
 	resp.Diagnostics.Append(d...)
@@ -58,4 +59,9 @@ func tableColumnsRequireReplace(ctx context.Context, req planmodifier.ListReques
 			resp.Diagnostics.AddWarning(fmt.Sprintf("Column %q requires a table replace", name), reason)
 		}
 	}
+
+	if class, reason := tableschema.ClassifyPrimaryKeyChange(priorColumns, plannedColumns); class == tableschema.ColumnRequiresReplace {
+		resp.RequiresReplace = true
+		resp.Diagnostics.AddWarning("Primary key requires a table replace", reason)
+	}
 }
@@ -103,4 +103,20 @@ func TestTableColumnsRequireReplace(t *testing.T) {
 			t.Errorf("RequiresReplace=%v warnings=%d, want false/0", resp.RequiresReplace, resp.Diagnostics.WarningsCount())
 		}
 	})
+
+	t.Run("reordered primary key replaces with one warning", func(t *testing.T) {
+		tenant := spannerTableColumn{Name: types.StringValue("tenant_id"), Type: types.StringValue("STRING(36)"), IsPrimaryKey: types.BoolValue(true)}
+		created := spannerTableColumn{Name: types.StringValue("create_time"), Type: types.StringValue("TIMESTAMP"), IsPrimaryKey: types.BoolValue(true)}
+		createdFirst := created
+		createdFirst.KeyPosition = types.Int64Value(1)
+
+		resp := runRequireReplace(t, []spannerTableColumn{tenant, created}, []spannerTableColumn{tenant, createdFirst})
+		if !resp.RequiresReplace {
+			t.Fatal("RequiresReplace = false, want true")
+		}
+		warns := resp.Diagnostics.Warnings()
+		if len(warns) != 1 || warns[0].Summary() != "Primary key requires a table replace" {
+			t.Errorf("unexpected warnings: %v", warns)
+		}
+	})
 }
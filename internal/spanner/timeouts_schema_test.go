@@ -15,13 +15,21 @@ import (
 // accept the config attribute but never apply it.
 func TestAllResourceSchemas_HaveTimeoutsBlock(t *testing.T) {
 	resources := map[string]resource.Resource{
-		"table":       NewSpannerTableResource(),
-		"index":       NewSpannerTableIndexResource(),
-		"foreign_key": NewTableForeignKeyResource(),
-		"ttl_policy":  NewTableTtlPolicyResource(),
-		"iam_binding": NewTableIamBindingResource(),
-		"role":        NewDatabaseRoleResource(),
-		"sequence":    NewDatabaseSequenceResource(),
+		"database":         NewDatabaseResource(),
+		"database_options": NewDatabaseOptionsResource(),
+		"backup_schedule":  NewBackupScheduleResource(),
+		"table":            NewSpannerTableResource(),
+		"index":            NewSpannerTableIndexResource(),
+		"foreign_key":      NewTableForeignKeyResource(),
+		"ttl_policy":       NewTableTtlPolicyResource(),
+		"iam_binding":      NewTableIamBindingResource(),
+		"iam_policy":       NewTableIamPolicyResource(),
+		"iam_member":       NewTableIamMemberResource(),
+		"role":             NewDatabaseRoleResource(),
+		"role_membership":  NewDatabaseRoleMembershipResource(),
+		"database_iam":     NewDatabaseIamBindingResource(),
+		"sequence":         NewDatabaseSequenceResource(),
+		"schema":           NewSchemaResource(),
 	}
 
 	for name, r := range resources {
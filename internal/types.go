@@ -4,7 +4,13 @@ import (
 	spannerservices "terraform-provider-alis/internal/spanner/services"
 )
 
+// ProviderConfig is what the provider's Configure hands every resource, data
+// source and list resource. GoogleProjectId, SpannerInstance and
+// SpannerDatabase are the provider-level defaults for the project, instance
+// and database attributes; each is empty when the provider leaves it unset.
 type ProviderConfig struct {
 	GoogleProjectId string
+	SpannerInstance string
+	SpannerDatabase string
 	SpannerService  *spannerservices.SpannerService
 }
@@ -3,13 +3,55 @@ package utils
 import (
 	"context"
 	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 
+	"cloud.google.com/go/auth"
+	"cloud.google.com/go/auth/credentials/impersonate"
+	"cloud.google.com/go/auth/oauth2adapt"
 	"cloud.google.com/go/spanner"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	oath2 "golang.org/x/oauth2"
 	googleoauth "golang.org/x/oauth2/google"
 )
 
+// CloudPlatformScope is the scope credentials need to call the IAM
+// Credentials API, which impersonation mints its tokens through.
+const CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// spannerScopes are the scopes credentials get when the caller names none.
+var spannerScopes = []string{
+	spanner.Scope,
+	spanner.AdminScope,
+}
+
+// ReadPathOrContents returns the contents of the file value names, when it
+// names one, and value itself otherwise; isPath reports which. A leading ~/
+// is expanded to the home directory, so credentials can be given as either a
+// key file path or the key's JSON.
+func ReadPathOrContents(value string) (contents string, isPath bool, err error) {
+	path := value
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", true, err
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return value, false, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", true, err
+	}
+
+	return string(data), true, nil
+}
+
 // GetGoogleCredentials retrieves google.Credentials from the provided credentials, access token, or application default credentials.
 // The source priority is as follows:
 //  1. Credentials
@@ -34,10 +76,7 @@ func GetGoogleCredentials(
 ) (*googleoauth.Credentials, error) {
 	// Set default scopes if none are provided
 	if len(scopes) == 0 {
-		scopes = []string{
-			spanner.Scope,
-			spanner.AdminScope,
-		}
+		scopes = spannerScopes
 	}
 
 	// The returned token source outlives the calling RPC: it is cached in
@@ -87,3 +126,71 @@ func GetGoogleCredentials(
 	tflog.Debug(ctx, "Using Application Default Credentials")
 	return creds, nil
 }
+
+// ImpersonateGoogleCredentials returns credentials that act as the service
+// account targetPrincipal, with tokens for the Spanner scopes minted by the
+// IAM Credentials API on behalf of base. When delegates are given, base
+// impersonates through them in order, each holding
+// roles/iam.serviceAccountTokenCreator on the next and the last on
+// targetPrincipal.
+//
+// The IAM Credentials API is the one of universeDomain, or of base when it is
+// empty, and the returned credentials report that universe so clients
+// configured for it accept them. base must carry CloudPlatformScope. The
+// returned token source refreshes itself for as long as the provider runs;
+// nothing is fetched until the first token is needed.
+//
+// Params:
+//   - ctx: {context.Context} - The context to use for the operation(Required)
+//   - base: {google.Credentials} - The credentials impersonating(Required)
+//   - targetPrincipal: {string} - The service account email to act as(Required)
+//   - delegates: {[]string} - The service account emails of the delegation chain
+//   - universeDomain: {string} - The universe domain of the IAM Credentials API
+//   - client: {http.Client} - The client calling the IAM Credentials API,
+//     authenticated as base; nil builds one from base
+//
+// Returns: {google.Credentials}.
+func ImpersonateGoogleCredentials(
+	ctx context.Context,
+	base *googleoauth.Credentials,
+	targetPrincipal string,
+	delegates []string,
+	universeDomain string,
+	client *http.Client,
+) (*googleoauth.Credentials, error) {
+	if base == nil {
+		return nil, errors.New("base credentials are required to impersonate a service account")
+	}
+	if targetPrincipal == "" {
+		return nil, errors.New("targetPrincipal is required to impersonate a service account")
+	}
+
+	universe := auth.CredentialsPropertyFunc(func(context.Context) (string, error) {
+		if universeDomain != "" {
+			return universeDomain, nil
+		}
+		return base.GetUniverseDomain()
+	})
+
+	// The token source this returns outlives the request for the same reason
+	// as in GetGoogleCredentials; the auth library fetches every token with a
+	// background context of its own.
+	impersonated, err := impersonate.NewCredentials(&impersonate.CredentialsOptions{
+		TargetPrincipal: targetPrincipal,
+		Delegates:       delegates,
+		Scopes:          spannerScopes,
+		Credentials: auth.NewCredentials(&auth.CredentialsOptions{
+			TokenProvider:          oauth2adapt.TokenProviderFromTokenSource(base.TokenSource),
+			UniverseDomainProvider: universe,
+		}),
+		Client: client,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Impersonating service account", map[string]any{"target_principal": targetPrincipal})
+	creds := oauth2adapt.Oauth2CredentialsFromAuthCredentials(impersonated)
+	creds.ProjectID = base.ProjectID
+	return creds, nil
+}
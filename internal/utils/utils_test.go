@@ -1,9 +1,17 @@
 package utils
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	oath2 "golang.org/x/oauth2"
+	googleoauth "golang.org/x/oauth2/google"
 )
 
 // fakeServiceAccountJSON parses as Google service-account credentials. The
@@ -73,3 +81,176 @@ func TestGetGoogleCredentials_ApplicationDefault(t *testing.T) {
 		t.Errorf("ProjectID = %q, want %q", creds.ProjectID, "fake-project")
 	}
 }
+
+// roundTripFunc stubs the IAM Credentials API.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// generateAccessTokenRequest is what the stub IAM Credentials API received.
+type generateAccessTokenRequest struct {
+	calls int
+	url   string
+	body  struct {
+		Delegates []string `json:"delegates"`
+		Scope     []string `json:"scope"`
+	}
+}
+
+// iamCredentialsStub returns a client for a stub IAM Credentials API that
+// mints "impersonated-token", and the record of what it received.
+func iamCredentialsStub(t *testing.T) (*http.Client, *generateAccessTokenRequest) {
+	t.Helper()
+
+	got := &generateAccessTokenRequest{}
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		got.calls++
+		got.url = req.URL.String()
+		if err := json.NewDecoder(req.Body).Decode(&got.body); err != nil {
+			t.Errorf("decoding generateAccessToken request: %v", err)
+		}
+		body, _ := json.Marshal(map[string]string{
+			"accessToken": "impersonated-token",
+			"expireTime":  time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(body))), Header: http.Header{}}, nil
+	})}
+
+	return client, got
+}
+
+func TestImpersonateGoogleCredentials(t *testing.T) {
+	base := &googleoauth.Credentials{
+		ProjectID:   "my-project",
+		TokenSource: oath2.StaticTokenSource(&oath2.Token{AccessToken: "base-token"}),
+	}
+	client, got := iamCredentialsStub(t)
+
+	creds, err := ImpersonateGoogleCredentials(
+		t.Context(),
+		base,
+		"schema-admin@my-project.iam.gserviceaccount.com",
+		[]string{"hop@my-project.iam.gserviceaccount.com"},
+		"",
+		client,
+	)
+	if err != nil {
+		t.Fatalf("ImpersonateGoogleCredentials: %v", err)
+	}
+	if got.calls != 0 {
+		t.Errorf("impersonation fetched %d tokens up front, want none until first use", got.calls)
+	}
+	if creds.ProjectID != "my-project" {
+		t.Errorf("ProjectID = %q, want the base project", creds.ProjectID)
+	}
+
+	for range 2 {
+		token, err := creds.TokenSource.Token()
+		if err != nil {
+			t.Fatalf("impersonated token: %v", err)
+		}
+		if token.AccessToken != "impersonated-token" {
+			t.Errorf("AccessToken = %q, want the impersonated token", token.AccessToken)
+		}
+	}
+	if got.calls != 1 {
+		t.Errorf("generateAccessToken called %d times, want 1: a valid token is reused", got.calls)
+	}
+	if want := "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/schema-admin@my-project.iam.gserviceaccount.com:generateAccessToken"; got.url != want {
+		t.Errorf("token minted at %s, want %s", got.url, want)
+	}
+	if len(got.body.Delegates) != 1 || got.body.Delegates[0] != "projects/-/serviceAccounts/hop@my-project.iam.gserviceaccount.com" {
+		t.Errorf("delegates = %v, want the delegation chain", got.body.Delegates)
+	}
+	if len(got.body.Scope) != len(spannerScopes) {
+		t.Errorf("scopes = %v, want %v", got.body.Scope, spannerScopes)
+	}
+}
+
+// Outside the default universe, tokens come from that universe's IAM
+// Credentials API, and the credentials report the universe so clients
+// configured for it accept them.
+func TestImpersonateGoogleCredentials_UniverseDomain(t *testing.T) {
+	base := &googleoauth.Credentials{
+		ProjectID:   "my-project",
+		TokenSource: oath2.StaticTokenSource(&oath2.Token{AccessToken: "base-token"}),
+	}
+	client, got := iamCredentialsStub(t)
+
+	creds, err := ImpersonateGoogleCredentials(t.Context(), base, "schema-admin@my-project.iam.gserviceaccount.com", nil, "example.com", client)
+	if err != nil {
+		t.Fatalf("ImpersonateGoogleCredentials: %v", err)
+	}
+	if _, err := creds.TokenSource.Token(); err != nil {
+		t.Fatalf("impersonated token: %v", err)
+	}
+
+	if !strings.HasPrefix(got.url, "https://iamcredentials.example.com/") {
+		t.Errorf("token minted at %s, want the IAM Credentials API of example.com", got.url)
+	}
+	if universe, err := creds.GetUniverseDomain(); err != nil || universe != "example.com" {
+		t.Errorf("GetUniverseDomain() = %q, %v; want example.com", universe, err)
+	}
+}
+
+func TestImpersonateGoogleCredentials_RequiresTarget(t *testing.T) {
+	base := &googleoauth.Credentials{TokenSource: oath2.StaticTokenSource(&oath2.Token{AccessToken: "base-token"})}
+	if _, err := ImpersonateGoogleCredentials(t.Context(), base, "", nil, "", nil); err == nil {
+		t.Error("ImpersonateGoogleCredentials without a target principal succeeded, want error")
+	}
+	if _, err := ImpersonateGoogleCredentials(t.Context(), nil, "sa@my-project.iam.gserviceaccount.com", nil, "", nil); err == nil {
+		t.Error("ImpersonateGoogleCredentials without base credentials succeeded, want error")
+	}
+}
+
+func TestReadPathOrContents(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	keyFile := filepath.Join(home, "key.json")
+	if err := os.WriteFile(keyFile, []byte(fakeServiceAccountJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		value      string
+		wantPath   bool
+		wantResult string
+	}{
+		"absolute path":     {value: keyFile, wantPath: true, wantResult: fakeServiceAccountJSON},
+		"home-relative":     {value: "~/key.json", wantPath: true, wantResult: fakeServiceAccountJSON},
+		"JSON content":      {value: fakeServiceAccountJSON, wantResult: fakeServiceAccountJSON},
+		"missing file":      {value: filepath.Join(home, "missing.json"), wantResult: filepath.Join(home, "missing.json")},
+		"empty stays empty": {value: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, isPath, err := ReadPathOrContents(tt.value)
+			if err != nil {
+				t.Fatalf("ReadPathOrContents: %v", err)
+			}
+			if isPath != tt.wantPath || got != tt.wantResult {
+				t.Errorf("ReadPathOrContents = (%q, %t), want (%q, %t)", got, isPath, tt.wantResult, tt.wantPath)
+			}
+		})
+	}
+}
+
+// A key file path reaches GetGoogleCredentials as the file's contents.
+func TestGetGoogleCredentials_CredentialsFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(keyFile, []byte(fakeServiceAccountJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	contents, _, err := ReadPathOrContents(keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	creds, err := GetGoogleCredentials(t.Context(), "", contents, "")
+	if err != nil {
+		t.Fatalf("GetGoogleCredentials with a key file: %v", err)
+	}
+	if creds.ProjectID != "fake-project" {
+		t.Errorf("ProjectID = %q, want %q", creds.ProjectID, "fake-project")
+	}
+}
@@ -83,6 +83,22 @@ var (
 		CutPrefixAndSuffix(SpannerPostgresSqlDatabaseIdRegex, "^", "$"),
 		CutPrefixAndSuffix(SpannerPostgresSqlRoleIdRegex, "^", "$"),
 	)
+	SpannerGoogleSqlDatabaseRoleMemberNameRegex = fmt.Sprintf(
+		`^projects\/%s\/instances\/%s\/databases\/%s\/databaseRoles\/%s\/members\/%s$`,
+		CutPrefixAndSuffix(ProjectIdRegex, "^", "$"),
+		CutPrefixAndSuffix(InstanceIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerGoogleSqlDatabaseIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerGoogleSqlRoleIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerGoogleSqlRoleIdRegex, "^", "$"),
+	)
+	SpannerPostgresSqlDatabaseRoleMemberNameRegex = fmt.Sprintf(
+		`^projects\/%s\/instances\/%s\/databases\/%s\/databaseRoles\/%s\/members\/%s$`,
+		CutPrefixAndSuffix(ProjectIdRegex, "^", "$"),
+		CutPrefixAndSuffix(InstanceIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerPostgresSqlDatabaseIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerPostgresSqlRoleIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerPostgresSqlRoleIdRegex, "^", "$"),
+	)
 	SpannerGoogleSqlTableIdRegex   = `^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
 	SpannerPostgresSqlTableIdRegex = `^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
 	SpannerGoogleSqlTableNameRegex = fmt.Sprintf(
@@ -129,6 +145,23 @@ var (
 		CutPrefixAndSuffix(InstanceIdRegex, "^", "$"),
 		CutPrefixAndSuffix(SpannerPostgresSqlBackupIdRegex, "^", "$"),
 	)
+	// Backup schedule IDs are admin API names, so one pattern serves both
+	// dialects; only the parent database ID differs.
+	SpannerBackupScheduleIdRegex            = `^[a-z][a-z0-9_\-]{0,58}[a-z0-9]$`
+	SpannerGoogleSqlBackupScheduleNameRegex = fmt.Sprintf(
+		`^projects\/%s\/instances\/%s\/databases\/%s\/backupSchedules\/%s$`,
+		CutPrefixAndSuffix(ProjectIdRegex, "^", "$"),
+		CutPrefixAndSuffix(InstanceIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerGoogleSqlDatabaseIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerBackupScheduleIdRegex, "^", "$"),
+	)
+	SpannerPostgresSqlBackupScheduleNameRegex = fmt.Sprintf(
+		`^projects\/%s\/instances\/%s\/databases\/%s\/backupSchedules\/%s$`,
+		CutPrefixAndSuffix(ProjectIdRegex, "^", "$"),
+		CutPrefixAndSuffix(InstanceIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerPostgresSqlDatabaseIdRegex, "^", "$"),
+		CutPrefixAndSuffix(SpannerBackupScheduleIdRegex, "^", "$"),
+	)
 	SpannerGoogleSqlColumnIdRegex       = `^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
 	SpannerPostgresSqlColumnIdRegex     = `^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
 	SpannerGoogleSqlIndexIdRegex        = `^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
@@ -2,7 +2,8 @@ package validators
 
 import (
 	"context"
-	"os"
+
+	"terraform-provider-alis/internal/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	googleoauth "golang.org/x/oauth2/google"
@@ -34,28 +35,37 @@ func (v googleCredentialsValidator) ValidateString(
 		return
 	}
 
-	value := request.ConfigValue.ValueString()
-
-	// If this is a path and we can stat it, assume it's ok; unreadable or
-	// malformed file contents still fail later, at provider Configure.
-	if _, err := os.Stat(value); err == nil {
+	value, isPath, err := utils.ReadPathOrContents(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Google Credentials",
+			"Could not read the credentials file: "+err.Error(),
+		)
 		return
 	}
+
 	//nolint:staticcheck // deprecated parse API; validation-only use until the provider migrates to cloud.google.com/go/auth
 	if _, err := googleoauth.CredentialsFromJSON(ctx, []byte(value)); err != nil {
 		// Deliberately does not echo the value: credentials are secret.
+		detail := "Value is neither a path to an existing credentials file nor valid Google credentials JSON: "
+		if isPath {
+			detail = "The credentials file does not hold valid Google credentials JSON: "
+		}
 		response.Diagnostics.AddAttributeError(
 			request.Path,
 			"Invalid Google Credentials",
-			"Value is neither a path to an existing credentials file nor valid Google credentials JSON: "+err.Error(),
+			detail+err.Error(),
 		)
 	}
 }
 
 // GoogleCredentialsValidator returns a validator which ensures that a
-// configured credentials string is either a path to an existing file (assumed
-// to hold credentials) or raw JSON that parses as Google credentials. Null
-// (unconfigured) and unknown (known after apply) values are skipped.
+// configured credentials string is either a path to a file holding Google
+// credentials JSON (a leading ~/ is expanded) or that JSON itself, as
+// utils.ReadPathOrContents reads it. Null (unconfigured) and unknown (known
+// after apply) values are skipped; credentials from the environment are
+// checked at provider Configure instead.
 func GoogleCredentialsValidator() validator.String {
 	return googleCredentialsValidator{}
 }
@@ -20,10 +20,16 @@ const testServiceAccountJSON = `{
 }`
 
 func TestGoogleCredentialsValidator(t *testing.T) {
-	existingFile := filepath.Join(t.TempDir(), "creds.json")
-	if err := os.WriteFile(existingFile, []byte("not even json"), 0o600); err != nil {
+	dir := t.TempDir()
+	invalidFile := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalidFile, []byte("not even json"), 0o600); err != nil {
 		t.Fatal(err)
 	}
+	validFile := filepath.Join(dir, "creds.json")
+	if err := os.WriteFile(validFile, []byte(testServiceAccountJSON), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("HOME", dir)
 
 	cases := map[string]struct {
 		value     types.String
@@ -31,11 +37,13 @@ func TestGoogleCredentialsValidator(t *testing.T) {
 	}{
 		"null skipped":    {types.StringNull(), false},
 		"unknown skipped": {types.StringUnknown(), false},
-		// An existing file passes on the stat alone; contents are checked
-		// later, at provider Configure.
-		"existing file path": {types.StringValue(existingFile), false},
-		"valid JSON":         {types.StringValue(testServiceAccountJSON), false},
-		"invalid JSON":       {types.StringValue("not-json-and-not-a-file"), true},
+		// A path is read: the file has to hold credentials, not just exist.
+		"credentials file path":      {types.StringValue(validFile), false},
+		"home-relative file path":    {types.StringValue("~/creds.json"), false},
+		"file with invalid contents": {types.StringValue(invalidFile), true},
+		"missing home-relative path": {types.StringValue("~/missing.json"), true},
+		"valid JSON":                 {types.StringValue(testServiceAccountJSON), false},
+		"invalid JSON":               {types.StringValue("not-json-and-not-a-file"), true},
 	}
 
 	for name, tc := range cases {
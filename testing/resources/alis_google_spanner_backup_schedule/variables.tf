@@ -0,0 +1,3 @@
+variable "GOOGLE_PROJECT" {}
+variable "SPANNER_INSTANCE" {}
+variable "SPANNER_DATABASE" {}
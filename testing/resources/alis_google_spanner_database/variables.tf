@@ -0,0 +1,2 @@
+variable "GOOGLE_PROJECT" {}
+variable "SPANNER_INSTANCE" {}
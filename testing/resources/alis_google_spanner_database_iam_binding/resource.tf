@@ -0,0 +1,32 @@
+// Local manual verification for alis_google_spanner_database_iam_binding.
+// SPANNER_IAM_MEMBER must be an existing principal, e.g.
+// serviceAccount:{email}; IAM rejects unknown ones. Editing the condition's
+// expression must update the binding in place; editing its title must
+// replace it.
+resource "alis_google_spanner_database_role" "tf_test_fgac" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "tf_test_fgac"
+}
+
+resource "alis_google_spanner_database_iam_binding" "fine_grained_access_user" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "roles/spanner.fineGrainedAccessUser"
+  members  = [var.SPANNER_IAM_MEMBER]
+}
+
+resource "alis_google_spanner_database_iam_binding" "role_user" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "roles/spanner.databaseRoleUser"
+  members  = [var.SPANNER_IAM_MEMBER]
+
+  condition = {
+    title      = alis_google_spanner_database_role.tf_test_fgac.role
+    expression = "resource.type == \"spanner.googleapis.com/DatabaseRole\" && resource.name.endsWith(\"/databaseRoles/${alis_google_spanner_database_role.tf_test_fgac.role}\")"
+  }
+}
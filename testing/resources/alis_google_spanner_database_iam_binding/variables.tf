@@ -0,0 +1,4 @@
+variable "GOOGLE_PROJECT" {}
+variable "SPANNER_INSTANCE" {}
+variable "SPANNER_DATABASE" {}
+variable "SPANNER_IAM_MEMBER" {}
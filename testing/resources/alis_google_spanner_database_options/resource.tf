@@ -0,0 +1,7 @@
+resource "alis_google_spanner_database_options" "test_database_options" {
+  project                  = var.GOOGLE_PROJECT
+  instance                 = var.SPANNER_INSTANCE
+  database                 = var.SPANNER_DATABASE
+  version_retention_period = "3d"
+  optimizer_version        = 6
+}
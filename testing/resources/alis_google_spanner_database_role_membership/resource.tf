@@ -0,0 +1,23 @@
+// Local manual verification for alis_google_spanner_database_role_membership.
+resource "alis_google_spanner_database_role" "parent" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "tf_test_parent"
+}
+
+resource "alis_google_spanner_database_role" "child" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "tf_test_child"
+}
+
+// Expect tf_test_child to list tf_test_parent in INFORMATION_SCHEMA.ROLE_GRANTEES after apply.
+resource "alis_google_spanner_database_role_membership" "test" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = alis_google_spanner_database_role.parent.role
+  member   = alis_google_spanner_database_role.child.role
+}
@@ -0,0 +1,9 @@
+resource "alis_google_spanner_schema" "test_schema" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  ddl = [
+    "CREATE TABLE tf_schema_users (id STRING(36) NOT NULL, name STRING(MAX)) PRIMARY KEY (id)",
+    "CREATE INDEX tf_schema_users_by_name ON tf_schema_users (name)",
+  ]
+}
@@ -0,0 +1,28 @@
+// Local manual verification for alis_google_spanner_table_iam_member.
+// Prerequisite: apply testing/resources/alis_google_spanner_table first —
+// both members grant on the tf_test table in database "play". Destroying one
+// must leave the other's grant in place.
+resource "alis_google_spanner_database_role" "tf_test_shared" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "tf_test_shared"
+}
+
+resource "alis_google_spanner_table_iam_member" "select" {
+  project    = var.GOOGLE_PROJECT
+  instance   = var.SPANNER_INSTANCE
+  database   = var.SPANNER_DATABASE
+  table      = var.SPANNER_TABLE
+  role       = alis_google_spanner_database_role.tf_test_shared.role
+  permission = "SELECT"
+}
+
+resource "alis_google_spanner_table_iam_member" "insert" {
+  project    = var.GOOGLE_PROJECT
+  instance   = var.SPANNER_INSTANCE
+  database   = var.SPANNER_DATABASE
+  table      = var.SPANNER_TABLE
+  role       = alis_google_spanner_database_role.tf_test_shared.role
+  permission = "INSERT"
+}
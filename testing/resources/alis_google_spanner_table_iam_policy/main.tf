@@ -0,0 +1,11 @@
+terraform {
+  required_providers {
+    alis = {
+      source = "alis-exchange/alis"
+    }
+  }
+}
+
+provider "alis" {
+  project = var.GOOGLE_PROJECT
+}
@@ -0,0 +1,24 @@
+// Local manual verification for alis_google_spanner_table_iam_policy.
+// Prerequisite: apply testing/resources/alis_google_spanner_table first —
+// the policy owns the tf_test table in database "play", so any grant on it
+// made outside this file shows up in the plan's revocations.
+resource "alis_google_spanner_database_role" "tf_test_reader" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  role     = "tf_test_reader"
+}
+
+resource "alis_google_spanner_table_iam_policy" "test_policy" {
+  project  = var.GOOGLE_PROJECT
+  instance = var.SPANNER_INSTANCE
+  database = var.SPANNER_DATABASE
+  table    = var.SPANNER_TABLE
+
+  bindings = [
+    {
+      role        = alis_google_spanner_database_role.tf_test_reader.role
+      permissions = ["SELECT"]
+    },
+  ]
+}